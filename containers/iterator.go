@@ -131,3 +131,25 @@ type ReverseIteratorWithKey[K, V any] interface {
 
 	IteratorWithKey[K, V]
 }
+
+// ConcurrentModificationError is the error fail-fast iterators panic with when the container they iterate
+// was structurally modified (elements added or removed) other than through the iterator itself.
+//
+// An iterator that has not moved to an element yet is not affected, and resetting an iterator with Begin() or End()
+// makes it valid again. Containers with fail-fast iterators implement FailFast, so that the checks can be disabled
+// for hot paths.
+type ConcurrentModificationError struct {
+	Container string // name of the modified container, e.g. "ArrayList"
+}
+
+// Error returns the error message.
+func (err *ConcurrentModificationError) Error() string {
+	return err.Container + " was modified during iteration"
+}
+
+// FailFast is implemented by containers whose iterators detect concurrent modification of the container.
+type FailFast interface {
+	// SetFailFast enables or disables the modification checks of the container's iterators.
+	// Checks are enabled by default.
+	SetFailFast(enabled bool)
+}
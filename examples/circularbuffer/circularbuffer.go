@@ -23,4 +23,20 @@ func main() {
 	queue.Clear()           // empty
 	queue.Empty()           // true
 	_ = queue.Size()        // 0
+
+	window := cb.NewWith[int](3, cb.Reject) // empty (max size is 3, rejects elements when full)
+	_ = window.Offer(1)                     // 1
+	_ = window.Offer(2)                     // 1, 2
+	_ = window.PushFront(0)                 // 0, 1, 2
+	_ = window.Offer(3)                     // ErrFull, 0, 1, 2
+	window.SetOverflowPolicy(cb.Overwrite)  // evicts elements when full
+	window.SetEvictionCallback(func(value int) {
+		// called with evicted elements
+	})
+	window.Enqueue(3)       // 1, 2, 3 (0 evicted)
+	_, _ = window.Get(0)    // 1, true
+	_ = window.Last(2)      // 2, 3
+	_, _ = window.PopBack() // 3, true
+	window.Resize(1)        // 2 (1 evicted)
+	_ = window.MaxSize()    // 1
 }
@@ -0,0 +1,46 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"sync"
+
+	"github.com/emirpasic/gods/v2/maps/concurrenthashmap"
+)
+
+// ConcurrentHashMapExample to demonstrate basic usage of ConcurrentHashMap
+func main() {
+	m := concurrenthashmap.New[string, int]() // empty
+	m.Put("a", 1)                             // a->1
+	_, _ = m.PutIfAbsent("a", 2)              // 1, true (not replaced)
+	_, _ = m.PutIfAbsent("b", 2)              // 2, false (a->1, b->2)
+	_, _ = m.Get("a")                         // 1, true
+	_, _ = m.Compute("a", func(value int, found bool) (int, bool) {
+		return value + 10, true
+	}) // 11, true (a->11, b->2)
+	_, _ = m.ComputeIfPresent("c", func(value int) (int, bool) {
+		return value * 2, true
+	}) // 0, false (not present)
+	m.Range(func(key string, value int) bool {
+		return true // a->11, b->2 (random order)
+	})
+	m.Remove("a") // b->2
+
+	// count words from several goroutines
+	words := []string{"x", "y", "x", "z", "x"}
+	var wg sync.WaitGroup
+	for _, word := range words {
+		wg.Add(1)
+		go func(word string) {
+			defer wg.Done()
+			m.Merge(word, 1, func(oldValue, value int) (int, bool) {
+				return oldValue + value, true
+			})
+		}(word)
+	}
+	wg.Wait()
+	_, _ = m.Get("x") // 3, true
+	_ = m.Size()      // 4
+}
@@ -0,0 +1,45 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"sync"
+
+	"github.com/emirpasic/gods/v2/maps/concurrentskiplistmap"
+)
+
+// ConcurrentSkipListMapExample to demonstrate basic usage of ConcurrentSkipListMap
+func main() {
+	m := concurrentskiplistmap.New[int, string]() // empty
+	m.Put(1, "x")                                 // 1->x
+	m.Put(2, "b")                                 // 1->x, 2->b (in order)
+	m.Put(1, "a")                                 // 1->a, 2->b (in order)
+	_, _ = m.Get(2)                               // b, true
+	_, _ = m.Get(3)                               // "", false
+	_, _, _ = m.Floor(5)                          // 2, b, true
+	_, _, _ = m.Ceiling(0)                        // 1, a, true
+	_, _, _ = m.Max()                             // 2, b, true
+	m.Remove(1)                                   // 2->b
+
+	// put from several goroutines
+	var wg sync.WaitGroup
+	for i := 10; i < 20; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			m.Put(i, "c")
+		}(i)
+	}
+	wg.Wait()
+	_ = m.Size() // 11
+
+	m.RangeBetween(12, 15, func(key int, value string) bool {
+		return true // 12->c, 13->c, 14->c (in order)
+	})
+	it := m.Iterator()
+	for it.End(); it.Prev(); {
+		_, _ = it.Key(), it.Value() // 19->c, ..., 10->c, 2->b (in reverse order)
+	}
+}
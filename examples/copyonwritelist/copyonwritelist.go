@@ -0,0 +1,36 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"cmp"
+
+	"github.com/emirpasic/gods/v2/lists/copyonwritelist"
+)
+
+// CopyOnWriteListExample to demonstrate basic usage of CopyOnWriteList
+func main() {
+	list := copyonwritelist.New[string]() // []
+	list.Add("a")                         // ["a"]
+	list.Add("c", "b")                    // ["a","c","b"]
+	_ = list.AddIfAbsent("a")             // false (["a","c","b"])
+	list.Sort(cmp.Compare[string])        // ["a","b","c"]
+	_, _ = list.Get(0)                    // "a",true
+	_, _ = list.Get(100)                  // "",false
+	_ = list.Contains("a", "b", "c")      // true
+	list.Swap(0, 1)                       // ["b","a","c"]
+
+	// readers see a snapshot, writers do not disturb them
+	it := list.Iterator()
+	list.Remove(2) // ["b","a"]
+	for it.Next() {
+		_, _ = it.Index(), it.Value() // 0,"b", 1,"a", 2,"c"
+	}
+	_ = list.Elements() // []string{"b","a"} (shared, not to be modified)
+
+	list.Clear()     // []
+	_ = list.Empty() // true
+	_ = list.Size()  // 0
+}
@@ -0,0 +1,26 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import "github.com/emirpasic/gods/v2/sets/copyonwriteset"
+
+// CopyOnWriteSetExample to demonstrate basic usage of CopyOnWriteSet
+func main() {
+	set := copyonwriteset.New[int]() // empty
+	set.Add(5)                       // 5
+	set.Add(4, 4, 3, 2, 1)           // 5, 4, 3, 2, 1 (in insertion-order, duplicates ignored)
+	_ = set.AddIfAbsent(3)           // false
+	set.Remove(4)                    // 5, 3, 2, 1 (in insertion-order)
+	set.Remove(2, 3)                 // 5, 1 (in insertion-order)
+	set.Contains(1)                  // true
+	set.Contains(1, 6)               // false
+
+	// readers see a snapshot, writers do not disturb them
+	for _, value := range set.Elements() {
+		set.Remove(value) // 5, 1 are visited
+	}
+	set.Empty() // true
+	set.Size()  // 0
+}
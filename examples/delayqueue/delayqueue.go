@@ -0,0 +1,37 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"context"
+	"time"
+
+	"github.com/emirpasic/gods/v2/queues/delayqueue"
+)
+
+// DelayQueueExample to demonstrate basic usage of DelayQueue and TimerWheel
+func main() {
+	clock := delayqueue.NewManualClock(time.Now())
+	queue := delayqueue.NewWithClock[string](clock) // empty
+	queue.ScheduleAfter("b", 2*time.Second)         // b (in 2s)
+	queue.ScheduleAfter("a", time.Second)           // a (in 1s), b (in 2s)
+	c := queue.ScheduleAfter("c", time.Minute)      // a (in 1s), b (in 2s), c (in 1m)
+	_ = queue.Cancel(c)                             // true, a (in 1s), b (in 2s)
+	_, _ = queue.Poll()                             // "" false (nothing available yet)
+	clock.Advance(time.Second)                      // a (available), b (in 1s)
+	_, _ = queue.Poll()                             // a true
+	clock.Advance(time.Second)                      // b (available)
+	_, _ = queue.Take(context.Background())         // b <nil>
+	_ = queue.Empty()                               // true
+
+	wheel := delayqueue.NewTimerWheel[int](time.Millisecond) // empty, 1ms granularity
+	for i := 0; i < 1000; i++ {
+		wheel.ScheduleAfter(i, time.Duration(i)*time.Microsecond) // available within the first millisecond
+	}
+	_ = wheel.Size() // 1000
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	_, _ = wheel.Take(ctx) // 0 <nil> (elements of the same tick in deadline order)
+}
@@ -0,0 +1,59 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/emirpasic/gods/v2/trees/diskbtree"
+)
+
+// DiskBTreeExample to demonstrate basic usage of a B-tree stored in a file
+func main() {
+	path := filepath.Join(os.TempDir(), "diskbtree-example.db")
+	defer os.Remove(path)
+
+	store, err := diskbtree.OpenFilePageStore(path, diskbtree.DefaultPageSize)
+	if err != nil {
+		panic(err)
+	}
+	tree, err := diskbtree.New[int, string](store, 64, diskbtree.IntCodec[int]{}, diskbtree.StringCodec{})
+	if err != nil {
+		panic(err)
+	}
+
+	_ = tree.Put(1, "x") // 1->x (committed to disk)
+	_ = tree.Put(2, "b") // 1->x, 2->b (in order)
+	_ = tree.Put(1, "a") // 1->a, 2->b (in order, replacement)
+	_ = tree.Put(3, "c") // 1->a, 2->b, 3->c (in order)
+
+	_, _ = tree.Values() // []string{"a", "b", "c"} (in order)
+	_, _ = tree.Keys()   // []int{1, 2, 3} (in order)
+
+	_ = tree.Remove(2) // 1->a, 3->c (in order)
+	_ = tree.Close()
+
+	// reopen the file, the tree is as it was left
+	store, _ = diskbtree.OpenFilePageStore(path, 0)
+	tree, _ = diskbtree.New[int, string](store, 64, diskbtree.IntCodec[int]{}, diskbtree.StringCodec{})
+	defer tree.Close()
+
+	value, found, _ := tree.Get(3) // "c", true
+	fmt.Println(value, found)
+
+	it := tree.Iterator()
+	for it.Next() {
+		fmt.Println(it.Key(), it.Value()) // 1 a, 3 c
+	}
+	if err := it.Err(); err != nil { // reading pages may fail
+		panic(err)
+	}
+
+	_ = tree.Clear() // empty
+	tree.Empty()     // true
+	tree.Size()      // 0
+}
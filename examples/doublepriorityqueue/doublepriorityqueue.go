@@ -0,0 +1,43 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"cmp"
+
+	dpq "github.com/emirpasic/gods/v2/queues/doublepriorityqueue"
+)
+
+// Element is an entry in the priority queue
+type Element struct {
+	name     string
+	priority int
+}
+
+// Comparator function (sort by element's priority value in ascending order)
+func byPriority(a, b Element) int {
+	return cmp.Compare(a.priority, b.priority)
+}
+
+// DoublePriorityQueueExample to demonstrate basic usage of DoublePriorityQueue
+func main() {
+	a := Element{name: "a", priority: 1}
+	b := Element{name: "b", priority: 2}
+	c := Element{name: "c", priority: 3}
+
+	queue := dpq.NewWith(byPriority) // empty
+	queue.Enqueue(a)                 // {a 1}
+	queue.Enqueue(c)                 // {a 1}, {c 3}
+	queue.Enqueue(b)                 // {a 1}, {c 3}, {b 2}
+	_, _ = queue.PeekMin()           // {a 1} true
+	_, _ = queue.PeekMax()           // {c 3} true
+	_, _ = queue.PopMax()            // {c 3} true
+	_, _ = queue.Dequeue()           // {a 1} true (same as PopMin)
+	_, _ = queue.PopMin()            // {b 2} true
+	_, _ = queue.PopMin()            // { 0} false (nothing to pop)
+	queue.Clear()                    // empty
+	_ = queue.Empty()                // true
+	_ = queue.Size()                 // 0
+}
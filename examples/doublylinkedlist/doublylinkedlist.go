@@ -29,4 +29,14 @@ func main() {
 	_ = list.Size()                       // 0
 	list.Add("a")                         // ["a"]
 	list.Clear()                          // []
+
+	// element handles
+	a := list.Append("a")     // ["a"]
+	c := list.Append("c")     // ["a","c"]
+	list.InsertBefore(c, "b") // ["a","b","c"]
+	list.MoveToBack(a)        // ["b","c","a"]
+	list.RemoveElement(c)     // ["b","a"]
+	other := dll.New[string]()
+	other.Append("d")  // other: ["d"]
+	list.Splice(other) // ["b","a","d"], other: []
 }
@@ -0,0 +1,29 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import "github.com/emirpasic/gods/v2/trees/fibonacciheap"
+
+// FibonacciHeapExample to demonstrate basic usage of FibonacciHeap
+func main() {
+	heap := fibonacciheap.New[int]() // empty (min-heap)
+	heap.Push(5, 3)                  // 3, 5
+	node := heap.Insert(7)           // 3, 5, 7 (node is a handle of 7)
+	_, _ = heap.Peek()               // 3, true
+	heap.DecreaseKey(node, 1)        // 1, 3, 5 (7 decreased to 1)
+	_, _ = heap.Peek()               // 1, true
+	heap.Delete(node)                // 3, 5
+	_ = heap.Contains(node)          // false
+
+	other := fibonacciheap.New[int]()
+	other.Push(4, 2)  // 2, 4
+	heap.Meld(other)  // 2, 3, 4, 5 (other is empty)
+	_, _ = heap.Pop() // 2, true
+	_, _ = heap.Pop() // 3, true
+	_ = heap.Size()   // 2
+	heap.Clear()      // empty
+	_ = heap.Empty()  // true
+	_, _ = heap.Pop() // 0, false (nothing to pop)
+}
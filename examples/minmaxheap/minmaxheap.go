@@ -0,0 +1,36 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"cmp"
+
+	"github.com/emirpasic/gods/v2/trees/minmaxheap"
+)
+
+// MinMaxHeapExample to demonstrate basic usage of MinMaxHeap
+func main() {
+	heap := minmaxheap.New[int]() // empty
+	heap.Push(2)                  // 2
+	heap.Push(3)                  // 2, 3
+	heap.Push(1)                  // 1, 3, 2
+	heap.Push(5, 4)               // 1, 5, 2, 3, 4 (bulk optimized)
+	_ = heap.Values()             // 1, 2, 5, 3, 4 (levels sorted)
+	_, _ = heap.PeekMin()         // 1, true
+	_, _ = heap.PeekMax()         // 5, true
+	_, _ = heap.PopMin()          // 1, true
+	_, _ = heap.PopMax()          // 5, true
+	_, _ = heap.PopMax()          // 4, true
+	_, _ = heap.PopMin()          // 2, true
+	_, _ = heap.PopMin()          // 3, true
+	_, _ = heap.PopMin()          // 0, false (nothing to pop)
+	heap.Clear()                  // empty
+	_ = heap.Empty()              // true
+	_ = heap.Size()               // 0
+
+	heap = minmaxheap.NewFromSlice([]int{4, 1, 3, 2}, cmp.Compare[int]) // 1, 4, 3, 2 (heapified in O(n))
+	_, _ = heap.PeekMin()                                               // 1, true
+	_, _ = heap.PeekMax()                                               // 4, true
+}
@@ -0,0 +1,42 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"sync"
+
+	"github.com/emirpasic/gods/v2/queues/mpmcqueue"
+)
+
+// MPMCQueueExample to demonstrate basic usage of MPMCQueue
+func main() {
+	queue := mpmcqueue.New[int](3) // empty (capacity is rounded up to 4)
+	queue.Enqueue(1)               // 1
+	_ = queue.TryEnqueue(2)        // true, 1, 2
+	_, _ = queue.Peek()            // 1, true
+	_, _ = queue.Dequeue()         // 1, true
+	_, _ = queue.TryDequeue()      // 2, true
+	_, _ = queue.TryDequeue()      // 0, false (nothing to dequeue)
+
+	// producers and consumers in different goroutines
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 100; i++ {
+			queue.Enqueue(i) // waits while the queue is full
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		for received := 0; received < 100; {
+			if _, ok := queue.TryDequeue(); ok {
+				received++
+			}
+		}
+	}()
+	wg.Wait()
+	_ = queue.Empty() // true
+}
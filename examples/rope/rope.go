@@ -0,0 +1,29 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+
+	"github.com/emirpasic/gods/v2/lists/rope"
+)
+
+// RopeExample to demonstrate basic usage of Rope as a text buffer
+func main() {
+	text := rope.NewString("hello world") // "hello world"
+
+	rope.InsertString(text, 5, ",") // "hello, world"
+	text.Set(0, 'H')                // "Hello, world"
+	text.Add('!')                   // "Hello, world!"
+	_ = rope.Substring(text, 7, 12) // "world"
+	_ = text.IndexOf('w')           // 7
+	fmt.Println(rope.Text(text))    // Hello, world!
+
+	tail := text.Split(5)             // text: "Hello", tail: ", world!"
+	rope.InsertString(text, 5, " to") // text: "Hello to"
+	text.Concat(tail)                 // text: "Hello to, world!", tail: ""
+	text.RemoveRange(5, 8)            // "Hello, world!"
+	fmt.Println(rope.Text(text))      // Hello, world!
+}
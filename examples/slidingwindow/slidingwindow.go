@@ -0,0 +1,32 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"time"
+
+	"github.com/emirpasic/gods/v2/queues/slidingwindow"
+)
+
+// SlidingWindowExample to demonstrate basic usage of Window and TimeWindow
+func main() {
+	window := slidingwindow.New[int](3) // empty (holds the last 3 samples)
+	window.Add(5)                       // 5
+	window.Add(1)                       // 5, 1
+	window.Add(3)                       // 5, 1, 3
+	window.Add(4)                       // 1, 3, 4 (5 left the window)
+	_ = window.Sum()                    // 8
+	_, _ = window.Mean()                // 2.6666666666666665, true
+	_, _ = window.Min()                 // 1, true
+	_, _ = window.Max()                 // 4, true
+	_, _ = window.Quantile(0.5)         // 3 (approximately), true
+	_ = window.Values()                 // [1 3 4]
+
+	latencies := slidingwindow.NewTimeWindow[float64](time.Minute) // empty (holds the samples of the last minute)
+	latencies.Add(12.5)                                            // 12.5
+	latencies.Add(80)                                              // 12.5, 80
+	_, _ = latencies.Quantile(0.99)                                // 80 (approximately), true
+	_, _ = latencies.Mean()                                        // 46.25, true
+}
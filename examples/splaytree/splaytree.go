@@ -0,0 +1,46 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+
+	"github.com/emirpasic/gods/v2/trees/splaytree"
+)
+
+// SplayTreeExample to demonstrate basic usage of SplayTree
+func main() {
+	tree := splaytree.New[int, string]() // empty (keys are of type int)
+
+	tree.Put(1, "x") // 1->x
+	tree.Put(2, "b") // 1->x, 2->b (in order)
+	tree.Put(1, "a") // 1->a, 2->b (in order, replacement)
+	tree.Put(3, "c") // 1->a, 2->b, 3->c (in order)
+	tree.Put(4, "d") // 1->a, 2->b, 3->c, 4->d (in order)
+
+	fmt.Println(tree)
+	// SplayTree
+	// └── 4
+	//     └── 3
+	//         └── 2
+	//             └── 1
+
+	tree.Get(1) // "a", true (1 is splayed to the root)
+	fmt.Println(tree)
+	// SplayTree
+	// │   ┌── 4
+	// │   │   │   ┌── 3
+	// │   │   └── 2
+	// └── 1
+
+	_ = tree.Values() // []string{"a", "b", "c", "d"} (in order)
+	_ = tree.Keys()   // []int{1, 2, 3, 4} (in order)
+
+	tree.Remove(2) // 1->a, 3->c, 4->d (in order)
+
+	tree.Clear() // empty
+	tree.Empty() // true
+	tree.Size()  // 0
+}
@@ -0,0 +1,39 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+
+	"github.com/emirpasic/gods/v2/trees/treap"
+)
+
+// TreapExample to demonstrate basic usage of Treap
+func main() {
+	tree := treap.New[int, string]() // empty (keys are of type int)
+
+	tree.Put(1, "x") // 1->x
+	tree.Put(2, "b") // 1->x, 2->b (in order)
+	tree.Put(1, "a") // 1->a, 2->b (in order, replacement)
+	tree.Put(3, "c") // 1->a, 2->b, 3->c (in order)
+	tree.Put(4, "d") // 1->a, 2->b, 3->c, 4->d (in order)
+	tree.Put(5, "e") // 1->a, 2->b, 3->c, 4->d, 5->e (in order)
+
+	fmt.Println(tree) // shape depends on the random priorities
+
+	_ = tree.Values() // []string{"a", "b", "c", "d", "e"} (in order)
+	_ = tree.Keys()   // []int{1, 2, 3, 4, 5} (in order)
+
+	left, right := tree.Split(3) // left: 1->a, 2->b; right: 3->c, 4->d, 5->e; tree: empty
+	_ = left.Keys()              // []int{1, 2}
+	_ = right.Keys()             // []int{3, 4, 5}
+
+	left.Merge(right) // left: 1->a, 2->b, 3->c, 4->d, 5->e; right: empty
+	left.Remove(2)    // 1->a, 3->c, 4->d, 5->e (in order)
+
+	left.Clear() // empty
+	left.Empty() // true
+	left.Size()  // 0
+}
@@ -20,4 +20,12 @@ func main() {
 	set.Clear()               // empty
 	set.Empty()               // true
 	set.Size()                // 0
+
+	set.Add(1, 3, 5, 7, 9)                // 1, 3, 5, 7, 9
+	_, _ = set.Floor(4)                   // 3, true
+	_, _ = set.Higher(5)                  // 7, true
+	view := set.SubSet(3, true, 7, false) // 3, 5 (live view)
+	set.Add(4)                            // view: 3, 4, 5
+	_ = view.DescendingSet().Values()     // []int{5,4,3}
+	_, _ = view.PollFirst()               // 3, true (removed from set)
 }
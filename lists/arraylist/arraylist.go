@@ -14,16 +14,28 @@ import (
 	"slices"
 	"strings"
 
+	"github.com/emirpasic/gods/v2/containers"
 	"github.com/emirpasic/gods/v2/lists"
 	"github.com/emirpasic/gods/v2/utils"
 )
 
 // Assert List implementation
 var _ lists.List[int] = (*List[int])(nil)
+var _ containers.FailFast = (*List[int])(nil)
 
 // List holds the elements in a slice
 type List[T comparable] struct {
-	elements []T
+	elements         []T
+	policy           ResizePolicy // zero value means DefaultResizePolicy
+	minCapacity      int          // capacity requested by NewWithCapacity or EnsureCapacity, kept when shrinking
+	modCount         int          // number of structural modifications, checked by iterators
+	failFastDisabled bool         // iterators do not check for modifications
+}
+
+// ResizePolicy defines how the capacity of a list changes as elements are added and removed.
+type ResizePolicy struct {
+	GrowthFactor float32 // capacity is multiplied by this factor when it is reached, has to be greater than 1
+	ShrinkFactor float32 // capacity is reduced to the size when size is at this fraction of capacity (0 means never shrink)
 }
 
 const (
@@ -31,6 +43,9 @@ const (
 	shrinkFactor = float32(0.25) // shrink when size is 25% of capacity (0 means never shrink)
 )
 
+// DefaultResizePolicy is the policy of new lists, which grow by 100% and shrink when size is 25% of capacity.
+var DefaultResizePolicy = ResizePolicy{GrowthFactor: growthFactor, ShrinkFactor: shrinkFactor}
+
 // New instantiates a new list and adds the passed values, if any, to the list
 func New[T comparable](values ...T) *List[T] {
 	list := &List[T]{}
@@ -40,8 +55,17 @@ func New[T comparable](values ...T) *List[T] {
 	return list
 }
 
+// NewWithCapacity instantiates a new empty list that can hold the given number of elements without allocating.
+// The list does not shrink below that capacity, see EnsureCapacity.
+func NewWithCapacity[T comparable](capacity int) *List[T] {
+	list := &List[T]{}
+	list.EnsureCapacity(capacity)
+	return list
+}
+
 // Add appends a value at the end of the list
 func (list *List[T]) Add(values ...T) {
+	list.modCount++
 	l := len(list.elements)
 	list.growBy(len(values))
 	for i := range values {
@@ -68,6 +92,7 @@ func (list *List[T]) Remove(index int) {
 		return
 	}
 
+	list.modCount++
 	list.elements = slices.Delete(list.elements, index, index+1)
 	list.shrink()
 }
@@ -107,16 +132,89 @@ func (list *List[T]) Size() int {
 
 // Clear removes all elements from the list.
 func (list *List[T]) Clear() {
+	list.modCount++
 	clear(list.elements[:cap(list.elements)])
 	list.elements = list.elements[:0]
 }
 
 // Sort sorts values (in-place) using.
 func (list *List[T]) Sort(comparator utils.Comparator[T]) {
+	list.sortRange(0, len(list.elements), comparator)
+}
+
+// SortStable sorts values (in-place) using a Comparator, keeping equal values in their original order.
+func (list *List[T]) SortStable(comparator utils.Comparator[T]) {
 	if len(list.elements) < 2 {
 		return
 	}
-	slices.SortFunc(list.elements, comparator)
+	list.modCount++
+	slices.SortStableFunc(list.elements, comparator)
+}
+
+// Merge moves all elements of the other list into this list in O(n+m), leaving the other list empty.
+// Both lists have to be sorted by the comparator, the result is sorted as well. Values of this list come before equal
+// values of the other list. The values are merged in place from the back, so no allocation happens beyond growing
+// this list.
+func (list *List[T]) Merge(other *List[T], comparator utils.Comparator[T]) {
+	if other == list || len(other.elements) == 0 {
+		return
+	}
+	list.modCount++
+	n, m := len(list.elements), len(other.elements)
+	list.growBy(m)
+	i, j := n-1, m-1
+	for k := n + m - 1; j >= 0; k-- {
+		if i >= 0 && comparator(list.elements[i], other.elements[j]) > 0 {
+			list.elements[k] = list.elements[i]
+			i--
+		} else {
+			list.elements[k] = other.elements[j]
+			j--
+		}
+	}
+	other.Clear()
+}
+
+// IsSorted returns true if the values are in the order defined by the comparator.
+func (list *List[T]) IsSorted(comparator utils.Comparator[T]) bool {
+	return slices.IsSortedFunc(list.elements, comparator)
+}
+
+// BinarySearch searches the value in the list, which has to be sorted by the comparator, in O(log n).
+// Returns the index of the value and true if found, otherwise the index where it would be inserted and false.
+// If the value is present multiple times, the index of the first one is returned.
+func (list *List[T]) BinarySearch(value T, comparator utils.Comparator[T]) (index int, found bool) {
+	return slices.BinarySearchFunc(list.elements, value, comparator)
+}
+
+// LowerBound returns the index of the first value that is not less than the given value, or Size() if there is none.
+// The list has to be sorted by the comparator.
+func (list *List[T]) LowerBound(value T, comparator utils.Comparator[T]) int {
+	index, _ := slices.BinarySearchFunc(list.elements, value, comparator)
+	return index
+}
+
+// UpperBound returns the index of the first value that is greater than the given value, or Size() if there is none.
+// The list has to be sorted by the comparator.
+func (list *List[T]) UpperBound(value T, comparator utils.Comparator[T]) int {
+	low, high := 0, len(list.elements)
+	for low < high {
+		middle := int(uint(low+high) >> 1)
+		if comparator(list.elements[middle], value) <= 0 {
+			low = middle + 1
+		} else {
+			high = middle
+		}
+	}
+	return low
+}
+
+// InsertSorted inserts the value after all values that are not greater than it and returns its index,
+// keeping the list sorted by the comparator. The list has to be sorted by the comparator.
+func (list *List[T]) InsertSorted(value T, comparator utils.Comparator[T]) int {
+	index := list.UpperBound(value, comparator)
+	list.Insert(index, value)
+	return index
 }
 
 // Swap swaps the two values at the specified positions.
@@ -138,6 +236,7 @@ func (list *List[T]) Insert(index int, values ...T) {
 		return
 	}
 
+	list.modCount++
 	l := len(list.elements)
 	list.growBy(len(values))
 	list.elements = slices.Insert(list.elements[:l], index, values...)
@@ -159,6 +258,96 @@ func (list *List[T]) Set(index int, value T) {
 	list.elements[index] = value
 }
 
+// RemoveIf removes all elements for which the predicate returns true.
+// Remaining elements are compacted in a single pass, i.e. in O(n).
+func (list *List[T]) RemoveIf(predicate func(value T) bool) {
+	list.removeIf(0, len(list.elements), predicate)
+}
+
+// RetainIf removes all elements for which the predicate returns false.
+// Remaining elements are compacted in a single pass, i.e. in O(n).
+func (list *List[T]) RetainIf(predicate func(value T) bool) {
+	list.RemoveIf(func(value T) bool { return !predicate(value) })
+}
+
+// RemoveRange removes the elements from index "from" (inclusive) to "to" (exclusive), shifting any subsequent
+// elements to the left.
+// Does not do anything if the range is not within the list.
+func (list *List[T]) RemoveRange(from, to int) {
+	if from < 0 || to > len(list.elements) || from >= to {
+		return
+	}
+	list.removeRange(from, to)
+}
+
+// Reverse reverses the order of the elements (in-place).
+func (list *List[T]) Reverse() {
+	list.reverse(0, len(list.elements))
+}
+
+// Rotate rotates the elements by the given distance (in-place), i.e. the element at index i moves to index
+// (i + distance) modulo Size(). A negative distance rotates towards the front,
+// e.g. [a,b,c,d] rotated by 1 is [d,a,b,c] and rotated by -1 is [b,c,d,a].
+func (list *List[T]) Rotate(distance int) {
+	list.rotate(0, len(list.elements), distance)
+}
+
+// AddAll appends all values of another list at the end of the list.
+func (list *List[T]) AddAll(another lists.List[T]) {
+	list.Add(another.Values()...)
+}
+
+// Capacity returns the number of elements the list can hold without allocating.
+func (list *List[T]) Capacity() int {
+	return cap(list.elements)
+}
+
+// EnsureCapacity increases the capacity of the list, if necessary, so that it can hold at least the given number of
+// elements without allocating. The list does not shrink below that capacity until TrimToSize is called.
+func (list *List[T]) EnsureCapacity(capacity int) {
+	list.minCapacity = max(list.minCapacity, capacity)
+	if cap(list.elements) < capacity {
+		list.resize(len(list.elements), capacity)
+	}
+}
+
+// TrimToSize reduces the capacity of the list to its size and drops the capacity requested by NewWithCapacity or
+// EnsureCapacity.
+func (list *List[T]) TrimToSize() {
+	list.minCapacity = 0
+	if cap(list.elements) > len(list.elements) {
+		list.resize(len(list.elements), len(list.elements))
+	}
+}
+
+// SetResizePolicy sets how the capacity of the list changes as elements are added and removed.
+// Panics if the growth factor is not greater than 1 or the shrink factor is not within [0, 1).
+func (list *List[T]) SetResizePolicy(policy ResizePolicy) {
+	if policy.GrowthFactor <= 1 || policy.ShrinkFactor < 0 || policy.ShrinkFactor >= 1 {
+		panic(fmt.Sprintf("Invalid resize policy %+v", policy))
+	}
+	list.policy = policy
+}
+
+// AppendValuesTo appends all elements in the list to dst and returns the extended slice, like append.
+// Unlike Values, it does not allocate if dst has enough capacity.
+func (list *List[T]) AppendValuesTo(dst []T) []T {
+	return append(dst, list.elements...)
+}
+
+// Elements returns the elements in the list without copying them.
+// The returned slice shares the list's storage: it must not be modified, and it is only valid until the list is
+// modified.
+func (list *List[T]) Elements() []T {
+	return list.elements[:len(list.elements):len(list.elements)]
+}
+
+// SetFailFast enables or disables the checks of iterators for modifications of the list done other than through
+// the iterator itself, see containers.ConcurrentModificationError. Checks are enabled by default.
+func (list *List[T]) SetFailFast(enabled bool) {
+	list.failFastDisabled = !enabled
+}
+
 // String returns a string representation of container
 func (list *List[T]) String() string {
 	str := "ArrayList\n"
@@ -181,13 +370,13 @@ func (list *List[T]) resize(len, cap int) {
 	list.elements = newElements
 }
 
-// Expand the array if necessary, i.e. capacity will be reached if we add n elements
+// Expand the array if necessary, i.e. capacity will be exceeded if we add n elements
 func (list *List[T]) growBy(n int) {
-	// When capacity is reached, grow by a factor of growthFactor and add number of elements
+	// When capacity is exceeded, grow by a factor of the growth factor and add number of elements
 	currentCapacity := cap(list.elements)
 
-	if newLength := len(list.elements) + n; newLength >= currentCapacity {
-		newCapacity := int(growthFactor * float32(currentCapacity+n))
+	if newLength := len(list.elements) + n; newLength > currentCapacity {
+		newCapacity := int(list.resizePolicy().GrowthFactor * float32(currentCapacity+n))
 		list.resize(newLength, newCapacity)
 	} else {
 		list.elements = list.elements[:newLength]
@@ -196,12 +385,88 @@ func (list *List[T]) growBy(n int) {
 
 // Shrink the array if necessary, i.e. when size is shrinkFactor percent of current capacity
 func (list *List[T]) shrink() {
+	shrinkFactor := list.resizePolicy().ShrinkFactor
 	if shrinkFactor == 0.0 {
 		return
 	}
-	// Shrink when size is at shrinkFactor * capacity
+	// Shrink when size is at shrinkFactor * capacity, but not below the requested capacity
 	currentCapacity := cap(list.elements)
-	if len(list.elements) <= int(float32(currentCapacity)*shrinkFactor) {
-		list.resize(len(list.elements), len(list.elements))
+	if currentCapacity > list.minCapacity && len(list.elements) <= int(float32(currentCapacity)*shrinkFactor) {
+		list.resize(len(list.elements), max(len(list.elements), list.minCapacity))
+	}
+}
+
+// resizePolicy returns the resize policy of the list.
+func (list *List[T]) resizePolicy() ResizePolicy {
+	if list.policy.GrowthFactor == 0 {
+		return DefaultResizePolicy
+	}
+	return list.policy
+}
+
+// values returns the elements from index "from" (inclusive) to "to" (exclusive).
+func (list *List[T]) values(from, to int) []T {
+	return slices.Clone(list.elements[from:to])
+}
+
+// removeRange removes the elements from index "from" (inclusive) to "to" (exclusive), which has to be a valid range.
+func (list *List[T]) removeRange(from, to int) {
+	list.modCount++
+	size := len(list.elements) - (to - from)
+	copy(list.elements[from:], list.elements[to:])
+	clear(list.elements[size:])
+	list.elements = list.elements[:size]
+	list.shrink()
+}
+
+// removeIf removes the elements within the range for which the predicate returns true and returns their number.
+// Remaining elements are compacted in a single pass, i.e. in O(n).
+func (list *List[T]) removeIf(from, to int, predicate func(value T) bool) int {
+	kept := from
+	for i := from; i < to; i++ {
+		if !predicate(list.elements[i]) {
+			list.elements[kept] = list.elements[i]
+			kept++
+		}
+	}
+	if kept == to {
+		return 0
+	}
+	list.removeRange(kept, to)
+	return to - kept
+}
+
+// sortRange sorts the elements within the range (in-place).
+func (list *List[T]) sortRange(from, to int, comparator utils.Comparator[T]) {
+	if to-from < 2 {
+		return
+	}
+	list.modCount++
+	slices.SortFunc(list.elements[from:to], comparator)
+}
+
+// reverse reverses the order of the elements within the range (in-place).
+func (list *List[T]) reverse(from, to int) {
+	if to-from < 2 {
+		return
+	}
+	list.modCount++
+	slices.Reverse(list.elements[from:to])
+}
+
+// rotate rotates the elements within the range by the given distance (in-place), by reversing its two parts
+// and then the whole range.
+func (list *List[T]) rotate(from, to, distance int) {
+	n := to - from
+	if n < 2 {
+		return
+	}
+	distance = (distance%n + n) % n
+	if distance == 0 {
+		return
 	}
+	list.modCount++
+	slices.Reverse(list.elements[from : to-distance])
+	slices.Reverse(list.elements[to-distance : to])
+	slices.Reverse(list.elements[from:to])
 }
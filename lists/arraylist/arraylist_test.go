@@ -10,6 +10,9 @@ import (
 	"slices"
 	"strings"
 	"testing"
+
+	"github.com/emirpasic/gods/v2/testutils"
+	"github.com/emirpasic/gods/v2/utils"
 )
 
 func TestListNew(t *testing.T) {
@@ -145,6 +148,135 @@ func TestListSort(t *testing.T) {
 	}
 }
 
+func TestListBinarySearch(t *testing.T) {
+	list := New[int]()
+	if index, found := list.BinarySearch(1, cmp.Compare[int]); index != 0 || found {
+		t.Errorf("Got %v %v expected %v %v", index, found, 0, false)
+	}
+	if actualValue := list.IsSorted(cmp.Compare[int]); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	list.Add(1, 3, 3, 3, 5, 7)
+	if actualValue := list.IsSorted(cmp.Compare[int]); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	tests := []struct {
+		value, index, lower, upper int
+		found                      bool
+	}{
+		{0, 0, 0, 0, false},
+		{1, 0, 0, 1, true},
+		{2, 1, 1, 1, false},
+		{3, 1, 1, 4, true},
+		{4, 4, 4, 4, false},
+		{7, 5, 5, 6, true},
+		{8, 6, 6, 6, false},
+	}
+	for _, test := range tests {
+		if index, found := list.BinarySearch(test.value, cmp.Compare[int]); index != test.index || found != test.found {
+			t.Errorf("BinarySearch(%v): got %v %v expected %v %v", test.value, index, found, test.index, test.found)
+		}
+		if actualValue := list.LowerBound(test.value, cmp.Compare[int]); actualValue != test.lower {
+			t.Errorf("LowerBound(%v): got %v expected %v", test.value, actualValue, test.lower)
+		}
+		if actualValue := list.UpperBound(test.value, cmp.Compare[int]); actualValue != test.upper {
+			t.Errorf("UpperBound(%v): got %v expected %v", test.value, actualValue, test.upper)
+		}
+	}
+	list.Swap(0, 5)
+	if actualValue := list.IsSorted(cmp.Compare[int]); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+}
+
+func TestListInsertSorted(t *testing.T) {
+	type pair struct{ key, value int }
+	byKey := func(a, b pair) int { return cmp.Compare(a.key, b.key) }
+	list := New[pair]()
+	for i, key := range []int{5, 1, 3, 1, 5, 3} {
+		list.InsertSorted(pair{key, i}, byKey)
+	}
+	if index := list.InsertSorted(pair{3, 6}, byKey); index != 4 {
+		t.Errorf("Got %v expected %v", index, 4)
+	}
+	expected := []pair{{1, 1}, {1, 3}, {3, 2}, {3, 5}, {3, 6}, {5, 0}, {5, 4}}
+	if actualValue := list.Values(); !slices.Equal(actualValue, expected) {
+		t.Errorf("Got %v expected %v", actualValue, expected)
+	}
+	if actualValue := list.IsSorted(byKey); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+}
+
+func TestListSortStable(t *testing.T) {
+	type pair struct{ key, value int }
+	byKey := func(a, b pair) int { return cmp.Compare(a.key, b.key) }
+	for size := 0; size < 40; size++ {
+		list := New[pair]()
+		expected := make([]pair, 0, size)
+		for i := 0; i < size; i++ {
+			list.Add(pair{(i * 7) % 5, i})
+			expected = append(expected, pair{(i * 7) % 5, i})
+		}
+		slices.SortStableFunc(expected, byKey)
+		list.SortStable(byKey)
+		if actualValue := list.Values(); !slices.Equal(actualValue, expected) {
+			t.Errorf("Got %v expected %v", actualValue, expected)
+		}
+	}
+}
+
+func TestListMerge(t *testing.T) {
+	type pair struct{ key, value int }
+	byKey := func(a, b pair) int { return cmp.Compare(a.key, b.key) }
+	list := New[pair](pair{1, 0}, pair{3, 0}, pair{3, 1}, pair{6, 0})
+	other := New[pair](pair{0, 2}, pair{3, 2}, pair{4, 2}, pair{7, 2}, pair{8, 2})
+	list.Merge(other, byKey)
+	expected := []pair{{0, 2}, {1, 0}, {3, 0}, {3, 1}, {3, 2}, {4, 2}, {6, 0}, {7, 2}, {8, 2}}
+	if actualValue := list.Values(); !slices.Equal(actualValue, expected) {
+		t.Errorf("Got %v expected %v", actualValue, expected)
+	}
+	if actualValue := list.Size(); actualValue != len(expected) {
+		t.Errorf("Got %v expected %v", actualValue, len(expected))
+	}
+	if actualValue := other.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	list.Merge(list, byKey)
+	list.Merge(other, byKey)
+	if actualValue := list.Size(); actualValue != len(expected) {
+		t.Errorf("Got %v expected %v", actualValue, len(expected))
+	}
+
+	empty := New[pair]()
+	empty.Merge(list, byKey)
+	if actualValue := empty.Values(); !slices.Equal(actualValue, expected) {
+		t.Errorf("Got %v expected %v", actualValue, expected)
+	}
+}
+
+func TestListSortComparatorCombinators(t *testing.T) {
+	one, two := 1, 2
+	list := New[*int](&two, nil, &one)
+	list.Sort(utils.NilsLast(cmp.Compare[int]))
+	if a, b, c := list.elements[0], list.elements[1], list.elements[2]; a != &one || b != &two || c != nil {
+		t.Errorf("Got %v expected %v", list.Values(), []*int{&one, &two, nil})
+	}
+
+	type file struct {
+		name string
+		size int
+	}
+	files := New[file](file{"b10", 1}, file{"b2", 2}, file{"a", 2})
+	files.Sort(utils.ThenComparing(
+		utils.Reverse(utils.ComparingBy(func(f file) int { return f.size })),
+		utils.ComparingByFunc(func(f file) string { return f.name }, utils.NaturalStringComparator),
+	))
+	if actualValue, expectedValue := files.Values(), []file{{"a", 2}, {"b2", 2}, {"b10", 1}}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
 func TestListClear(t *testing.T) {
 	list := New[string]()
 	list.Add("e", "f", "g", "a", "b", "c", "d")
@@ -605,6 +737,473 @@ func TestListIteratorPrevTo(t *testing.T) {
 	}
 }
 
+func TestListIteratorRemove(t *testing.T) {
+	list := New[int](1, 2, 3, 4, 5, 6)
+	it := list.Iterator()
+	for it.Next() {
+		if it.Value()%2 == 0 {
+			it.Remove()
+		}
+	}
+	if actualValue, expectedValue := list.Values(), []int{1, 3, 5}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := list.Size(), 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// remove everything, including first and last
+	it = list.Iterator()
+	for it.Next() {
+		it.Remove()
+		it.Remove() // no current element, no-op
+	}
+	if actualValue := list.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	list.Add(7, 8)
+	if actualValue, expectedValue := list.Values(), []int{7, 8}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// indices follow removals
+	list = New[int](1, 2, 3)
+	it = list.Iterator()
+	it.Next()
+	it.Remove()
+	if actualValue := it.Next(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue, expectedValue := it.Index(), 0; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := it.Value(), 2; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestListIteratorSetAndInsert(t *testing.T) {
+	list := New[string]("a", "c", "e")
+	it := list.Iterator()
+	it.Set("x") // no current element, no-op
+	it.InsertBefore("x")
+	it.InsertAfter("x")
+	for it.Next() {
+		switch it.Value() {
+		case "a":
+			it.InsertBefore("_")
+			it.InsertAfter("b")
+		case "c":
+			it.Set("C")
+			it.InsertAfter("d")
+		case "e":
+			it.InsertAfter("f")
+		}
+	}
+	if actualValue, expectedValue := list.Values(), []string{"_", "a", "b", "C", "d", "e", "f"}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := list.Size(), 7; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// last element is updated on insert after the last one
+	list.Add("g")
+	if actualValue, expectedValue := list.Values(), []string{"_", "a", "b", "C", "d", "e", "f", "g"}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// indices follow insertions
+	it = list.Iterator()
+	for it.Next() {
+		if actualValue, _ := list.Get(it.Index()); actualValue != it.Value() {
+			t.Errorf("Got %v expected %v", actualValue, it.Value())
+		}
+		it.InsertBefore("-")
+	}
+	if actualValue, expectedValue := list.Size(), 16; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestListRemoveIfAndRetainIf(t *testing.T) {
+	list := New[int](1, 2, 3, 4, 5, 6, 7, 8, 9, 10)
+	list.RemoveIf(func(value int) bool { return value%3 == 0 })
+	if actualValue, expectedValue := list.Values(), []int{1, 2, 4, 5, 7, 8, 10}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	list.RetainIf(func(value int) bool { return value%2 == 0 })
+	if actualValue, expectedValue := list.Values(), []int{2, 4, 8, 10}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	list.RemoveIf(func(value int) bool { return true })
+	if actualValue := list.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	list.Add(1)
+	if actualValue, expectedValue := list.Values(), []int{1}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestListIteratorRemoveReverse(t *testing.T) {
+	list := New[int](1, 2, 3, 4, 5)
+	it := list.Iterator()
+	for it.End(); it.Prev(); {
+		if it.Value()%2 == 1 {
+			it.Remove()
+		}
+	}
+	if actualValue, expectedValue := list.Values(), []int{2, 4}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// Prev after Remove moves to the element before the removed one
+	list = New[int](1, 2, 3)
+	it = list.Iterator()
+	it.Next()
+	it.Next()
+	it.Remove()
+	if actualValue := it.Prev(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue, expectedValue := it.Value(), 1; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	it.Remove()
+	if actualValue := it.Prev(); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if actualValue := it.Next(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue, expectedValue := it.Value(), 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestListRemoveRange(t *testing.T) {
+	list := New[int](0, 1, 2, 3, 4, 5)
+	list.RemoveRange(1, 3)
+	if actualValue, expectedValue := list.Values(), []int{0, 3, 4, 5}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	list.RemoveRange(2, 4)
+	if actualValue, expectedValue := list.Values(), []int{0, 3}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	// invalid or empty ranges are ignored
+	list.RemoveRange(-1, 1)
+	list.RemoveRange(1, 3)
+	list.RemoveRange(1, 1)
+	list.RemoveRange(1, 0)
+	if actualValue, expectedValue := list.Values(), []int{0, 3}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	list.RemoveRange(0, 2)
+	if actualValue, expectedValue := list.Size(), 0; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	list.Add(7)
+	if actualValue, expectedValue := list.Values(), []int{7}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestListReverseAndRotate(t *testing.T) {
+	list := New[int]()
+	list.Reverse()
+	list.Rotate(3)
+	if actualValue, expectedValue := list.Size(), 0; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	list.Add(1, 2, 3, 4, 5)
+	list.Reverse()
+	if actualValue, expectedValue := list.Values(), []int{5, 4, 3, 2, 1}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	list.Reverse()
+	list.Add(6)
+	if actualValue, expectedValue := list.Values(), []int{1, 2, 3, 4, 5, 6}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	tests := []struct {
+		distance int
+		expected []int
+	}{
+		{0, []int{1, 2, 3, 4, 5, 6}},
+		{1, []int{6, 1, 2, 3, 4, 5}},
+		{2, []int{5, 6, 1, 2, 3, 4}},
+		{-1, []int{2, 3, 4, 5, 6, 1}},
+		{6, []int{1, 2, 3, 4, 5, 6}},
+		{13, []int{6, 1, 2, 3, 4, 5}},
+		{-8, []int{3, 4, 5, 6, 1, 2}},
+	}
+	for _, test := range tests {
+		list := New[int](1, 2, 3, 4, 5, 6)
+		list.Rotate(test.distance)
+		if actualValue := list.Values(); !slices.Equal(actualValue, test.expected) {
+			t.Errorf("Rotate(%v): Got %v expected %v", test.distance, actualValue, test.expected)
+		}
+		list.Add(7)
+		if actualValue, expectedValue := list.Size(), 7; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		if actualValue, _ := list.Get(6); actualValue != 7 {
+			t.Errorf("Got %v expected %v", actualValue, 7)
+		}
+	}
+}
+
+func TestListAddAll(t *testing.T) {
+	list := New[int](1, 2)
+	list.AddAll(New[int](3, 4))
+	list.AddAll(list)
+	if actualValue, expectedValue := list.Values(), []int{1, 2, 3, 4, 1, 2, 3, 4}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestListSubList(t *testing.T) {
+	list := New[int](0, 1, 2, 3, 4, 5, 6, 7)
+	view := list.SubList(2, 6)
+	if actualValue, expectedValue := view.Values(), []int{2, 3, 4, 5}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, ok := view.Get(0); actualValue != 2 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 2)
+	}
+	if _, ok := view.Get(4); ok {
+		t.Errorf("Got %v expected %v", ok, false)
+	}
+	if actualValue := view.Contains(2, 5); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := view.Contains(1); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+
+	// writes go through to the list and the other way around
+	view.Set(0, 20)
+	list.Set(5, 50)
+	if actualValue, expectedValue := view.Values(), []int{20, 3, 4, 50}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	view.Reverse()
+	view.Swap(0, 3)
+	if actualValue, expectedValue := list.Values(), []int{0, 1, 20, 4, 3, 50, 6, 7}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	view.Sort(cmp.Compare[int])
+	view.Rotate(1)
+	if actualValue, expectedValue := list.Values(), []int{0, 1, 50, 3, 4, 20, 6, 7}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// structural changes through the view resize it
+	view.Add(8)
+	view.Insert(0, 9)
+	view.Remove(1)
+	if actualValue, expectedValue := view.Values(), []int{9, 3, 4, 20, 8}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	view.RemoveIf(func(value int) bool { return value > 5 })
+	if actualValue, expectedValue := view.Size(), 2; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	view.AddAll(New[int](5, 5))
+	view.RemoveRange(2, 3)
+	if actualValue, expectedValue := list.Values(), []int{0, 1, 3, 4, 5, 6, 7}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// nested views resize their parents
+	inner := view.SubList(1, 3)
+	inner.Clear()
+	if actualValue, expectedValue := view.Values(), []int{3}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	inner.Add(1, 2)
+	if actualValue, expectedValue := view.Values(), []int{3, 1, 2}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	view.Clear()
+	if actualValue, expectedValue := list.Values(), []int{0, 1, 6, 7}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue := view.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue, expectedValue := view.String(), "View\n"; !strings.HasSuffix(actualValue, expectedValue) {
+		t.Errorf("Got %v expected suffix %v", actualValue, expectedValue)
+	}
+
+	// structural changes of the list invalidate the view
+	list.Add(8)
+	testutils.ConcurrentModificationPanic(t, func() { view.Size() })
+	testutils.ConcurrentModificationPanic(t, func() { inner.Values() })
+
+	// invalid ranges
+	for _, r := range [][2]int{{-1, 2}, {0, 6}, {3, 2}} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("SubList(%v, %v) should panic", r[0], r[1])
+				}
+			}()
+			list.SubList(r[0], r[1])
+		}()
+	}
+	if actualValue := list.SubList(5, 5).Size(); actualValue != 0 {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+}
+
+func TestListIteratorFailFast(t *testing.T) {
+	list := New[int](1, 2, 3)
+	it := list.Iterator()
+	it.Next()
+	list.Add(4)
+	testutils.ConcurrentModificationPanic(t, func() { it.Next() })
+	testutils.ConcurrentModificationPanic(t, func() { it.Prev() })
+
+	// reset iterator is valid again
+	count := 0
+	for it.Begin(); it.Next(); {
+		count++
+	}
+	if actualValue, expectedValue := count, 4; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// replacing values is not a structural modification
+	it.First()
+	list.Set(0, 10)
+	list.Swap(1, 2)
+	if actualValue := it.Next(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+
+	for _, modify := range []func(){
+		func() { list.Remove(0) },
+		func() { list.Insert(1, 5) },
+		func() { list.Sort(cmp.Compare[int]) },
+		func() { list.RemoveIf(func(value int) bool { return value == 5 }) },
+		func() { list.Clear() },
+	} {
+		list.Add(1, 2, 3)
+		it.First()
+		modify()
+		testutils.ConcurrentModificationPanic(t, func() { it.Next() })
+	}
+
+	list.SetFailFast(false)
+	list.Add(1, 2, 3)
+	it.First()
+	list.Remove(0)
+	it.Next() // no panic
+}
+
+func TestListCapacity(t *testing.T) {
+	list := NewWithCapacity[int](10)
+	if actualValue := list.Capacity(); actualValue != 10 {
+		t.Errorf("Got %v expected %v", actualValue, 10)
+	}
+	allocs := testing.AllocsPerRun(10, func() {
+		for i := 0; i < 10; i++ {
+			list.Add(i)
+		}
+		list.Clear()
+	})
+	if allocs != 0 {
+		t.Errorf("Got %v allocations expected %v", allocs, 0)
+	}
+
+	// does not shrink below the requested capacity
+	list.Add(1, 2, 3)
+	list.Remove(0)
+	list.Remove(0)
+	if actualValue := list.Capacity(); actualValue != 10 {
+		t.Errorf("Got %v expected %v", actualValue, 10)
+	}
+	list.EnsureCapacity(5)
+	if actualValue := list.Capacity(); actualValue != 10 {
+		t.Errorf("Got %v expected %v", actualValue, 10)
+	}
+	list.EnsureCapacity(20)
+	if actualValue := list.Capacity(); actualValue != 20 {
+		t.Errorf("Got %v expected %v", actualValue, 20)
+	}
+	list.TrimToSize()
+	if actualValue := list.Capacity(); actualValue != 1 {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+	if actualValue, ok := list.Get(0); actualValue != 3 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+}
+
+func TestListResizePolicy(t *testing.T) {
+	list := New[int]()
+	list.SetResizePolicy(ResizePolicy{GrowthFactor: 1.5, ShrinkFactor: 0})
+	list.Add(1, 2, 3, 4)
+	if actualValue := list.Capacity(); actualValue != 6 {
+		t.Errorf("Got %v expected %v", actualValue, 6)
+	}
+	list.RemoveRange(0, 4)
+	if actualValue := list.Capacity(); actualValue != 6 {
+		t.Errorf("Got %v expected %v", actualValue, 6)
+	}
+
+	list.SetResizePolicy(ResizePolicy{GrowthFactor: 2, ShrinkFactor: 0.5})
+	list.Add(1, 2, 3, 4, 5)
+	list.Remove(0)
+	if actualValue := list.Capacity(); actualValue != 6 {
+		t.Errorf("Got %v expected %v", actualValue, 6)
+	}
+	list.Remove(0)
+	if actualValue := list.Capacity(); actualValue != 3 {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+
+	for _, policy := range []ResizePolicy{{GrowthFactor: 1}, {GrowthFactor: 2, ShrinkFactor: -1}, {GrowthFactor: 2, ShrinkFactor: 1}} {
+		func() {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Errorf("Expected panic for %+v", policy)
+				}
+			}()
+			list.SetResizePolicy(policy)
+		}()
+	}
+}
+
+func TestListAppendValuesTo(t *testing.T) {
+	list := New[int](1, 2, 3)
+	dst := make([]int, 1, 10)
+	if actualValue, expectedValue := list.AppendValuesTo(dst), []int{0, 1, 2, 3}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	allocs := testing.AllocsPerRun(10, func() {
+		dst = list.AppendValuesTo(dst[:0])
+	})
+	if allocs != 0 {
+		t.Errorf("Got %v allocations expected %v", allocs, 0)
+	}
+	elements := list.Elements()
+	if actualValue, expectedValue := elements, []int{1, 2, 3}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue := cap(elements); actualValue != 3 {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+	if actualValue := New[int]().Elements(); len(actualValue) != 0 {
+		t.Errorf("Got %v expected %v", actualValue, []int{})
+	}
+}
+
 func TestListSerialization(t *testing.T) {
 	list := New[string]()
 	list.Add("a", "b", "c")
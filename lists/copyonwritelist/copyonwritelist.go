@@ -0,0 +1,313 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package copyonwritelist implements a list that is safe for concurrent use, for lists that are read much more often
+// than they are modified.
+//
+// The elements are held in an immutable slice. Readers load the current slice and read it without locking, while
+// writers, serialized by a mutex, copy the slice, modify the copy and atomically swap it in. Every modification thus
+// costs O(n), but reads never wait and iterators see the snapshot of the list they were created on, never failing
+// because of concurrent modifications.
+//
+// Views returned by SubList read the current elements of the list and write through to it. Like the views of other
+// lists they track their range by index, so they fail fast once the size of the list is changed other than through
+// them, and a view is not meant to be shared between goroutines. Views check for modifications under the lock of
+// writers, so that a concurrent writer cannot change the size between the check and the operation.
+//
+// Structure is thread safe.
+//
+// Reference: https://en.wikipedia.org/wiki/Copy-on-write
+package copyonwritelist
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/emirpasic/gods/v2/lists"
+	"github.com/emirpasic/gods/v2/utils"
+)
+
+// Assert List implementation
+var _ lists.List[int] = (*List[int])(nil)
+
+// List holds the elements in an immutable slice that is replaced on every modification
+type List[T comparable] struct {
+	elements atomic.Pointer[[]T]
+	mutex    sync.Mutex   // serializes writers
+	modCount atomic.Int64 // number of modifications that changed the size of the list, checked by views
+}
+
+// New instantiates a new list and adds the passed values, if any, to the list
+func New[T comparable](values ...T) *List[T] {
+	list := &List[T]{}
+	elements := slices.Clone(values)
+	list.elements.Store(&elements)
+	return list
+}
+
+// Add appends a value at the end of the list
+func (list *List[T]) Add(values ...T) {
+	if len(values) == 0 {
+		return
+	}
+	list.update(func(elements []T) []T {
+		return concat(elements, values)
+	})
+}
+
+// AddIfAbsent appends the value at the end of the list unless it is present.
+// Returns true if the value was added.
+func (list *List[T]) AddIfAbsent(value T) bool {
+	added := false
+	list.update(func(elements []T) []T {
+		if slices.Contains(elements, value) {
+			return nil
+		}
+		added = true
+		return concat(elements, []T{value})
+	})
+	return added
+}
+
+// Get returns the element at index.
+// Second return parameter is true if index is within bounds of the array and array is not empty, otherwise false.
+func (list *List[T]) Get(index int) (T, bool) {
+	elements := list.Elements()
+	if index < 0 || index >= len(elements) {
+		var t T
+		return t, false
+	}
+	return elements[index], true
+}
+
+// Remove removes the element at the given index from the list.
+func (list *List[T]) Remove(index int) {
+	list.RemoveRange(index, index+1)
+}
+
+// Contains checks if elements (one or more) are present in the set.
+// All elements have to be present in the set for the method to return true.
+// Performance time complexity of n^2.
+// Returns true if no arguments are passed at all, i.e. set is always super-set of empty set.
+func (list *List[T]) Contains(values ...T) bool {
+	elements := list.Elements()
+	for _, searchValue := range values {
+		if !slices.Contains(elements, searchValue) {
+			return false
+		}
+	}
+	return true
+}
+
+// Values returns all elements in the list.
+func (list *List[T]) Values() []T {
+	return slices.Clone(list.Elements())
+}
+
+// IndexOf returns index of provided element
+func (list *List[T]) IndexOf(value T) int {
+	return slices.Index(list.Elements(), value)
+}
+
+// Empty returns true if list does not contain any elements.
+func (list *List[T]) Empty() bool {
+	return list.Size() == 0
+}
+
+// Size returns number of elements within the list.
+func (list *List[T]) Size() int {
+	return len(list.Elements())
+}
+
+// Clear removes all elements from the list.
+func (list *List[T]) Clear() {
+	list.update(func(elements []T) []T {
+		return []T{}
+	})
+}
+
+// Sort sorts values using a copy of the list.
+func (list *List[T]) Sort(comparator utils.Comparator[T]) {
+	list.update(func(elements []T) []T {
+		if len(elements) < 2 {
+			return nil
+		}
+		elements = slices.Clone(elements)
+		slices.SortFunc(elements, comparator)
+		return elements
+	})
+}
+
+// Swap swaps the two values at the specified positions.
+func (list *List[T]) Swap(i, j int) {
+	list.update(func(elements []T) []T {
+		if i < 0 || i >= len(elements) || j < 0 || j >= len(elements) {
+			return nil
+		}
+		elements = slices.Clone(elements)
+		elements[i], elements[j] = elements[j], elements[i]
+		return elements
+	})
+}
+
+// Insert inserts values at specified index position shifting the value at that position (if any) and any subsequent elements to the right.
+// Does not do anything if position is negative or bigger than list's size
+// Note: position equal to list's size is valid, i.e. append.
+func (list *List[T]) Insert(index int, values ...T) {
+	if len(values) == 0 {
+		return
+	}
+	list.update(func(elements []T) []T {
+		if index < 0 || index > len(elements) {
+			return nil
+		}
+		return concat(elements[:index], values, elements[index:])
+	})
+}
+
+// Set the value at specified index
+// Does not do anything if position is negative or bigger than list's size
+// Note: position equal to list's size is valid, i.e. append.
+func (list *List[T]) Set(index int, value T) {
+	list.update(func(elements []T) []T {
+		switch {
+		case index == len(elements):
+			return concat(elements, []T{value})
+		case index < 0 || index > len(elements):
+			return nil
+		}
+		elements = slices.Clone(elements)
+		elements[index] = value
+		return elements
+	})
+}
+
+// RemoveIf removes all elements for which the predicate returns true.
+// The list is left as is if the predicate returns false for all elements.
+func (list *List[T]) RemoveIf(predicate func(value T) bool) {
+	list.update(func(elements []T) []T {
+		var remaining []T
+		for i, value := range elements {
+			if predicate(value) {
+				if remaining == nil {
+					remaining = make([]T, i, len(elements)-1)
+					copy(remaining, elements)
+				}
+			} else if remaining != nil {
+				remaining = append(remaining, value)
+			}
+		}
+		return remaining
+	})
+}
+
+// RetainIf removes all elements for which the predicate returns false.
+// The list is left as is if the predicate returns true for all elements.
+func (list *List[T]) RetainIf(predicate func(value T) bool) {
+	list.RemoveIf(func(value T) bool { return !predicate(value) })
+}
+
+// RemoveRange removes the elements from index "from" (inclusive) to "to" (exclusive), shifting any subsequent
+// elements to the left.
+// Does not do anything if the range is not within the list.
+func (list *List[T]) RemoveRange(from, to int) {
+	list.update(func(elements []T) []T {
+		if from < 0 || to > len(elements) || from >= to {
+			return nil
+		}
+		return concat(elements[:from], elements[to:])
+	})
+}
+
+// Reverse reverses the order of the elements.
+func (list *List[T]) Reverse() {
+	list.update(func(elements []T) []T {
+		if len(elements) < 2 {
+			return nil
+		}
+		elements = slices.Clone(elements)
+		slices.Reverse(elements)
+		return elements
+	})
+}
+
+// Rotate rotates the elements by the given distance, i.e. the element at index i moves to index
+// (i + distance) modulo Size(). A negative distance rotates towards the front,
+// e.g. [a,b,c,d] rotated by 1 is [d,a,b,c] and rotated by -1 is [b,c,d,a].
+func (list *List[T]) Rotate(distance int) {
+	list.update(func(elements []T) []T {
+		return rotate(elements, distance)
+	})
+}
+
+// AddAll appends all values of another list at the end of the list.
+func (list *List[T]) AddAll(another lists.List[T]) {
+	list.Add(another.Values()...)
+}
+
+// Elements returns the elements in the list without copying them.
+// The returned slice is a snapshot of the list: it must not be modified, but it stays valid and unchanged when the
+// list is modified.
+func (list *List[T]) Elements() []T {
+	elements := *list.elements.Load()
+	return elements[:len(elements):len(elements)]
+}
+
+// String returns a string representation of container
+func (list *List[T]) String() string {
+	str := "CopyOnWriteList\n"
+	elements := list.Elements()
+	values := make([]string, 0, len(elements))
+	for _, value := range elements {
+		values = append(values, fmt.Sprintf("%v", value))
+	}
+	str += strings.Join(values, ", ")
+	return str
+}
+
+// update replaces the elements by the result of f, which is passed the current elements and must not modify them.
+// Writers are serialized, so that f sees the elements as last replaced. Returning nil leaves the list as is.
+func (list *List[T]) update(f func(elements []T) []T) {
+	list.mutex.Lock()
+	defer list.mutex.Unlock()
+	list.replace(f)
+}
+
+// replace replaces the elements by the result of f, see update. The caller must hold the lock of writers.
+func (list *List[T]) replace(f func(elements []T) []T) {
+	current := list.Elements()
+	if elements := f(current); elements != nil {
+		list.elements.Store(&elements)
+		if len(elements) != len(current) {
+			list.modCount.Add(1)
+		}
+	}
+}
+
+// rotate returns a new slice with the elements rotated by the given distance, see List.Rotate,
+// or nil if that does not change their order.
+func rotate[T any](elements []T, distance int) []T {
+	n := len(elements)
+	if n < 2 || distance%n == 0 {
+		return nil
+	}
+	split := n - (distance%n+n)%n
+	return concat(elements[split:], elements[:split])
+}
+
+// concat returns a new slice with the elements of the slices one after the other.
+func concat[T any](parts ...[]T) []T {
+	n := 0
+	for _, part := range parts {
+		n += len(part)
+	}
+	result := make([]T, 0, n)
+	for _, part := range parts {
+		result = append(result, part...)
+	}
+	return result
+}
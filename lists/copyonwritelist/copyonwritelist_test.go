@@ -0,0 +1,388 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package copyonwritelist
+
+import (
+	"cmp"
+	"encoding/json"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/emirpasic/gods/v2/containers"
+	"github.com/emirpasic/gods/v2/lists"
+	"github.com/emirpasic/gods/v2/lists/arraylist"
+	"github.com/emirpasic/gods/v2/testutils"
+)
+
+func TestListNew(t *testing.T) {
+	list1 := New[int]()
+	if actualValue := list1.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+
+	values := []int{1, 2}
+	list2 := New(values...)
+	values[0] = 3 // the list holds a copy
+	if actualValue := list2.Size(); actualValue != 2 {
+		t.Errorf("Got %v expected %v", actualValue, 2)
+	}
+	if actualValue, ok := list2.Get(0); actualValue != 1 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+	if actualValue, ok := list2.Get(2); actualValue != 0 || ok {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+}
+
+func TestListAdd(t *testing.T) {
+	list := New[string]()
+	list.Add("a")
+	list.Add("b", "c")
+	list.Add()
+	if actualValue, expectedValue := list.Values(), []string{"a", "b", "c"}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue := list.AddIfAbsent("b"); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if actualValue := list.AddIfAbsent("d"); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := list.IndexOf("d"); actualValue != 3 {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+	if actualValue := list.Contains("a", "d"); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := list.Contains("a", "e"); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+}
+
+func TestListModifications(t *testing.T) {
+	list := New("a", "b", "c")
+
+	// operation,expectedValues
+	tests := []struct {
+		modify   func()
+		expected []string
+	}{
+		{func() { list.Insert(1, "x", "y") }, []string{"a", "x", "y", "b", "c"}},
+		{func() { list.Insert(5, "z") }, []string{"a", "x", "y", "b", "c", "z"}},
+		{func() { list.Insert(7, "q") }, []string{"a", "x", "y", "b", "c", "z"}},
+		{func() { list.Remove(5) }, []string{"a", "x", "y", "b", "c"}},
+		{func() { list.Remove(-1) }, []string{"a", "x", "y", "b", "c"}},
+		{func() { list.RemoveRange(1, 3) }, []string{"a", "b", "c"}},
+		{func() { list.Set(0, "d") }, []string{"d", "b", "c"}},
+		{func() { list.Set(3, "e") }, []string{"d", "b", "c", "e"}},
+		{func() { list.Set(5, "f") }, []string{"d", "b", "c", "e"}},
+		{func() { list.Swap(0, 3) }, []string{"e", "b", "c", "d"}},
+		{func() { list.Sort(cmp.Compare[string]) }, []string{"b", "c", "d", "e"}},
+		{func() { list.Reverse() }, []string{"e", "d", "c", "b"}},
+		{func() { list.Rotate(1) }, []string{"b", "e", "d", "c"}},
+		{func() { list.Rotate(-5) }, []string{"e", "d", "c", "b"}},
+		{func() { list.RemoveIf(func(value string) bool { return value < "d" }) }, []string{"e", "d"}},
+		{func() { list.RetainIf(func(value string) bool { return value != "x" }) }, []string{"e", "d"}},
+		{func() { list.AddAll(arraylist.New("f", "g")) }, []string{"e", "d", "f", "g"}},
+		{func() { list.Clear() }, []string{}},
+	}
+
+	for i, test := range tests {
+		test.modify()
+		if actualValue := list.Values(); !slices.Equal(actualValue, test.expected) {
+			t.Errorf("[%d] Got %v expected %v", i, actualValue, test.expected)
+		}
+	}
+}
+
+func TestListSnapshot(t *testing.T) {
+	list := New(1, 2, 3)
+	elements := list.Elements()
+	it := list.Iterator()
+
+	list.Set(0, 10)
+	list.Add(4)
+	list.Remove(1)
+
+	if actualValue, expectedValue := elements, []int{1, 2, 3}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	var values []int
+	for it.Next() {
+		values = append(values, it.Value())
+	}
+	if actualValue, expectedValue := values, []int{1, 2, 3}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := list.Values(), []int{10, 3, 4}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// appending to the snapshot does not write to the list
+	_ = append(elements, 5)
+	if actualValue, expectedValue := list.Values(), []int{10, 3, 4}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestListSubList(t *testing.T) {
+	list := New("a", "b", "c", "d")
+	view := list.SubList(1, 3)
+	if actualValue, expectedValue := view.Values(), []string{"b", "c"}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// writes go through to the list and the other way around
+	view.Add("x")
+	view.Set(0, "y")
+	list.Set(3, "z")
+	if actualValue, expectedValue := list.Values(), []string{"a", "y", "c", "z", "d"}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := view.Values(), []string{"y", "c", "z"}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	view.Sort(cmp.Compare[string])
+	view.Rotate(1)
+	view.Reverse()
+	if actualValue, expectedValue := list.Values(), []string{"a", "y", "c", "z", "d"}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	view.RemoveIf(func(value string) bool { return value == "c" })
+	view.SubList(1, 2).Clear()
+	if actualValue, expectedValue := list.Values(), []string{"a", "y", "d"}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue := view.Size(); actualValue != 1 {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+	if actualValue, expectedValue := view.String(), "CopyOnWriteListView\n"; !strings.HasPrefix(actualValue, expectedValue) {
+		t.Errorf("Got %v expected prefix %v", actualValue, expectedValue)
+	}
+
+	// snapshots taken before a write through the view are not changed
+	elements := list.Elements()
+	view.Insert(0, "b")
+	if actualValue, expectedValue := elements, []string{"a", "y", "d"}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// changing the size of the list other than through the view invalidates the view
+	list.Add("e")
+	testutils.ConcurrentModificationPanic(t, func() { view.Size() })
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("Got no panic expected a panic for an invalid range")
+		}
+	}()
+	list.SubList(3, 6)
+}
+
+func TestListEnumerable(t *testing.T) {
+	list := New(1, 2, 3, 4)
+
+	sum := 0
+	list.Each(func(index int, value int) { sum += value })
+	if actualValue := sum; actualValue != 10 {
+		t.Errorf("Got %v expected %v", actualValue, 10)
+	}
+	if actualValue, expectedValue := list.Map(func(index int, value int) int { return value * index }).Values(), []int{0, 2, 6, 12}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := list.Select(func(index int, value int) bool { return value%2 == 0 }).Values(), []int{2, 4}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue := list.Any(func(index int, value int) bool { return value > 3 }); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := list.All(func(index int, value int) bool { return value > 1 }); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if index, value := list.Find(func(index int, value int) bool { return value > 2 }); index != 2 || value != 3 {
+		t.Errorf("Got %v, %v expected %v, %v", index, value, 2, 3)
+	}
+}
+
+func TestListIterator(t *testing.T) {
+	list := New("a", "b", "c")
+	it := list.Iterator()
+	if actualValue := it.Prev(); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	count := 0
+	for it.Next() {
+		count++
+		if actualValue, expectedValue := it.Index(), count-1; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+	if actualValue := count; actualValue != 3 {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+	if actualValue := it.Last(); actualValue != true || it.Value() != "c" {
+		t.Errorf("Got %v, %v expected %v, %v", actualValue, it.Value(), true, "c")
+	}
+	if actualValue := it.PrevTo(func(index int, value string) bool { return value == "a" }); actualValue != true || it.Index() != 0 {
+		t.Errorf("Got %v, %v expected %v, %v", actualValue, it.Index(), true, 0)
+	}
+	if actualValue := it.NextTo(func(index int, value string) bool { return value == "x" }); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if actualValue := it.First(); actualValue != true || it.Value() != "a" {
+		t.Errorf("Got %v, %v expected %v, %v", actualValue, it.Value(), true, "a")
+	}
+}
+
+func TestListConcurrent(t *testing.T) {
+	const writers, count = 4, 200
+	list := New[int]()
+
+	var wg sync.WaitGroup
+	for w := 0; w < writers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < count; i++ {
+				list.Add(w*count + i)
+				if i%2 == 1 {
+					list.RemoveIf(func(value int) bool { return value == w*count+i-1 })
+				}
+			}
+		}(w)
+	}
+
+	done := make(chan struct{})
+	var readers sync.WaitGroup
+	for r := 0; r < 2; r++ {
+		readers.Add(1)
+		go func() {
+			defer readers.Done()
+			for {
+				select {
+				case <-done:
+					return
+				default:
+				}
+				// elements of each writer are in the order they were added
+				last := make(map[int]int)
+				for it := list.Iterator(); it.Next(); {
+					w := it.Value() / count
+					if previous, ok := last[w]; ok && previous >= it.Value() {
+						t.Errorf("Got %v after %v", it.Value(), previous)
+					}
+					last[w] = it.Value()
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	close(done)
+	readers.Wait()
+
+	if actualValue, expectedValue := list.Size(), writers*count/2; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	list.Sort(cmp.Compare[int])
+	for i, value := range list.Elements() {
+		if expectedValue := 2*i + 1; value != expectedValue {
+			t.Fatalf("Got %v expected %v", value, expectedValue)
+		}
+	}
+}
+
+func TestListSubListConcurrent(t *testing.T) {
+	const count = 300000
+	list := New(0, 1, 2, 3, 4, 5, 6, 7, 8, 9)
+
+	// the list shrinks to the first five elements and grows back while views of the last five operate
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < count; i++ {
+			list.RemoveRange(5, 10)
+			list.Add(5, 6, 7, 8, 9)
+		}
+	}()
+	for running := true; running; {
+		select {
+		case <-done:
+			running = false
+		default:
+		}
+		func() {
+			defer func() {
+				if r := recover(); r != nil {
+					if _, ok := r.(*containers.ConcurrentModificationError); !ok {
+						t.Fatalf("Got %v expected %v", r, "ConcurrentModificationError panic")
+					}
+				}
+			}()
+			view := subList(list, 5, 10)
+			if view == nil {
+				return
+			}
+			view.Set(0, 5)
+			view.Get(4)
+			view.Reverse()
+			view.Reverse()
+			view.Values()
+		}()
+	}
+
+	if actualValue, expectedValue := list.Values(), []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+// subList returns the view of the range of the list, or nil if the range is not within the list.
+func subList(list *List[int], from, to int) (view lists.List[int]) {
+	defer func() {
+		if recover() != nil {
+			view = nil
+		}
+	}()
+	return list.SubList(from, to)
+}
+
+func TestListSerialization(t *testing.T) {
+	list := New("a", "b", "c")
+
+	var err error
+	assert := func() {
+		if actualValue, expectedValue := list.Values(), []string{"a", "b", "c"}; !slices.Equal(actualValue, expectedValue) {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		if err != nil {
+			t.Errorf("Got error %v", err)
+		}
+	}
+
+	assert()
+
+	bytes, err := list.ToJSON()
+	assert()
+
+	err = list.FromJSON(bytes)
+	assert()
+
+	bytes, err = json.Marshal([]interface{}{"a", "b", "c", list})
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+
+	err = json.Unmarshal([]byte(`["a","b","c"]`), &list)
+	assert()
+}
+
+func TestListString(t *testing.T) {
+	c := New[int]()
+	c.Add(1)
+	if !strings.HasPrefix(c.String(), "CopyOnWriteList") {
+		t.Errorf("String should start with container name")
+	}
+}
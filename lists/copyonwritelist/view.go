@@ -0,0 +1,165 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package copyonwritelist
+
+import (
+	"slices"
+
+	"github.com/emirpasic/gods/v2/containers"
+	"github.com/emirpasic/gods/v2/lists"
+	"github.com/emirpasic/gods/v2/utils"
+)
+
+// Assert Backing implementation
+var _ lists.ConcurrentBacking[int] = backing[int]{}
+
+// SubList returns a live view of the elements of the list from index "from" (inclusive) to "to" (exclusive),
+// see lists.View. The view reads the current elements of the list and every write through it replaces them.
+// Operations of the view lock the list like writers do, so that they check for modifications atomically.
+// Panics if the range is not within the list, i.e. unless 0 <= from <= to <= Size().
+func (list *List[T]) SubList(from, to int) lists.List[T] {
+	return lists.NewView[T](backing[T]{list: list}, from, to)
+}
+
+// backing exposes the list to its views.
+type backing[T comparable] struct {
+	list     *List[T]
+	modCount *int // modification count the operating view is in sync with, nil until a view expects one
+}
+
+func (b backing[T]) Name() string {
+	return "CopyOnWriteList"
+}
+
+func (b backing[T]) ModCount() int {
+	return int(b.list.modCount.Load())
+}
+
+func (b backing[T]) FailFast() bool {
+	return true
+}
+
+func (b backing[T]) Expect(modCount *int) lists.Backing[T] {
+	return backing[T]{list: b.list, modCount: modCount}
+}
+
+func (b backing[T]) Size() int {
+	return b.list.Size()
+}
+
+func (b backing[T]) Get(index int) (value T) {
+	b.update(func(elements []T) []T {
+		value = elements[index]
+		return nil
+	})
+	return value
+}
+
+func (b backing[T]) Set(index int, value T) {
+	b.update(func(elements []T) []T {
+		elements = slices.Clone(elements)
+		elements[index] = value
+		return elements
+	})
+}
+
+func (b backing[T]) Swap(i, j int) {
+	b.update(func(elements []T) []T {
+		elements = slices.Clone(elements)
+		elements[i], elements[j] = elements[j], elements[i]
+		return elements
+	})
+}
+
+func (b backing[T]) Insert(index int, values ...T) {
+	b.update(func(elements []T) []T {
+		return concat(elements[:index], values, elements[index:])
+	})
+}
+
+func (b backing[T]) Values(from, to int) (values []T) {
+	b.update(func(elements []T) []T {
+		values = slices.Clone(elements[from:to])
+		return nil
+	})
+	return values
+}
+
+func (b backing[T]) RemoveRange(from, to int) {
+	b.update(func(elements []T) []T {
+		return concat(elements[:from], elements[to:])
+	})
+}
+
+func (b backing[T]) Reverse(from, to int) {
+	b.updateRange(from, to, func(elements []T) []T {
+		if len(elements) < 2 {
+			return nil
+		}
+		elements = slices.Clone(elements)
+		slices.Reverse(elements)
+		return elements
+	})
+}
+
+func (b backing[T]) Rotate(from, to, distance int) {
+	b.updateRange(from, to, func(elements []T) []T {
+		return rotate(elements, distance)
+	})
+}
+
+func (b backing[T]) RemoveIf(from, to int, predicate func(value T) bool) int {
+	removed := 0
+	b.updateRange(from, to, func(elements []T) []T {
+		remaining := make([]T, 0, len(elements))
+		for _, value := range elements {
+			if !predicate(value) {
+				remaining = append(remaining, value)
+			}
+		}
+		if removed = len(elements) - len(remaining); removed == 0 {
+			return nil
+		}
+		return remaining
+	})
+	return removed
+}
+
+func (b backing[T]) Sort(from, to int, comparator utils.Comparator[T]) {
+	b.updateRange(from, to, func(elements []T) []T {
+		if len(elements) < 2 {
+			return nil
+		}
+		elements = slices.Clone(elements)
+		slices.SortFunc(elements, comparator)
+		return elements
+	})
+}
+
+// update replaces the elements by the result of f, see List.update. Under the lock of writers it first checks that
+// the list was not structurally modified since the view was in sync with it, so that the indices of the view are
+// still valid for the elements passed to f, and afterwards brings the view up to date.
+func (b backing[T]) update(f func(elements []T) []T) {
+	b.list.mutex.Lock()
+	defer b.list.mutex.Unlock()
+	if b.modCount != nil && *b.modCount != b.ModCount() {
+		panic(&containers.ConcurrentModificationError{Container: b.Name()})
+	}
+	b.list.replace(f)
+	if b.modCount != nil {
+		*b.modCount = b.ModCount()
+	}
+}
+
+// updateRange replaces the elements within the range by the result of f, see update.
+func (b backing[T]) updateRange(from, to int, f func(elements []T) []T) {
+	b.update(func(elements []T) []T {
+		replaced := f(elements[from:to])
+		if replaced == nil {
+			return nil
+		}
+		return concat(elements[:from], replaced, elements[to:])
+	})
+}
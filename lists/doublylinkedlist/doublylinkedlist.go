@@ -4,6 +4,9 @@
 
 // Package doublylinkedlist implements the doubly-linked list.
 //
+// Methods like Append, Prepend, InsertBefore and InsertAfter return element handles, through which elements can be
+// removed and moved in constant time, similar to container/list.
+//
 // Structure is not thread safe.
 //
 // Reference: https://en.wikipedia.org/wiki/List_%28abstract_data_type%29
@@ -11,27 +14,45 @@ package doublylinkedlist
 
 import (
 	"fmt"
-	"slices"
 	"strings"
 
+	"github.com/emirpasic/gods/v2/containers"
 	"github.com/emirpasic/gods/v2/lists"
 	"github.com/emirpasic/gods/v2/utils"
 )
 
 // Assert List implementation
 var _ lists.List[any] = (*List[any])(nil)
+var _ containers.FailFast = (*List[any])(nil)
 
 // List holds the elements, where each element points to the next and previous element
 type List[T comparable] struct {
-	first *element[T]
-	last  *element[T]
-	size  int
+	first            *Element[T]
+	last             *Element[T]
+	size             int
+	owner            *owner[T] // owner of the elements of the list, created on first use
+	modCount         int       // number of structural modifications, checked by iterators
+	failFastDisabled bool      // iterators do not check for modifications
 }
 
-type element[T comparable] struct {
-	value T
-	prev  *element[T]
-	next  *element[T]
+// Element is a handle to a value stored in the list.
+//
+// A handle stays valid while its element is in the list, i.e. until it is removed by index, by RemoveElement,
+// through an iterator, or the list is cleared. Methods taking handles ignore invalid ones.
+type Element[T comparable] struct {
+	Value T // value stored with this element
+	prev  *Element[T]
+	next  *Element[T]
+	owner *owner[T] // nil once the element is removed
+}
+
+// owner identifies the list an element belongs to.
+//
+// Splicing a list into another one forwards the owner of the moved elements to the owner of the receiving list,
+// so that ownership changes in O(1) without touching the elements. Forwarding chains are shortened on lookup.
+type owner[T comparable] struct {
+	list    *List[T] // nil once the owner is retired
+	forward *owner[T]
 }
 
 // New instantiates a new list and adds the passed values, if any, to the list
@@ -45,8 +66,10 @@ func New[T comparable](values ...T) *List[T] {
 
 // Add appends a value (one or more) at the end of the list (same as Append())
 func (list *List[T]) Add(values ...T) {
+	list.modCount++
 	for _, value := range values {
-		newElement := &element[T]{value: value, prev: list.last}
+		newElement := list.newElement(value)
+		newElement.prev = list.last
 		if list.size == 0 {
 			list.first = newElement
 			list.last = newElement
@@ -59,15 +82,30 @@ func (list *List[T]) Add(values ...T) {
 }
 
 // Append appends a value (one or more) at the end of the list (same as Add())
-func (list *List[T]) Append(values ...T) {
+// Returns the element holding the first of the values, the others follow it, or nil if no values were passed.
+func (list *List[T]) Append(values ...T) *Element[T] {
+	last := list.last
 	list.Add(values...)
+	if len(values) == 0 {
+		return nil
+	}
+	if last == nil {
+		return list.first
+	}
+	return last.next
 }
 
 // Prepend prepends a values (or more)
-func (list *List[T]) Prepend(values ...T) {
+// Returns the element holding the first of the values, i.e. the new front of the list, or nil if no values were passed.
+func (list *List[T]) Prepend(values ...T) *Element[T] {
+	if len(values) == 0 {
+		return nil
+	}
+	list.modCount++
 	// in reverse to keep passed order i.e. ["c","d"] -> Prepend(["a","b"]) -> ["a","b","c",d"]
 	for v := len(values) - 1; v >= 0; v-- {
-		newElement := &element[T]{value: values[v], next: list.first}
+		newElement := list.newElement(values[v])
+		newElement.next = list.first
 		if list.size == 0 {
 			list.first = newElement
 			list.last = newElement
@@ -77,6 +115,7 @@ func (list *List[T]) Prepend(values ...T) {
 		}
 		list.size++
 	}
+	return list.first
 }
 
 // Get returns the element at index.
@@ -93,12 +132,12 @@ func (list *List[T]) Get(index int) (T, bool) {
 		element := list.last
 		for e := list.size - 1; e != index; e, element = e-1, element.prev {
 		}
-		return element.value, true
+		return element.Value, true
 	}
 	element := list.first
 	for e := 0; e != index; e, element = e+1, element.next {
 	}
-	return element.value, true
+	return element.Value, true
 }
 
 // Remove removes the element at the given index from the list.
@@ -108,39 +147,7 @@ func (list *List[T]) Remove(index int) {
 		return
 	}
 
-	if list.size == 1 {
-		list.Clear()
-		return
-	}
-
-	var element *element[T]
-	// determine traversal direction, last to first or first to last
-	if list.size-index < index {
-		element = list.last
-		for e := list.size - 1; e != index; e, element = e-1, element.prev {
-		}
-	} else {
-		element = list.first
-		for e := 0; e != index; e, element = e+1, element.next {
-		}
-	}
-
-	if element == list.first {
-		list.first = element.next
-	}
-	if element == list.last {
-		list.last = element.prev
-	}
-	if element.prev != nil {
-		element.prev.next = element.next
-	}
-	if element.next != nil {
-		element.next.prev = element.prev
-	}
-
-	element = nil
-
-	list.size--
+	list.remove(list.elementAt(index))
 }
 
 // Contains check if values (one or more) are present in the set.
@@ -158,7 +165,7 @@ func (list *List[T]) Contains(values ...T) bool {
 	for _, value := range values {
 		found := false
 		for element := list.first; element != nil; element = element.next {
-			if element.value == value {
+			if element.Value == value {
 				found = true
 				break
 			}
@@ -174,7 +181,7 @@ func (list *List[T]) Contains(values ...T) bool {
 func (list *List[T]) Values() []T {
 	values := make([]T, list.size, list.size)
 	for e, element := 0, list.first; element != nil; e, element = e+1, element.next {
-		values[e] = element.value
+		values[e] = element.Value
 	}
 	return values
 }
@@ -203,32 +210,48 @@ func (list *List[T]) Size() int {
 }
 
 // Clear removes all elements from the list.
+// Handles of the removed elements become invalid.
 func (list *List[T]) Clear() {
+	list.modCount++
 	list.size = 0
 	list.first = nil
 	list.last = nil
+	if list.owner != nil {
+		// invalidates all handles at once, including the ones of spliced lists forwarded to this owner
+		list.owner.list = nil
+		list.owner = nil
+	}
 }
 
 // Sort sorts values (in-place) using a Comparator.
+// The sort is a stable merge sort that relinks the elements in O(n log n) without allocating,
+// so handles keep referring to their values.
 func (list *List[T]) Sort(comparator utils.Comparator[T]) {
+	list.sortRange(0, list.size, comparator)
+}
 
-	if list.size < 2 {
+// Merge moves all elements of the other list into this list in O(n+m) without allocating, leaving the other list empty.
+// Both lists have to be sorted by the comparator, the result is sorted as well. Values of this list come before equal
+// values of the other list. Handles of the moved elements stay valid and now refer to this list, see Splice.
+func (list *List[T]) Merge(other *List[T], comparator utils.Comparator[T]) {
+	if other == list || other.size == 0 {
 		return
 	}
-
-	values := list.Values()
-	slices.SortFunc(values, comparator)
-
-	list.Clear()
-
-	list.Add(values...)
-
+	first, otherFirst := list.first, other.first
+	list.Splice(other)
+	if first == nil {
+		return
+	}
+	otherFirst.prev.next = nil
+	first, last := merge(first, otherFirst, comparator)
+	list.linkChain(nil, first)
+	list.last = last
 }
 
 // Swap swaps values of two elements at the given indices.
 func (list *List[T]) Swap(i, j int) {
 	if list.withinRange(i) && list.withinRange(j) && i != j {
-		var element1, element2 *element[T]
+		var element1, element2 *Element[T]
 		for e, currentElement := 0, list.first; element1 == nil || element2 == nil; e, currentElement = e+1, currentElement.next {
 			switch e {
 			case i:
@@ -237,7 +260,7 @@ func (list *List[T]) Swap(i, j int) {
 				element2 = currentElement
 			}
 		}
-		element1.value, element2.value = element2.value, element1.value
+		element1.Value, element2.Value = element2.Value, element1.Value
 	}
 }
 
@@ -245,58 +268,24 @@ func (list *List[T]) Swap(i, j int) {
 // Does not do anything if position is negative or bigger than list's size
 // Note: position equal to list's size is valid, i.e. append.
 func (list *List[T]) Insert(index int, values ...T) {
+	list.InsertAt(index, values...)
+}
 
-	if !list.withinRange(index) {
-		// Append
-		if index == list.size {
-			list.Add(values...)
-		}
-		return
+// InsertAt inserts values at specified index position like Insert and returns the element holding the first of them,
+// the others follow it. Returns nil if nothing was inserted.
+func (list *List[T]) InsertAt(index int, values ...T) *Element[T] {
+	if len(values) == 0 || index < 0 || index > list.size {
+		return nil
 	}
-
-	var beforeElement *element[T]
-	var foundElement *element[T]
-	// determine traversal direction, last to first or first to last
-	if list.size-index < index {
-		foundElement = list.last
-		beforeElement = list.last.prev
-		for e := list.size - 1; e != index; e, foundElement = e-1, foundElement.prev {
-			beforeElement = beforeElement.prev
-		}
-	} else {
-		foundElement = list.first
-		for e := 0; e != index; e, foundElement = e+1, foundElement.next {
-			beforeElement = foundElement
-		}
+	if index == list.size {
+		return list.Append(values...)
 	}
-
-	if foundElement == list.first {
-		oldNextElement := list.first
-		for i, value := range values {
-			newElement := &element[T]{value: value}
-			if i == 0 {
-				list.first = newElement
-			} else {
-				newElement.prev = beforeElement
-				beforeElement.next = newElement
-			}
-			beforeElement = newElement
-		}
-		oldNextElement.prev = beforeElement
-		beforeElement.next = oldNextElement
-	} else {
-		oldNextElement := beforeElement.next
-		for _, value := range values {
-			newElement := &element[T]{value: value}
-			newElement.prev = beforeElement
-			beforeElement.next = newElement
-			beforeElement = newElement
-		}
-		oldNextElement.prev = beforeElement
-		beforeElement.next = oldNextElement
+	mark := list.elementAt(index)
+	first := list.InsertBefore(mark, values[0])
+	for _, value := range values[1:] {
+		list.InsertBefore(mark, value)
 	}
-
-	list.size += len(values)
+	return first
 }
 
 // Set value at specified index position
@@ -312,7 +301,7 @@ func (list *List[T]) Set(index int, value T) {
 		return
 	}
 
-	var foundElement *element[T]
+	var foundElement *Element[T]
 	// determine traversal direction, last to first or first to last
 	if list.size-index < index {
 		foundElement = list.last
@@ -327,7 +316,166 @@ func (list *List[T]) Set(index int, value T) {
 		}
 	}
 
-	foundElement.value = value
+	foundElement.Value = value
+}
+
+// RemoveIf removes all elements for which the predicate returns true, in a single pass over the list.
+func (list *List[T]) RemoveIf(predicate func(value T) bool) {
+	list.removeIf(0, list.size, predicate)
+}
+
+// RetainIf removes all elements for which the predicate returns false, in a single pass over the list.
+func (list *List[T]) RetainIf(predicate func(value T) bool) {
+	list.RemoveIf(func(value T) bool { return !predicate(value) })
+}
+
+// RemoveRange removes the elements from index "from" (inclusive) to "to" (exclusive).
+// Does not do anything if the range is not within the list.
+func (list *List[T]) RemoveRange(from, to int) {
+	if from < 0 || to > list.size || from >= to {
+		return
+	}
+	list.removeRange(from, to)
+}
+
+// Reverse reverses the order of the elements in place by relinking them, handles stay valid.
+func (list *List[T]) Reverse() {
+	list.reverse(0, list.size)
+}
+
+// Rotate rotates the elements by the given distance, i.e. the element at index i moves to index
+// (i + distance) modulo Size(). A negative distance rotates towards the front,
+// e.g. [a,b,c,d] rotated by 1 is [d,a,b,c] and rotated by -1 is [b,c,d,a].
+// Elements are relinked in O(1) once the new front is found, handles stay valid.
+func (list *List[T]) Rotate(distance int) {
+	list.rotate(0, list.size, distance)
+}
+
+// AddAll appends all values of another list at the end of the list.
+func (list *List[T]) AddAll(another lists.List[T]) {
+	list.Add(another.Values()...)
+}
+
+// Front returns the first element of the list or nil if the list is empty.
+func (list *List[T]) Front() *Element[T] {
+	return list.first
+}
+
+// Back returns the last element of the list or nil if the list is empty.
+func (list *List[T]) Back() *Element[T] {
+	return list.last
+}
+
+// InsertBefore inserts the value in front of the mark element in O(1) and returns the new element.
+// Returns nil if mark is not an element of the list.
+func (list *List[T]) InsertBefore(mark *Element[T], value T) *Element[T] {
+	if !list.has(mark) {
+		return nil
+	}
+	newElement := list.newElement(value)
+	list.linkBefore(newElement, mark)
+	return newElement
+}
+
+// InsertAfter inserts the value after the mark element in O(1) and returns the new element.
+// Returns nil if mark is not an element of the list.
+func (list *List[T]) InsertAfter(mark *Element[T], value T) *Element[T] {
+	if !list.has(mark) {
+		return nil
+	}
+	newElement := list.newElement(value)
+	list.linkAfter(newElement, mark)
+	return newElement
+}
+
+// RemoveElement removes the element from the list in O(1), its handle becomes invalid.
+// Returns false if e is not an element of the list.
+func (list *List[T]) RemoveElement(e *Element[T]) bool {
+	if !list.has(e) {
+		return false
+	}
+	list.remove(e)
+	return true
+}
+
+// MoveToFront moves the element to the front of the list in O(1).
+// Does nothing if e is not an element of the list.
+func (list *List[T]) MoveToFront(e *Element[T]) {
+	if list.has(e) && list.first != e {
+		list.unlink(e)
+		list.linkBefore(e, list.first)
+	}
+}
+
+// MoveToBack moves the element to the back of the list in O(1).
+// Does nothing if e is not an element of the list.
+func (list *List[T]) MoveToBack(e *Element[T]) {
+	if list.has(e) && list.last != e {
+		list.unlink(e)
+		list.linkAfter(e, list.last)
+	}
+}
+
+// MoveBefore moves the element in front of the mark element in O(1).
+// Does nothing if e or mark is not an element of the list, or they are the same element.
+func (list *List[T]) MoveBefore(e, mark *Element[T]) {
+	if e != mark && list.has(e) && list.has(mark) {
+		list.unlink(e)
+		list.linkBefore(e, mark)
+	}
+}
+
+// MoveAfter moves the element after the mark element in O(1).
+// Does nothing if e or mark is not an element of the list, or they are the same element.
+func (list *List[T]) MoveAfter(e, mark *Element[T]) {
+	if e != mark && list.has(e) && list.has(mark) {
+		list.unlink(e)
+		list.linkAfter(e, mark)
+	}
+}
+
+// Splice moves all elements of the other list to the end of this list in O(1), leaving the other list empty.
+// Handles of the moved elements stay valid and now refer to this list.
+func (list *List[T]) Splice(other *List[T]) {
+	if other == list || other.size == 0 {
+		return
+	}
+	if list.size == 0 {
+		list.first = other.first
+	} else {
+		list.last.next = other.first
+		other.first.prev = list.last
+	}
+	list.last = other.last
+	list.size += other.size
+	list.modCount++
+	// the other list keeps no owner, so that its future elements are not forwarded to this list
+	other.owner.forward = list.elementOwner()
+	other.owner = nil
+	other.first, other.last, other.size = nil, nil, 0
+	other.modCount++
+}
+
+// Next returns the next element of the list or nil if there is none or the element is not in a list anymore.
+func (e *Element[T]) Next() *Element[T] {
+	if !e.valid() {
+		return nil
+	}
+	return e.next
+}
+
+// Prev returns the previous element of the list or nil if there is none or the element is not in a list anymore.
+func (e *Element[T]) Prev() *Element[T] {
+	if !e.valid() {
+		return nil
+	}
+	return e.prev
+}
+
+// SetFailFast enables or disables the checks of iterators for modifications of the list done other than through
+// the iterator itself, see containers.ConcurrentModificationError. Checks are enabled by default.
+func (list *List[T]) SetFailFast(enabled bool) {
+	list.failFastDisabled = !enabled
 }
 
 // String returns a string representation of container
@@ -335,7 +483,7 @@ func (list *List[T]) String() string {
 	str := "DoublyLinkedList\n"
 	values := []string{}
 	for element := list.first; element != nil; element = element.next {
-		values = append(values, fmt.Sprintf("%v", element.value))
+		values = append(values, fmt.Sprintf("%v", element.Value))
 	}
 	str += strings.Join(values, ", ")
 	return str
@@ -345,3 +493,288 @@ func (list *List[T]) String() string {
 func (list *List[T]) withinRange(index int) bool {
 	return index >= 0 && index < list.size
 }
+
+// unlink removes the element from the list.
+func (list *List[T]) unlink(e *Element[T]) {
+	list.modCount++
+	if e.prev == nil {
+		list.first = e.next
+	} else {
+		e.prev.next = e.next
+	}
+	if e.next == nil {
+		list.last = e.prev
+	} else {
+		e.next.prev = e.prev
+	}
+	list.size--
+}
+
+// remove unlinks the element from the list and invalidates its handle.
+func (list *List[T]) remove(e *Element[T]) {
+	list.unlink(e)
+	e.prev, e.next, e.owner = nil, nil, nil
+}
+
+// linkBefore inserts the new element in front of the mark element of the list.
+func (list *List[T]) linkBefore(e, mark *Element[T]) {
+	list.modCount++
+	e.prev, e.next = mark.prev, mark
+	if mark.prev == nil {
+		list.first = e
+	} else {
+		mark.prev.next = e
+	}
+	mark.prev = e
+	list.size++
+}
+
+// linkAfter inserts the new element after the mark element of the list.
+func (list *List[T]) linkAfter(e, mark *Element[T]) {
+	list.modCount++
+	e.prev, e.next = mark, mark.next
+	if mark.next == nil {
+		list.last = e
+	} else {
+		mark.next.prev = e
+	}
+	mark.next = e
+	list.size++
+}
+
+// elementAt returns the element at the index, which has to be within range.
+func (list *List[T]) elementAt(index int) *Element[T] {
+	// determine traversal direction, last to first or first to last
+	if list.size-index < index {
+		element := list.last
+		for e := list.size - 1; e != index; e, element = e-1, element.prev {
+		}
+		return element
+	}
+	element := list.first
+	for e := 0; e != index; e, element = e+1, element.next {
+	}
+	return element
+}
+
+// newElement returns a new element owned by the list, which still has to be linked into it.
+func (list *List[T]) newElement(value T) *Element[T] {
+	return &Element[T]{Value: value, owner: list.elementOwner()}
+}
+
+// elementOwner returns the owner of the list's elements, creating it if needed.
+func (list *List[T]) elementOwner() *owner[T] {
+	if list.owner == nil {
+		list.owner = &owner[T]{list: list}
+	}
+	return list.owner
+}
+
+// has returns true if the element belongs to the list.
+func (list *List[T]) has(e *Element[T]) bool {
+	return e != nil && e.owner != nil && e.owner.resolve() == list
+}
+
+// valid returns true if the element belongs to a list.
+func (e *Element[T]) valid() bool {
+	return e.owner != nil && e.owner.resolve() != nil
+}
+
+// resolve returns the list the owner's elements belong to, or nil if they were removed.
+func (o *owner[T]) resolve() *List[T] {
+	root := o
+	for root.forward != nil {
+		root = root.forward
+	}
+	// point all owners on the way directly to the root
+	for o != root {
+		next := o.forward
+		o.forward = root
+		o = next
+	}
+	return root.list
+}
+
+// removeRange removes the elements from index "from" (inclusive) to "to" (exclusive), which has to be a valid range.
+func (list *List[T]) removeRange(from, to int) {
+	e := list.elementAt(from)
+	for i := from; i < to; i++ {
+		next := e.next
+		list.remove(e)
+		e = next
+	}
+}
+
+// removeIf removes the elements within the range for which the predicate returns true and returns their number.
+func (list *List[T]) removeIf(from, to int, predicate func(value T) bool) int {
+	if from == to {
+		return 0
+	}
+	removed := 0
+	e := list.elementAt(from)
+	for i := from; i < to; i++ {
+		next := e.next
+		if predicate(e.Value) {
+			list.remove(e)
+			removed++
+		}
+		e = next
+	}
+	return removed
+}
+
+// sortRange sorts the elements within the range by relinking them in sorted order, see mergeSort.
+func (list *List[T]) sortRange(from, to int, comparator utils.Comparator[T]) {
+	if to-from < 2 {
+		return
+	}
+	first := list.elementAt(from)
+	last := first
+	for i := from + 1; i < to; i++ {
+		last = last.next
+	}
+	before, after := first.prev, last.next
+	last.next = nil
+	first, last = mergeSort(first, to-from, comparator)
+	list.linkChain(before, first)
+	list.link(last, after)
+	list.modCount++
+}
+
+// reverse reverses the order of the elements within the range by relinking them.
+func (list *List[T]) reverse(from, to int) {
+	if to-from < 2 {
+		return
+	}
+	first := list.elementAt(from)
+	last := first
+	for i := from + 1; i < to; i++ {
+		last = last.next
+	}
+	before, after := first.prev, last.next
+	for e := first; e != after; {
+		next := e.next
+		e.prev, e.next = e.next, e.prev
+		e = next
+	}
+	list.link(before, last)
+	list.link(first, after)
+	list.modCount++
+}
+
+// rotate rotates the elements within the range by the given distance by relinking its two parts.
+func (list *List[T]) rotate(from, to, distance int) {
+	n := to - from
+	if n < 2 {
+		return
+	}
+	distance = (distance%n + n) % n
+	if distance == 0 {
+		return
+	}
+	first := list.elementAt(from)
+	mid := first // new first element of the range
+	for i := 0; i < n-distance; i++ {
+		mid = mid.next
+	}
+	last := mid
+	for i := 1; i < distance; i++ {
+		last = last.next
+	}
+	before, after, midPrev := first.prev, last.next, mid.prev
+	list.link(before, mid)
+	list.link(last, first)
+	list.link(midPrev, after)
+	list.modCount++
+}
+
+// link makes b follow a, either of which may be nil for the front or back of the list.
+func (list *List[T]) link(a, b *Element[T]) {
+	if a == nil {
+		list.first = b
+	} else {
+		a.next = b
+	}
+	if b == nil {
+		list.last = a
+	} else {
+		b.prev = a
+	}
+}
+
+// linkChain makes the chain of elements starting at first, whose previous links are not set yet, follow a,
+// which may be nil for the front of the list.
+func (list *List[T]) linkChain(a, first *Element[T]) {
+	list.link(a, first)
+	for e := first; e.next != nil; e = e.next {
+		e.next.prev = e
+	}
+}
+
+// mergeSort sorts the chain of n elements starting at first, whose last element has no next, with a bottom-up
+// merge sort, i.e. by merging runs of doubling width in place. Only the next links are maintained.
+// Returns the first and last element of the sorted chain.
+func mergeSort[T comparable](first *Element[T], n int, comparator utils.Comparator[T]) (*Element[T], *Element[T]) {
+	var last *Element[T]
+	for width := 1; width < n; width *= 2 {
+		rest := first
+		first, last = nil, nil
+		for rest != nil {
+			left := rest
+			right := split(left, width)
+			rest = split(right, width)
+			mergedFirst, mergedLast := merge(left, right, comparator)
+			if last == nil {
+				first = mergedFirst
+			} else {
+				last.next = mergedFirst
+			}
+			last = mergedLast
+		}
+	}
+	return first, last
+}
+
+// split cuts the chain starting at e after n elements and returns the rest of it, which may be nil.
+func split[T comparable](e *Element[T], n int) *Element[T] {
+	for i := 1; e != nil && i < n; i++ {
+		e = e.next
+	}
+	if e == nil {
+		return nil
+	}
+	rest := e.next
+	e.next = nil
+	return rest
+}
+
+// merge merges the sorted chains a and b, taking elements from a first among equal ones, and returns the first
+// and last element of the merged chain. Only the next links are maintained. Either chain may be empty, but not both.
+func merge[T comparable](a, b *Element[T], comparator utils.Comparator[T]) (first, last *Element[T]) {
+	for a != nil && b != nil {
+		var e *Element[T]
+		if comparator(a.Value, b.Value) <= 0 {
+			e, a = a, a.next
+		} else {
+			e, b = b, b.next
+		}
+		if last == nil {
+			first = e
+		} else {
+			last.next = e
+		}
+		last = e
+	}
+	if a == nil {
+		a = b
+	}
+	if last == nil {
+		first = a
+	} else {
+		last.next = a
+	}
+	for ; a != nil; a = a.next {
+		last = a
+	}
+	return first, last
+}
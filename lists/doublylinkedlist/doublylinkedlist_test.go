@@ -10,6 +10,8 @@ import (
 	"slices"
 	"strings"
 	"testing"
+
+	"github.com/emirpasic/gods/v2/testutils"
 )
 
 func TestListNew(t *testing.T) {
@@ -140,6 +142,82 @@ func TestListSort(t *testing.T) {
 	}
 }
 
+func TestListSortStable(t *testing.T) {
+	type pair struct{ key, value int }
+	byKey := func(a, b pair) int { return cmp.Compare(a.key, b.key) }
+	for size := 0; size < 40; size++ {
+		list := New[pair]()
+		expected := make([]pair, 0, size)
+		for i := 0; i < size; i++ {
+			list.Add(pair{(i * 7) % 5, i})
+			expected = append(expected, pair{(i * 7) % 5, i})
+		}
+		slices.SortStableFunc(expected, byKey)
+		list.Sort(byKey)
+		if actualValue := list.Values(); !slices.Equal(actualValue, expected) {
+			t.Errorf("Got %v expected %v", actualValue, expected)
+		}
+		if size > 0 {
+			list.Add(pair{-1, -1})
+			if actualValue, _ := list.Get(list.Size() - 1); actualValue != (pair{-1, -1}) {
+				t.Errorf("Got %v expected %v", actualValue, pair{-1, -1})
+			}
+		}
+	}
+}
+
+func TestListMerge(t *testing.T) {
+	type pair struct{ key, value int }
+	byKey := func(a, b pair) int { return cmp.Compare(a.key, b.key) }
+	list := New[pair](pair{1, 0}, pair{3, 0}, pair{3, 1}, pair{6, 0})
+	other := New[pair](pair{0, 2}, pair{3, 2}, pair{4, 2}, pair{7, 2}, pair{8, 2})
+	list.Merge(other, byKey)
+	expected := []pair{{0, 2}, {1, 0}, {3, 0}, {3, 1}, {3, 2}, {4, 2}, {6, 0}, {7, 2}, {8, 2}}
+	if actualValue := list.Values(); !slices.Equal(actualValue, expected) {
+		t.Errorf("Got %v expected %v", actualValue, expected)
+	}
+	if actualValue := list.Size(); actualValue != len(expected) {
+		t.Errorf("Got %v expected %v", actualValue, len(expected))
+	}
+	if actualValue := other.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	list.Merge(list, byKey)
+	list.Merge(other, byKey)
+	if actualValue := list.Size(); actualValue != len(expected) {
+		t.Errorf("Got %v expected %v", actualValue, len(expected))
+	}
+
+	empty := New[pair]()
+	empty.Merge(list, byKey)
+	if actualValue := empty.Values(); !slices.Equal(actualValue, expected) {
+		t.Errorf("Got %v expected %v", actualValue, expected)
+	}
+	empty.Add(pair{9, 9})
+	if actualValue, _ := empty.Get(empty.Size() - 1); actualValue != (pair{9, 9}) {
+		t.Errorf("Got %v expected %v", actualValue, pair{9, 9})
+	}
+	for i, e := len(expected), empty.Back(); e != nil; i, e = i-1, e.Prev() {
+		if i < len(expected) && e.Value != expected[i] {
+			t.Errorf("Got %v expected %v", e.Value, expected[i])
+		}
+	}
+
+	// handles of merged elements stay valid
+	a, b := New[int](), New[int]()
+	one, three := a.Append(1), a.Append(3)
+	two := b.Append(2)
+	a.Merge(b, cmp.Compare[int])
+	a.MoveToBack(two)
+	a.RemoveElement(one)
+	if actualValue, expectedValue := a.Values(), []int{3, 2}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue := three.Next(); actualValue != two {
+		t.Errorf("Got %v expected %v", actualValue, two)
+	}
+}
+
 func TestListClear(t *testing.T) {
 	list := New[string]()
 	list.Add("e", "f", "g", "a", "b", "c", "d")
@@ -607,6 +685,602 @@ func TestListIteratorPrevTo(t *testing.T) {
 	}
 }
 
+func TestListIteratorRemove(t *testing.T) {
+	list := New[int](1, 2, 3, 4, 5, 6)
+	it := list.Iterator()
+	for it.Next() {
+		if it.Value()%2 == 0 {
+			it.Remove()
+		}
+	}
+	if actualValue, expectedValue := list.Values(), []int{1, 3, 5}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := list.Size(), 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// remove everything, including first and last
+	it = list.Iterator()
+	for it.Next() {
+		it.Remove()
+		it.Remove() // no current element, no-op
+	}
+	if actualValue := list.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	list.Add(7, 8)
+	if actualValue, expectedValue := list.Values(), []int{7, 8}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// indices follow removals
+	list = New[int](1, 2, 3)
+	it = list.Iterator()
+	it.Next()
+	it.Remove()
+	if actualValue := it.Next(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue, expectedValue := it.Index(), 0; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := it.Value(), 2; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestListIteratorSetAndInsert(t *testing.T) {
+	list := New[string]("a", "c", "e")
+	it := list.Iterator()
+	it.Set("x") // no current element, no-op
+	it.InsertBefore("x")
+	it.InsertAfter("x")
+	for it.Next() {
+		switch it.Value() {
+		case "a":
+			it.InsertBefore("_")
+			it.InsertAfter("b")
+		case "c":
+			it.Set("C")
+			it.InsertAfter("d")
+		case "e":
+			it.InsertAfter("f")
+		}
+	}
+	if actualValue, expectedValue := list.Values(), []string{"_", "a", "b", "C", "d", "e", "f"}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := list.Size(), 7; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// last element is updated on insert after the last one
+	list.Add("g")
+	if actualValue, expectedValue := list.Values(), []string{"_", "a", "b", "C", "d", "e", "f", "g"}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// indices follow insertions
+	it = list.Iterator()
+	for it.Next() {
+		if actualValue, _ := list.Get(it.Index()); actualValue != it.Value() {
+			t.Errorf("Got %v expected %v", actualValue, it.Value())
+		}
+		it.InsertBefore("-")
+	}
+	if actualValue, expectedValue := list.Size(), 16; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestListRemoveIfAndRetainIf(t *testing.T) {
+	list := New[int](1, 2, 3, 4, 5, 6, 7, 8, 9, 10)
+	list.RemoveIf(func(value int) bool { return value%3 == 0 })
+	if actualValue, expectedValue := list.Values(), []int{1, 2, 4, 5, 7, 8, 10}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	list.RetainIf(func(value int) bool { return value%2 == 0 })
+	if actualValue, expectedValue := list.Values(), []int{2, 4, 8, 10}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	list.RemoveIf(func(value int) bool { return true })
+	if actualValue := list.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	list.Add(1)
+	if actualValue, expectedValue := list.Values(), []int{1}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestListIteratorRemoveReverse(t *testing.T) {
+	list := New[int](1, 2, 3, 4, 5)
+	it := list.Iterator()
+	for it.End(); it.Prev(); {
+		if it.Value()%2 == 1 {
+			it.Remove()
+		}
+	}
+	if actualValue, expectedValue := list.Values(), []int{2, 4}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// Prev after Remove moves to the element before the removed one
+	list = New[int](1, 2, 3)
+	it = list.Iterator()
+	it.Next()
+	it.Next()
+	it.Remove()
+	if actualValue := it.Prev(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue, expectedValue := it.Value(), 1; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	it.Remove()
+	if actualValue := it.Prev(); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if actualValue := it.Next(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue, expectedValue := it.Value(), 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestListElements(t *testing.T) {
+	list := New[string]()
+	b := list.Append("b", "c")
+	a := list.Prepend("a")
+	d := list.InsertAt(3, "d")
+	if actualValue, expectedValue := list.Values(), []string{"a", "b", "c", "d"}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := []string{a.Value, b.Value, d.Value}, []string{"a", "b", "d"}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue := list.Append(); actualValue != nil {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+	if actualValue := list.InsertAt(5, "x"); actualValue != nil {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+
+	var values []string
+	for e := list.Front(); e != nil; e = e.Next() {
+		values = append(values, e.Value)
+	}
+	for e := list.Back(); e != nil; e = e.Prev() {
+		values = append(values, e.Value)
+	}
+	if actualValue, expectedValue := values, []string{"a", "b", "c", "d", "d", "c", "b", "a"}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	list.InsertBefore(b, "x")
+	list.InsertAfter(b, "y")
+	if actualValue, expectedValue := list.Values(), []string{"a", "x", "b", "y", "c", "d"}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	if actualValue := list.RemoveElement(b); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := list.RemoveElement(b); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if actualValue := list.InsertAfter(b, "z"); actualValue != nil {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+	if actualValue := b.Next(); actualValue != nil {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+	if actualValue, expectedValue := list.Values(), []string{"a", "x", "y", "c", "d"}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := list.Size(), 5; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// elements of another list are ignored
+	other := New[string]()
+	foreign := other.Append("f")
+	list.MoveToFront(foreign)
+	if actualValue := list.RemoveElement(foreign); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if actualValue, expectedValue := other.Values(), []string{"f"}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestListMoveElements(t *testing.T) {
+	list := New[int]()
+	e1 := list.Append(1)
+	e2 := list.Append(2)
+	e3 := list.Append(3)
+	e4 := list.Append(4)
+
+	tests := []struct {
+		move     func()
+		expected []int
+	}{
+		{func() { list.MoveToFront(e3) }, []int{3, 1, 2, 4}},
+		{func() { list.MoveToFront(e3) }, []int{3, 1, 2, 4}},
+		{func() { list.MoveToBack(e3) }, []int{1, 2, 4, 3}},
+		{func() { list.MoveToBack(e3) }, []int{1, 2, 4, 3}},
+		{func() { list.MoveBefore(e4, e1) }, []int{4, 1, 2, 3}},
+		{func() { list.MoveAfter(e4, e3) }, []int{1, 2, 3, 4}},
+		{func() { list.MoveAfter(e1, e2) }, []int{2, 1, 3, 4}},
+		{func() { list.MoveBefore(e1, e2) }, []int{1, 2, 3, 4}},
+		{func() { list.MoveBefore(e2, e2) }, []int{1, 2, 3, 4}},
+	}
+	for i, test := range tests {
+		test.move()
+		if actualValue := list.Values(); !slices.Equal(actualValue, test.expected) {
+			t.Errorf("test %v: Got %v expected %v", i, actualValue, test.expected)
+		}
+		var backwards []int
+		for e := list.Back(); e != nil; e = e.Prev() {
+			backwards = append([]int{e.Value}, backwards...)
+		}
+		if !slices.Equal(backwards, test.expected) {
+			t.Errorf("test %v: Got %v expected %v", i, backwards, test.expected)
+		}
+	}
+}
+
+func TestListSplice(t *testing.T) {
+	list := New[int](1, 2)
+	other := New[int]()
+	e3 := other.Append(3)
+	e4 := other.Append(4)
+
+	list.Splice(other)
+	if actualValue, expectedValue := list.Values(), []int{1, 2, 3, 4}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := list.Size(), 4; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue := other.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+
+	// handles now refer to the receiving list
+	list.MoveToFront(e4)
+	if actualValue := other.RemoveElement(e3); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if actualValue := list.RemoveElement(e3); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue, expectedValue := list.Values(), []int{4, 1, 2}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// the other list is independent again
+	e5 := other.Append(5)
+	if actualValue := list.RemoveElement(e5); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+
+	// splicing spliced elements again forwards them once more
+	third := New[int]()
+	third.Splice(list)
+	if actualValue := third.RemoveElement(e4); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue, expectedValue := third.Values(), []int{1, 2}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// clearing invalidates all handles, including spliced ones
+	e6 := third.Append(6)
+	third.Splice(other)
+	third.Clear()
+	if actualValue := third.RemoveElement(e5) || third.RemoveElement(e6); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if actualValue := e5.Next(); actualValue != nil {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+}
+
+func TestListRemoveRange(t *testing.T) {
+	list := New[int](0, 1, 2, 3, 4, 5)
+	list.RemoveRange(1, 3)
+	if actualValue, expectedValue := list.Values(), []int{0, 3, 4, 5}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	list.RemoveRange(2, 4)
+	if actualValue, expectedValue := list.Values(), []int{0, 3}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	// invalid or empty ranges are ignored
+	list.RemoveRange(-1, 1)
+	list.RemoveRange(1, 3)
+	list.RemoveRange(1, 1)
+	list.RemoveRange(1, 0)
+	if actualValue, expectedValue := list.Values(), []int{0, 3}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	list.RemoveRange(0, 2)
+	if actualValue, expectedValue := list.Size(), 0; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	list.Add(7)
+	if actualValue, expectedValue := list.Values(), []int{7}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestListReverseAndRotate(t *testing.T) {
+	list := New[int]()
+	list.Reverse()
+	list.Rotate(3)
+	if actualValue, expectedValue := list.Size(), 0; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	list.Add(1, 2, 3, 4, 5)
+	list.Reverse()
+	if actualValue, expectedValue := list.Values(), []int{5, 4, 3, 2, 1}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	list.Reverse()
+	list.Add(6)
+	if actualValue, expectedValue := list.Values(), []int{1, 2, 3, 4, 5, 6}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	tests := []struct {
+		distance int
+		expected []int
+	}{
+		{0, []int{1, 2, 3, 4, 5, 6}},
+		{1, []int{6, 1, 2, 3, 4, 5}},
+		{2, []int{5, 6, 1, 2, 3, 4}},
+		{-1, []int{2, 3, 4, 5, 6, 1}},
+		{6, []int{1, 2, 3, 4, 5, 6}},
+		{13, []int{6, 1, 2, 3, 4, 5}},
+		{-8, []int{3, 4, 5, 6, 1, 2}},
+	}
+	for _, test := range tests {
+		list := New[int](1, 2, 3, 4, 5, 6)
+		list.Rotate(test.distance)
+		if actualValue := list.Values(); !slices.Equal(actualValue, test.expected) {
+			t.Errorf("Rotate(%v): Got %v expected %v", test.distance, actualValue, test.expected)
+		}
+		list.Add(7)
+		if actualValue, expectedValue := list.Size(), 7; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		if actualValue, _ := list.Get(6); actualValue != 7 {
+			t.Errorf("Got %v expected %v", actualValue, 7)
+		}
+	}
+}
+
+func TestListAddAll(t *testing.T) {
+	list := New[int](1, 2)
+	list.AddAll(New[int](3, 4))
+	list.AddAll(list)
+	if actualValue, expectedValue := list.Values(), []int{1, 2, 3, 4, 1, 2, 3, 4}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestListSubList(t *testing.T) {
+	list := New[int](0, 1, 2, 3, 4, 5, 6, 7)
+	view := list.SubList(2, 6)
+	if actualValue, expectedValue := view.Values(), []int{2, 3, 4, 5}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, ok := view.Get(0); actualValue != 2 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 2)
+	}
+	if _, ok := view.Get(4); ok {
+		t.Errorf("Got %v expected %v", ok, false)
+	}
+	if actualValue := view.Contains(2, 5); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := view.Contains(1); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+
+	// writes go through to the list and the other way around
+	view.Set(0, 20)
+	list.Set(5, 50)
+	if actualValue, expectedValue := view.Values(), []int{20, 3, 4, 50}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	view.Reverse()
+	view.Swap(0, 3)
+	if actualValue, expectedValue := list.Values(), []int{0, 1, 20, 4, 3, 50, 6, 7}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	view.Sort(cmp.Compare[int])
+	view.Rotate(1)
+	if actualValue, expectedValue := list.Values(), []int{0, 1, 50, 3, 4, 20, 6, 7}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// structural changes through the view resize it
+	view.Add(8)
+	view.Insert(0, 9)
+	view.Remove(1)
+	if actualValue, expectedValue := view.Values(), []int{9, 3, 4, 20, 8}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	view.RemoveIf(func(value int) bool { return value > 5 })
+	if actualValue, expectedValue := view.Size(), 2; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	view.AddAll(New[int](5, 5))
+	view.RemoveRange(2, 3)
+	if actualValue, expectedValue := list.Values(), []int{0, 1, 3, 4, 5, 6, 7}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// nested views resize their parents
+	inner := view.SubList(1, 3)
+	inner.Clear()
+	if actualValue, expectedValue := view.Values(), []int{3}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	inner.Add(1, 2)
+	if actualValue, expectedValue := view.Values(), []int{3, 1, 2}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	view.Clear()
+	if actualValue, expectedValue := list.Values(), []int{0, 1, 6, 7}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue := view.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue, expectedValue := view.String(), "View\n"; !strings.HasSuffix(actualValue, expectedValue) {
+		t.Errorf("Got %v expected suffix %v", actualValue, expectedValue)
+	}
+
+	// structural changes of the list invalidate the view
+	list.Add(8)
+	testutils.ConcurrentModificationPanic(t, func() { view.Size() })
+	testutils.ConcurrentModificationPanic(t, func() { inner.Values() })
+
+	// invalid ranges
+	for _, r := range [][2]int{{-1, 2}, {0, 6}, {3, 2}} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("SubList(%v, %v) should panic", r[0], r[1])
+				}
+			}()
+			list.SubList(r[0], r[1])
+		}()
+	}
+	if actualValue := list.SubList(5, 5).Size(); actualValue != 0 {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+}
+
+func TestListSubListWalk(t *testing.T) {
+	list := New[int]()
+	for i := 0; i < 10; i++ {
+		list.Add(i)
+	}
+	view := list.SubList(2, 8)
+	walk := func(expected []int) {
+		t.Helper()
+		for i := len(expected) - 1; i >= 0; i-- {
+			if actualValue, ok := view.Get(i); actualValue != expected[i] || !ok {
+				t.Errorf("Got %v expected %v at %v", actualValue, expected[i], i)
+			}
+		}
+		for i := range expected {
+			if actualValue, ok := view.Get(i); actualValue != expected[i] || !ok {
+				t.Errorf("Got %v expected %v at %v", actualValue, expected[i], i)
+			}
+		}
+	}
+	walk([]int{2, 3, 4, 5, 6, 7})
+	for i := 0; i < view.Size(); i++ {
+		view.Set(i, i*10)
+	}
+	view.Swap(0, 5)
+	walk([]int{50, 10, 20, 30, 40, 0})
+
+	// structural changes invalidate the position the search starts from
+	view.Remove(1)
+	walk([]int{50, 20, 30, 40, 0})
+	list.SetFailFast(false)
+	list.Insert(3, 100)
+	list.Remove(0)
+	walk([]int{100, 20, 30, 40, 0})
+}
+
+func TestListReorderKeepsElements(t *testing.T) {
+	list := New[int]()
+	e3 := list.Append(3)
+	list.Append(1, 4, 2)
+	assertLinks := func(expected []int) {
+		t.Helper()
+		if actualValue := list.Values(); !slices.Equal(actualValue, expected) {
+			t.Errorf("Got %v expected %v", actualValue, expected)
+		}
+		var backwards []int
+		for e := list.Back(); e != nil; e = e.Prev() {
+			backwards = append([]int{e.Value}, backwards...)
+		}
+		if !slices.Equal(backwards, expected) {
+			t.Errorf("Got %v expected %v", backwards, expected)
+		}
+	}
+	list.Sort(cmp.Compare[int])
+	assertLinks([]int{1, 2, 3, 4})
+	list.Reverse()
+	assertLinks([]int{4, 3, 2, 1})
+	list.Rotate(-1)
+	assertLinks([]int{3, 2, 1, 4})
+	list.SubList(1, 4).Reverse()
+	assertLinks([]int{3, 4, 1, 2})
+	list.SubList(0, 3).Rotate(1)
+	assertLinks([]int{1, 3, 4, 2})
+	if actualValue := list.RemoveElement(e3); actualValue != true || e3.Value != 3 {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	assertLinks([]int{1, 4, 2})
+}
+
+func TestListIteratorFailFast(t *testing.T) {
+	list := New[int](1, 2, 3)
+	it := list.Iterator()
+	it.Next()
+	list.Add(4)
+	testutils.ConcurrentModificationPanic(t, func() { it.Next() })
+	testutils.ConcurrentModificationPanic(t, func() { it.Prev() })
+
+	// reset iterator is valid again
+	count := 0
+	for it.Begin(); it.Next(); {
+		count++
+	}
+	if actualValue, expectedValue := count, 4; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// replacing values is not a structural modification
+	it.First()
+	list.Set(0, 10)
+	list.Swap(1, 2)
+	if actualValue := it.Next(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+
+	for _, modify := range []func(){
+		func() { list.Remove(0) },
+		func() { list.Insert(1, 5) },
+		func() { list.Sort(cmp.Compare[int]) },
+		func() { list.RemoveIf(func(value int) bool { return value == 5 }) },
+		func() { list.Clear() },
+	} {
+		list.Add(1, 2, 3)
+		it.First()
+		modify()
+		testutils.ConcurrentModificationPanic(t, func() { it.Next() })
+	}
+
+	list.SetFailFast(false)
+	list.Add(1, 2, 3)
+	it.First()
+	list.Remove(0)
+	it.Next() // no panic
+}
+
 func TestListSerialization(t *testing.T) {
 	list := New[string]()
 	list.Add("a", "b", "c")
@@ -720,6 +1394,22 @@ func BenchmarkDoublyLinkedListGet100000(b *testing.B) {
 	benchmarkGet(b, list, size)
 }
 
+func BenchmarkDoublyLinkedListSubListGet10000(b *testing.B) {
+	b.StopTimer()
+	size := 10000
+	list := New[int]()
+	for n := 0; n < size; n++ {
+		list.Add(n)
+	}
+	view := list.SubList(0, size)
+	b.StartTimer()
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			view.Get(n)
+		}
+	}
+}
+
 func BenchmarkDoublyLinkedListAdd100(b *testing.B) {
 	b.StopTimer()
 	size := 100
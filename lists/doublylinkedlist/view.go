@@ -0,0 +1,122 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package doublylinkedlist
+
+import (
+	"github.com/emirpasic/gods/v2/lists"
+	"github.com/emirpasic/gods/v2/utils"
+)
+
+// Assert Backing implementation
+var _ lists.Backing[int] = (*backing[int])(nil)
+
+// SubList returns a live view of the elements of the list from index "from" (inclusive) to "to" (exclusive),
+// see lists.View.
+// Panics if the range is not within the list, i.e. unless 0 <= from <= to <= Size().
+func (list *List[T]) SubList(from, to int) lists.List[T] {
+	return lists.NewView[T](&backing[T]{list: list}, from, to)
+}
+
+// backing exposes the list to its views.
+//
+// It remembers the element last accessed through the views (the cursor) and searches elements from there,
+// so that accessing the elements of a view one after another walks the list once instead of once per element.
+type backing[T comparable] struct {
+	list     *List[T]
+	cursor   *Element[T] // element last accessed, nil if none
+	index    int         // index of the cursor
+	modCount int         // list's modification count the cursor is valid for
+}
+
+func (b *backing[T]) Name() string {
+	return "DoublyLinkedList"
+}
+
+func (b *backing[T]) ModCount() int {
+	return b.list.modCount
+}
+
+func (b *backing[T]) FailFast() bool {
+	return !b.list.failFastDisabled
+}
+
+func (b *backing[T]) Size() int {
+	return b.list.Size()
+}
+
+func (b *backing[T]) Get(index int) T {
+	return b.elementAt(index).Value
+}
+
+func (b *backing[T]) Set(index int, value T) {
+	b.elementAt(index).Value = value
+}
+
+func (b *backing[T]) Swap(i, j int) {
+	e1, e2 := b.elementAt(i), b.elementAt(j)
+	e1.Value, e2.Value = e2.Value, e1.Value
+}
+
+func (b *backing[T]) Insert(index int, values ...T) {
+	b.list.Insert(index, values...)
+}
+
+func (b *backing[T]) Values(from, to int) []T {
+	values := make([]T, 0, to-from)
+	if from == to {
+		return values
+	}
+	for e := b.elementAt(from); len(values) < to-from; e = e.next {
+		values = append(values, e.Value)
+	}
+	return values
+}
+
+func (b *backing[T]) RemoveRange(from, to int) {
+	b.list.removeRange(from, to)
+}
+
+func (b *backing[T]) Reverse(from, to int) {
+	b.list.reverse(from, to)
+}
+
+func (b *backing[T]) Rotate(from, to, distance int) {
+	b.list.rotate(from, to, distance)
+}
+
+func (b *backing[T]) RemoveIf(from, to int, predicate func(value T) bool) int {
+	return b.list.removeIf(from, to, predicate)
+}
+
+func (b *backing[T]) Sort(from, to int, comparator utils.Comparator[T]) {
+	b.list.sortRange(from, to, comparator)
+}
+
+// elementAt returns the element at the index, which has to be within range, and moves the cursor to it.
+func (b *backing[T]) elementAt(index int) *Element[T] {
+	var e *Element[T]
+	if b.cursor != nil && b.modCount == b.list.modCount && abs(index-b.index) <= min(index, b.list.size-1-index) {
+		// the cursor is nearer than either end of the list
+		e = b.cursor
+		for i := b.index; i < index; i++ {
+			e = e.next
+		}
+		for i := b.index; i > index; i-- {
+			e = e.prev
+		}
+	} else {
+		e = b.list.elementAt(index)
+	}
+	b.cursor, b.index, b.modCount = e, index, b.list.modCount
+	return e
+}
+
+// abs returns the absolute value of x.
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
@@ -24,6 +24,13 @@ type List[T comparable] interface {
 	Swap(index1, index2 int)
 	Insert(index int, values ...T)
 	Set(index int, value T)
+	RemoveIf(predicate func(value T) bool)
+	RetainIf(predicate func(value T) bool)
+	SubList(from, to int) List[T]
+	RemoveRange(from, to int)
+	Reverse()
+	Rotate(distance int)
+	AddAll(another List[T])
 
 	containers.Container[T]
 	// Empty() bool
@@ -32,3 +39,25 @@ type List[T comparable] interface {
 	// Values() []interface{}
 	// String() string
 }
+
+// ListIterator is an iterator that can modify the list at its position without being invalidated.
+//
+// Remove, Set, InsertBefore and InsertAfter operate on the current element, i.e. the one last returned by
+// Next() (or Prev()), and do nothing if there is no current element.
+type ListIterator[T any] interface {
+	// Remove removes the current element from the list.
+	// The iterator is left between the neighbours of the removed element, i.e. Next() moves to the element that
+	// followed it and Prev() (if supported) to the one that preceded it. There is no current element until then.
+	Remove()
+
+	// Set replaces the value of the current element.
+	Set(value T)
+
+	// InsertBefore inserts the value in front of the current element, the iterator stays on the current element.
+	InsertBefore(value T)
+
+	// InsertAfter inserts the value after the current element, the next call to Next() moves to it.
+	InsertAfter(value T)
+
+	containers.IteratorWithIndex[T]
+}
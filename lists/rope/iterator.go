@@ -0,0 +1,200 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package rope
+
+import (
+	"github.com/emirpasic/gods/v2/containers"
+	"github.com/emirpasic/gods/v2/lists"
+)
+
+// Assert Iterator implementation
+var _ containers.ReverseIteratorWithIndex[int] = (*Iterator[int])(nil)
+var _ lists.ListIterator[int] = (*Iterator[int])(nil)
+
+// Iterator holding the iterator's state
+type Iterator[T comparable] struct {
+	list     *List[T]
+	index    int
+	removed  bool // current element was removed
+	modCount int  // list's modification count the iterator is in sync with
+
+	// chunk holding the current element, so that consecutive values are fetched in O(1)
+	chunk         []T
+	chunkStart    int // index of the chunk's first value
+	chunkModCount int // list's modification count the chunk was fetched at
+}
+
+// Iterator returns a stateful iterator whose values can be fetched by an index.
+func (list *List[T]) Iterator() *Iterator[T] {
+	return &Iterator[T]{list: list, index: -1, modCount: list.modCount}
+}
+
+// Next moves the iterator to the next element and returns true if there was a next element in the container.
+// If Next() returns true, then next element's index and value can be retrieved by Index() and Value().
+// If Next() was called for the first time, then it will point the iterator to the first element if it exists.
+// Modifies the state of the iterator.
+func (iterator *Iterator[T]) Next() bool {
+	iterator.checkModification()
+	iterator.removed = false
+	if iterator.index < iterator.list.Size() {
+		iterator.index++
+	}
+	return iterator.list.withinRange(iterator.index)
+}
+
+// Prev moves the iterator to the previous element and returns true if there was a previous element in the container.
+// If Prev() returns true, then previous element's index and value can be retrieved by Index() and Value().
+// Modifies the state of the iterator.
+func (iterator *Iterator[T]) Prev() bool {
+	iterator.checkModification()
+	if iterator.removed {
+		// already on the element that preceded the removed one
+		iterator.removed = false
+		return iterator.list.withinRange(iterator.index)
+	}
+	if iterator.index >= 0 {
+		iterator.index--
+	}
+	return iterator.list.withinRange(iterator.index)
+}
+
+// Value returns the current element's value.
+// Does not modify the state of the iterator.
+func (iterator *Iterator[T]) Value() T {
+	offset := iterator.index - iterator.chunkStart
+	if iterator.chunkModCount != iterator.list.modCount || offset < 0 || offset >= len(iterator.chunk) {
+		iterator.chunk, iterator.chunkStart = iterator.list.chunkAt(iterator.index)
+		iterator.chunkModCount = iterator.list.modCount
+		offset = iterator.index - iterator.chunkStart
+	}
+	return iterator.chunk[offset]
+}
+
+// Index returns the current element's index.
+// Does not modify the state of the iterator.
+func (iterator *Iterator[T]) Index() int {
+	return iterator.index
+}
+
+// Begin resets the iterator to its initial state (one-before-first)
+// Call Next() to fetch the first element if any.
+func (iterator *Iterator[T]) Begin() {
+	iterator.index = -1
+	iterator.removed = false
+	iterator.modCount = iterator.list.modCount
+}
+
+// End moves the iterator past the last element (one-past-the-end).
+// Call Prev() to fetch the last element if any.
+func (iterator *Iterator[T]) End() {
+	iterator.index = iterator.list.Size()
+	iterator.removed = false
+	iterator.modCount = iterator.list.modCount
+}
+
+// First moves the iterator to the first element and returns true if there was a first element in the container.
+// If First() returns true, then first element's index and value can be retrieved by Index() and Value().
+// Modifies the state of the iterator.
+func (iterator *Iterator[T]) First() bool {
+	iterator.Begin()
+	return iterator.Next()
+}
+
+// Last moves the iterator to the last element and returns true if there was a last element in the container.
+// If Last() returns true, then last element's index and value can be retrieved by Index() and Value().
+// Modifies the state of the iterator.
+func (iterator *Iterator[T]) Last() bool {
+	iterator.End()
+	return iterator.Prev()
+}
+
+// NextTo moves the iterator to the next element from current position that satisfies the condition given by the
+// passed function, and returns true if there was a next element in the container.
+// If NextTo() returns true, then next element's index and value can be retrieved by Index() and Value().
+// Modifies the state of the iterator.
+func (iterator *Iterator[T]) NextTo(f func(index int, value T) bool) bool {
+	for iterator.Next() {
+		index, value := iterator.Index(), iterator.Value()
+		if f(index, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// PrevTo moves the iterator to the previous element from current position that satisfies the condition given by the
+// passed function, and returns true if there was a next element in the container.
+// If PrevTo() returns true, then next element's index and value can be retrieved by Index() and Value().
+// Modifies the state of the iterator.
+func (iterator *Iterator[T]) PrevTo(f func(index int, value T) bool) bool {
+	for iterator.Prev() {
+		index, value := iterator.Index(), iterator.Value()
+		if f(index, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// Remove removes the current element from the list.
+// The iterator is left between the neighbours of the removed element, i.e. Next() moves to the element that
+// followed it and Prev() to the one that preceded it.
+// Does nothing if there is no current element.
+func (iterator *Iterator[T]) Remove() {
+	if !iterator.valid() {
+		return
+	}
+	iterator.list.Remove(iterator.index)
+	iterator.modCount = iterator.list.modCount
+	iterator.index--
+	iterator.removed = true
+}
+
+// Set replaces the value of the current element.
+// Does nothing if there is no current element.
+func (iterator *Iterator[T]) Set(value T) {
+	if iterator.valid() {
+		iterator.list.Set(iterator.index, value)
+	}
+}
+
+// InsertBefore inserts the value in front of the current element, the iterator stays on the current element.
+// Does nothing if there is no current element.
+func (iterator *Iterator[T]) InsertBefore(value T) {
+	if !iterator.valid() {
+		return
+	}
+	iterator.list.Insert(iterator.index, value)
+	iterator.modCount = iterator.list.modCount
+	iterator.index++
+}
+
+// InsertAfter inserts the value after the current element, the next call to Next() moves to it.
+// Does nothing if there is no current element.
+func (iterator *Iterator[T]) InsertAfter(value T) {
+	if !iterator.valid() {
+		return
+	}
+	iterator.list.Insert(iterator.index+1, value)
+	iterator.modCount = iterator.list.modCount
+}
+
+// valid returns true if the iterator is on an element of the list.
+func (iterator *Iterator[T]) valid() bool {
+	return !iterator.removed && iterator.list.withinRange(iterator.index)
+}
+
+// checkModification panics if the list was structurally modified other than through the iterator,
+// unless fail-fast iteration is disabled for the list.
+func (iterator *Iterator[T]) checkModification() {
+	if iterator.index == -1 {
+		// not on an element yet, nothing to invalidate
+		iterator.modCount = iterator.list.modCount
+		return
+	}
+	if iterator.modCount != iterator.list.modCount && !iterator.list.failFastDisabled {
+		panic(&containers.ConcurrentModificationError{Container: "Rope"})
+	}
+}
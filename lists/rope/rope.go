@@ -0,0 +1,596 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package rope implements a rope, a list for large editable sequences.
+//
+// Values are stored in chunks of limited size, which are kept in a balanced tree ordered by position (an implicit
+// treap). Accessing, inserting or removing values anywhere in the list as well as splitting and concatenating lists
+// take O(log n) time, instead of the O(n) an array list needs to shift its values.
+//
+// Helpers for ropes of runes and bytes, e.g. for text editing, are in text.go.
+//
+// Structure is not thread safe.
+//
+// Reference: https://en.wikipedia.org/wiki/Rope_(data_structure)
+package rope
+
+import (
+	"fmt"
+	"math/rand"
+	"slices"
+	"strings"
+
+	"github.com/emirpasic/gods/v2/containers"
+	"github.com/emirpasic/gods/v2/lists"
+	"github.com/emirpasic/gods/v2/utils"
+)
+
+// Assert List implementation
+var _ lists.List[int] = (*List[int])(nil)
+var _ containers.FailFast = (*List[int])(nil)
+
+// List holds the elements in chunks, which are nodes of a treap ordered by position
+type List[T comparable] struct {
+	root             *node[T]
+	modCount         int  // number of structural modifications, checked by iterators
+	failFastDisabled bool // iterators do not check for modifications
+}
+
+// node holds a chunk of consecutive values. The values of the left subtree precede the chunk and the values of the
+// right subtree follow it. Nodes are in heap order with respect to their random priorities, which keeps the tree
+// balanced with high probability.
+type node[T comparable] struct {
+	chunk    []T
+	priority uint64
+	size     int // number of values in the subtree
+	left     *node[T]
+	right    *node[T]
+}
+
+// chunkSize is the maximum number of values in a chunk. Adjacent chunks with less than half of it are merged when
+// edits bring them together, so that the list does not fragment into many small chunks.
+const chunkSize = 256
+
+// New instantiates a new list and adds the passed values, if any, to the list
+func New[T comparable](values ...T) *List[T] {
+	list := &List[T]{}
+	if len(values) > 0 {
+		list.Add(values...)
+	}
+	return list
+}
+
+// Add appends values (one or more) at the end of the list
+func (list *List[T]) Add(values ...T) {
+	list.Insert(list.Size(), values...)
+}
+
+// Get returns the element at index in O(log n).
+// Second return parameter is true if index is within bounds of the list and list is not empty, otherwise false.
+func (list *List[T]) Get(index int) (T, bool) {
+	if !list.withinRange(index) {
+		var t T
+		return t, false
+	}
+	chunk, start := list.chunkAt(index)
+	return chunk[index-start], true
+}
+
+// Remove removes the element at the given index from the list in O(log n).
+func (list *List[T]) Remove(index int) {
+	if !list.withinRange(index) {
+		return
+	}
+	list.modCount++
+	chunk, start := list.chunkAt(index)
+	list.root = removeAt(list.root, index)
+	if length := len(chunk) - 1; length < chunkSize/2 {
+		list.root = coalesce(list.root, start+length)
+	}
+}
+
+// Contains checks if values (one or more) are present in the list.
+// All values have to be present in the list for the method to return true.
+// Performance time complexity of n^2.
+// Returns true if no arguments are passed at all, i.e. set is always super-set of empty set.
+func (list *List[T]) Contains(values ...T) bool {
+	for _, value := range values {
+		if list.IndexOf(value) < 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Values returns all elements in the list.
+func (list *List[T]) Values() []T {
+	return list.values(0, list.Size())
+}
+
+// IndexOf returns index of provided element, or -1 if it is not in the list
+func (list *List[T]) IndexOf(value T) int {
+	index := -1
+	offset := 0
+	eachChunk(list.root, 0, list.Size(), func(chunk []T) bool {
+		if i := slices.Index(chunk, value); i >= 0 {
+			index = offset + i
+			return false
+		}
+		offset += len(chunk)
+		return true
+	})
+	return index
+}
+
+// Empty returns true if list does not contain any elements.
+func (list *List[T]) Empty() bool {
+	return list.Size() == 0
+}
+
+// Size returns number of elements within the list.
+func (list *List[T]) Size() int {
+	return list.root.count()
+}
+
+// Clear removes all elements from the list.
+func (list *List[T]) Clear() {
+	list.modCount++
+	list.root = nil
+}
+
+// Sort sorts values (in-place) using a Comparator.
+func (list *List[T]) Sort(comparator utils.Comparator[T]) {
+	list.sortRange(0, list.Size(), comparator)
+}
+
+// Swap swaps the two values at the specified positions.
+func (list *List[T]) Swap(i, j int) {
+	if list.withinRange(i) && list.withinRange(j) && i != j {
+		chunk1, start1 := list.chunkAt(i)
+		chunk2, start2 := list.chunkAt(j)
+		chunk1[i-start1], chunk2[j-start2] = chunk2[j-start2], chunk1[i-start1]
+	}
+}
+
+// Insert inserts values at specified index position shifting the value at that position (if any) and any subsequent
+// elements to the right, in O(log n + m) for m values.
+// Does not do anything if position is negative or bigger than list's size
+// Note: position equal to list's size is valid, i.e. append.
+func (list *List[T]) Insert(index int, values ...T) {
+	if index < 0 || index > list.Size() || len(values) == 0 {
+		return
+	}
+	list.modCount++
+	if !insertInChunk(list.root, index, values) {
+		left, right := split(list.root, index)
+		list.root = join(join(left, build(values)), right)
+	}
+}
+
+// Set the value at specified index
+// Does not do anything if position is negative or bigger than list's size
+// Note: position equal to list's size is valid, i.e. append.
+func (list *List[T]) Set(index int, value T) {
+	if !list.withinRange(index) {
+		// Append
+		if index == list.Size() {
+			list.Add(value)
+		}
+		return
+	}
+	chunk, start := list.chunkAt(index)
+	chunk[index-start] = value
+}
+
+// RemoveIf removes all elements for which the predicate returns true.
+// Remaining elements are packed into new chunks in a single pass, i.e. in O(n).
+func (list *List[T]) RemoveIf(predicate func(value T) bool) {
+	list.removeIf(0, list.Size(), predicate)
+}
+
+// RetainIf removes all elements for which the predicate returns false.
+// Remaining elements are packed into new chunks in a single pass, i.e. in O(n).
+func (list *List[T]) RetainIf(predicate func(value T) bool) {
+	list.RemoveIf(func(value T) bool { return !predicate(value) })
+}
+
+// RemoveRange removes the elements from index "from" (inclusive) to "to" (exclusive) in O(log n).
+// Does not do anything if the range is not within the list.
+func (list *List[T]) RemoveRange(from, to int) {
+	if from < 0 || to > list.Size() || from >= to {
+		return
+	}
+	list.removeRange(from, to)
+}
+
+// Reverse reverses the order of the elements (in-place).
+func (list *List[T]) Reverse() {
+	list.reverse(0, list.Size())
+}
+
+// Rotate rotates the elements by the given distance in O(log n), i.e. the element at index i moves to index
+// (i + distance) modulo Size(). A negative distance rotates towards the front,
+// e.g. [a,b,c,d] rotated by 1 is [d,a,b,c] and rotated by -1 is [b,c,d,a].
+func (list *List[T]) Rotate(distance int) {
+	list.rotate(0, list.Size(), distance)
+}
+
+// AddAll appends all values of another list at the end of the list.
+func (list *List[T]) AddAll(another lists.List[T]) {
+	list.Add(another.Values()...)
+}
+
+// Concat moves all elements of the other list to the end of this list in O(log n), leaving the other list empty.
+func (list *List[T]) Concat(other *List[T]) {
+	if other == list || other.root == nil {
+		return
+	}
+	list.modCount++
+	list.root = join(list.root, other.root)
+	other.modCount++
+	other.root = nil
+}
+
+// Split moves the elements from index on to a new list in O(log n) and returns it, i.e. this list keeps the elements
+// before index. The new list has the fail-fast setting of this list.
+// Does not do anything and returns an empty list if position is negative or bigger than list's size.
+// Note: position equal to list's size is valid, i.e. the returned list is empty.
+func (list *List[T]) Split(index int) *List[T] {
+	if index < 0 || index >= list.Size() {
+		return &List[T]{failFastDisabled: list.failFastDisabled}
+	}
+	list.modCount++
+	left, right := split(list.root, index)
+	list.root = packLast(left)
+	return &List[T]{root: packFirst(right), failFastDisabled: list.failFastDisabled}
+}
+
+// SetFailFast enables or disables the checks of iterators for modifications of the list done other than through
+// the iterator itself, see containers.ConcurrentModificationError. Checks are enabled by default.
+func (list *List[T]) SetFailFast(enabled bool) {
+	list.failFastDisabled = !enabled
+}
+
+// String returns a string representation of container
+func (list *List[T]) String() string {
+	str := "Rope\n"
+	values := make([]string, 0, list.Size())
+	for _, value := range list.Values() {
+		values = append(values, fmt.Sprintf("%v", value))
+	}
+	str += strings.Join(values, ", ")
+	return str
+}
+
+// Check that the index is within bounds of the list
+func (list *List[T]) withinRange(index int) bool {
+	return index >= 0 && index < list.Size()
+}
+
+// chunkAt returns the chunk holding the value at index, which has to be within range, and the index of its first value.
+func (list *List[T]) chunkAt(index int) ([]T, int) {
+	start := 0
+	for n := list.root; ; {
+		leftSize := n.left.count()
+		switch {
+		case index < leftSize:
+			n = n.left
+		case index < leftSize+len(n.chunk):
+			return n.chunk, start + leftSize
+		default:
+			index -= leftSize + len(n.chunk)
+			start += leftSize + len(n.chunk)
+			n = n.right
+		}
+	}
+}
+
+// values returns the elements from index "from" (inclusive) to "to" (exclusive).
+func (list *List[T]) values(from, to int) []T {
+	values := make([]T, 0, to-from)
+	eachChunk(list.root, from, to, func(chunk []T) bool {
+		values = append(values, chunk...)
+		return true
+	})
+	return values
+}
+
+// setValues replaces the elements from index "from" on by the values, which have to be within range, in place.
+func (list *List[T]) setValues(from int, values []T) {
+	eachChunk(list.root, from, from+len(values), func(chunk []T) bool {
+		values = values[copy(chunk, values):]
+		return true
+	})
+}
+
+// removeRange removes the elements from index "from" (inclusive) to "to" (exclusive), which has to be a valid range.
+func (list *List[T]) removeRange(from, to int) {
+	list.modCount++
+	left, rest := split(list.root, from)
+	_, right := split(rest, to-from)
+	list.root = join(left, right)
+}
+
+// removeIf removes the elements within the range for which the predicate returns true and returns their number.
+// Remaining elements are packed into new chunks in a single pass, i.e. in O(n).
+func (list *List[T]) removeIf(from, to int, predicate func(value T) bool) int {
+	kept := slices.DeleteFunc(list.values(from, to), predicate)
+	removed := to - from - len(kept)
+	if removed == 0 {
+		return 0
+	}
+	list.modCount++
+	left, rest := split(list.root, from)
+	_, right := split(rest, to-from)
+	list.root = join(join(left, build(kept)), right)
+	return removed
+}
+
+// sortRange sorts the elements within the range (in-place).
+func (list *List[T]) sortRange(from, to int, comparator utils.Comparator[T]) {
+	if to-from < 2 {
+		return
+	}
+	list.modCount++
+	values := list.values(from, to)
+	slices.SortFunc(values, comparator)
+	list.setValues(from, values)
+}
+
+// reverse reverses the order of the elements within the range (in-place).
+func (list *List[T]) reverse(from, to int) {
+	if to-from < 2 {
+		return
+	}
+	list.modCount++
+	values := list.values(from, to)
+	slices.Reverse(values)
+	list.setValues(from, values)
+}
+
+// rotate rotates the elements within the range by the given distance by splitting off its two parts and
+// concatenating them the other way around.
+func (list *List[T]) rotate(from, to, distance int) {
+	n := to - from
+	if n < 2 {
+		return
+	}
+	distance = (distance%n + n) % n
+	if distance == 0 {
+		return
+	}
+	list.modCount++
+	left, rest := split(list.root, from)
+	middle, right := split(rest, n)
+	front, back := split(middle, n-distance)
+	list.root = join(join(left, join(back, front)), right)
+}
+
+// count returns the number of values in the subtree, which may be empty.
+func (n *node[T]) count() int {
+	if n == nil {
+		return 0
+	}
+	return n.size
+}
+
+// update recomputes the size of the subtree from the sizes of its children.
+func (n *node[T]) update() {
+	n.size = n.left.count() + len(n.chunk) + n.right.count()
+}
+
+// newNode returns a new node holding a copy of the values.
+func newNode[T comparable](values []T, priority uint64) *node[T] {
+	return &node[T]{chunk: slices.Clone(values), priority: priority, size: len(values)}
+}
+
+// build returns a tree holding the values in chunks of at most chunkSize values.
+func build[T comparable](values []T) *node[T] {
+	var root *node[T]
+	for i := 0; i < len(values); i += chunkSize {
+		root = merge(root, newNode(values[i:min(i+chunkSize, len(values))], rand.Uint64()))
+	}
+	return root
+}
+
+// merge concatenates the trees, all values of a come before the values of b, and returns the root of the result.
+func merge[T comparable](a, b *node[T]) *node[T] {
+	if a == nil {
+		return b
+	}
+	if b == nil {
+		return a
+	}
+	if a.priority > b.priority {
+		a.right = merge(a.right, b)
+		a.update()
+		return a
+	}
+	b.left = merge(a, b.left)
+	b.update()
+	return b
+}
+
+// join concatenates the trees like merge, but merges adjacent chunks with less than half of chunkSize values at the
+// seam, i.e. the last chunk of a with the first chunk of b, and next to it, where splitting may have cut a chunk.
+func join[T comparable](a, b *node[T]) *node[T] {
+	a, b = packLast(a), packFirst(b)
+	if a == nil || b == nil {
+		return merge(a, b)
+	}
+	_, last := a.lastTwo()
+	first, _ := b.firstTwo()
+	if len(last.chunk) >= chunkSize/2 || len(first.chunk) >= chunkSize/2 {
+		return merge(a, b)
+	}
+	a, _ = split(a, a.size-len(last.chunk))
+	_, b = split(b, len(first.chunk))
+	return merge(merge(a, concat(last, first)), b)
+}
+
+// packLast merges the last two chunks of the tree if both have less than half of chunkSize values
+// and returns the new root of the tree.
+func packLast[T comparable](n *node[T]) *node[T] {
+	if n == nil {
+		return n
+	}
+	prev, last := n.lastTwo()
+	if prev == nil || len(prev.chunk) >= chunkSize/2 || len(last.chunk) >= chunkSize/2 {
+		return n
+	}
+	rest, _ := split(n, n.size-len(prev.chunk)-len(last.chunk))
+	return merge(rest, concat(prev, last))
+}
+
+// packFirst merges the first two chunks of the tree if both have less than half of chunkSize values
+// and returns the new root of the tree.
+func packFirst[T comparable](n *node[T]) *node[T] {
+	if n == nil {
+		return n
+	}
+	first, next := n.firstTwo()
+	if next == nil || len(first.chunk) >= chunkSize/2 || len(next.chunk) >= chunkSize/2 {
+		return n
+	}
+	_, rest := split(n, len(first.chunk)+len(next.chunk))
+	return merge(concat(first, next), rest)
+}
+
+// coalesce joins the trees before and from index, which has to be at the start or end of a chunk,
+// and returns the new root of the tree.
+func coalesce[T comparable](n *node[T], index int) *node[T] {
+	left, right := split(n, index)
+	return join(left, right)
+}
+
+// concat returns a new node holding the values of the chunks of a and b.
+func concat[T comparable](a, b *node[T]) *node[T] {
+	chunk := make([]T, 0, len(a.chunk)+len(b.chunk))
+	chunk = append(append(chunk, a.chunk...), b.chunk...)
+	return &node[T]{chunk: chunk, priority: rand.Uint64(), size: len(chunk)}
+}
+
+// firstTwo returns the nodes holding the first and the second chunk of the tree, the second one is nil if there is none.
+func (n *node[T]) firstTwo() (first, next *node[T]) {
+	for ; n.left != nil; n = n.left {
+		next = n
+	}
+	first = n
+	if n.right != nil {
+		next = n.right
+		for next.left != nil {
+			next = next.left
+		}
+	}
+	return first, next
+}
+
+// lastTwo returns the nodes holding the second to last and the last chunk of the tree,
+// the second to last one is nil if there is none.
+func (n *node[T]) lastTwo() (prev, last *node[T]) {
+	for ; n.right != nil; n = n.right {
+		prev = n
+	}
+	last = n
+	if n.left != nil {
+		prev = n.left
+		for prev.right != nil {
+			prev = prev.right
+		}
+	}
+	return prev, last
+}
+
+// split splits the tree into the trees of the values before index and of the values from index on.
+// A chunk holding values on both sides is cut in two, the second part keeps the priority of the node so that the
+// heap order holds.
+func split[T comparable](n *node[T], index int) (*node[T], *node[T]) {
+	if n == nil {
+		return nil, nil
+	}
+	leftSize := n.left.count()
+	switch {
+	case index <= leftSize:
+		left, right := split(n.left, index)
+		n.left = right
+		n.update()
+		return left, n
+	case index >= leftSize+len(n.chunk):
+		left, right := split(n.right, index-leftSize-len(n.chunk))
+		n.right = left
+		n.update()
+		return n, right
+	default:
+		k := index - leftSize
+		second := newNode(n.chunk[k:], n.priority)
+		second.right = n.right
+		second.update()
+		clear(n.chunk[k:])
+		n.chunk = n.chunk[:k]
+		n.right = nil
+		n.update()
+		return n, second
+	}
+}
+
+// insertInChunk inserts the values at index into the chunk holding that index (or ending at it) and returns true,
+// unless the chunk would exceed chunkSize, in which case it does not do anything and returns false.
+func insertInChunk[T comparable](n *node[T], index int, values []T) bool {
+	if n == nil {
+		return false
+	}
+	leftSize := n.left.count()
+	switch {
+	case index < leftSize:
+		if !insertInChunk(n.left, index, values) {
+			return false
+		}
+	case index <= leftSize+len(n.chunk):
+		if len(n.chunk)+len(values) > chunkSize {
+			return false
+		}
+		n.chunk = slices.Insert(n.chunk, index-leftSize, values...)
+	default:
+		if !insertInChunk(n.right, index-leftSize-len(n.chunk), values) {
+			return false
+		}
+	}
+	n.size += len(values)
+	return true
+}
+
+// removeAt removes the value at index, which has to be within range, and returns the new root of the tree.
+func removeAt[T comparable](n *node[T], index int) *node[T] {
+	leftSize := n.left.count()
+	switch {
+	case index < leftSize:
+		n.left = removeAt(n.left, index)
+	case index < leftSize+len(n.chunk):
+		n.chunk = slices.Delete(n.chunk, index-leftSize, index-leftSize+1)
+		if len(n.chunk) == 0 {
+			return merge(n.left, n.right)
+		}
+	default:
+		n.right = removeAt(n.right, index-leftSize-len(n.chunk))
+	}
+	n.size--
+	return n
+}
+
+// eachChunk calls f in order for the parts of the chunks of the tree holding the values from index "from" (inclusive)
+// to "to" (exclusive), until f returns false. Returns false if f did.
+func eachChunk[T comparable](n *node[T], from, to int, f func(chunk []T) bool) bool {
+	if n == nil || from >= to {
+		return true
+	}
+	leftSize := n.left.count()
+	if from < leftSize && !eachChunk(n.left, from, min(to, leftSize), f) {
+		return false
+	}
+	if start, end := max(from-leftSize, 0), min(to-leftSize, len(n.chunk)); start < end && !f(n.chunk[start:end]) {
+		return false
+	}
+	rightStart := leftSize + len(n.chunk)
+	return to <= rightStart || eachChunk(n.right, max(from-rightStart, 0), to-rightStart, f)
+}
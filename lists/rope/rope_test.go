@@ -0,0 +1,1350 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package rope
+
+import (
+	"cmp"
+	"encoding/json"
+	"math/rand"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/emirpasic/gods/v2/testutils"
+)
+
+func TestListNew(t *testing.T) {
+	list1 := New[int]()
+
+	if actualValue := list1.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+
+	list2 := New[int](1, 2)
+
+	if actualValue := list2.Size(); actualValue != 2 {
+		t.Errorf("Got %v expected %v", actualValue, 2)
+	}
+
+	if actualValue, ok := list2.Get(0); actualValue != 1 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+
+	if actualValue, ok := list2.Get(1); actualValue != 2 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 2)
+	}
+
+	if actualValue, ok := list2.Get(2); actualValue != 0 || ok {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+}
+
+func TestListAdd(t *testing.T) {
+	list := New[string]()
+	list.Add("a")
+	list.Add("b", "c")
+	if actualValue := list.Empty(); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if actualValue := list.Size(); actualValue != 3 {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+	if actualValue, ok := list.Get(2); actualValue != "c" || !ok {
+		t.Errorf("Got %v expected %v", actualValue, "c")
+	}
+}
+
+func TestListIndexOf(t *testing.T) {
+	list := New[string]()
+
+	expectedIndex := -1
+	if index := list.IndexOf("a"); index != expectedIndex {
+		t.Errorf("Got %v expected %v", index, expectedIndex)
+	}
+
+	list.Add("a")
+	list.Add("b", "c")
+
+	expectedIndex = 0
+	if index := list.IndexOf("a"); index != expectedIndex {
+		t.Errorf("Got %v expected %v", index, expectedIndex)
+	}
+
+	expectedIndex = 1
+	if index := list.IndexOf("b"); index != expectedIndex {
+		t.Errorf("Got %v expected %v", index, expectedIndex)
+	}
+
+	expectedIndex = 2
+	if index := list.IndexOf("c"); index != expectedIndex {
+		t.Errorf("Got %v expected %v", index, expectedIndex)
+	}
+}
+
+func TestListRemove(t *testing.T) {
+	list := New[string]()
+	list.Add("a")
+	list.Add("b", "c")
+	list.Remove(2)
+	if actualValue, ok := list.Get(2); actualValue != "" || ok {
+		t.Errorf("Got %v expected %v", actualValue, "")
+	}
+	list.Remove(1)
+	list.Remove(0)
+	list.Remove(0) // no effect
+	if actualValue := list.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := list.Size(); actualValue != 0 {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+}
+
+func TestListGet(t *testing.T) {
+	list := New[string]()
+	list.Add("a")
+	list.Add("b", "c")
+	if actualValue, ok := list.Get(0); actualValue != "a" || !ok {
+		t.Errorf("Got %v expected %v", actualValue, "a")
+	}
+	if actualValue, ok := list.Get(1); actualValue != "b" || !ok {
+		t.Errorf("Got %v expected %v", actualValue, "b")
+	}
+	if actualValue, ok := list.Get(2); actualValue != "c" || !ok {
+		t.Errorf("Got %v expected %v", actualValue, "c")
+	}
+	if actualValue, ok := list.Get(3); actualValue != "" || ok {
+		t.Errorf("Got %v expected %v", actualValue, "")
+	}
+	list.Remove(0)
+	if actualValue, ok := list.Get(0); actualValue != "b" || !ok {
+		t.Errorf("Got %v expected %v", actualValue, "b")
+	}
+}
+
+func TestListSwap(t *testing.T) {
+	list := New[string]()
+	list.Add("a")
+	list.Add("b", "c")
+	list.Swap(0, 1)
+	if actualValue, ok := list.Get(0); actualValue != "b" || !ok {
+		t.Errorf("Got %v expected %v", actualValue, "b")
+	}
+}
+
+func TestListSort(t *testing.T) {
+	list := New[string]()
+	list.Sort(cmp.Compare[string])
+	list.Add("e", "f", "g", "a", "b", "c", "d")
+	list.Sort(cmp.Compare[string])
+	for i := 1; i < list.Size(); i++ {
+		a, _ := list.Get(i - 1)
+		b, _ := list.Get(i)
+		if a > b {
+			t.Errorf("Not sorted! %s > %s", a, b)
+		}
+	}
+}
+
+func TestListClear(t *testing.T) {
+	list := New[string]()
+	list.Add("e", "f", "g", "a", "b", "c", "d")
+	list.Clear()
+	if actualValue := list.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := list.Size(); actualValue != 0 {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+}
+
+func TestListContains(t *testing.T) {
+	list := New[string]()
+	list.Add("a")
+	list.Add("b", "c")
+	if actualValue := list.Contains("a"); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := list.Contains(""); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if actualValue := list.Contains("a", "b", "c"); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := list.Contains("a", "b", "c", "d"); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	list.Clear()
+	if actualValue := list.Contains("a"); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if actualValue := list.Contains("a", "b", "c"); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+}
+
+func TestListValues(t *testing.T) {
+	list := New[string]()
+	list.Add("a")
+	list.Add("b", "c")
+	if actualValue, expectedValue := list.Values(), []string{"a", "b", "c"}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestListInsert(t *testing.T) {
+	list := New[string]()
+	list.Insert(0, "b", "c")
+	list.Insert(0, "a")
+	list.Insert(10, "x") // ignore
+	if actualValue := list.Size(); actualValue != 3 {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+	list.Insert(3, "d") // append
+	if actualValue := list.Size(); actualValue != 4 {
+		t.Errorf("Got %v expected %v", actualValue, 4)
+	}
+	if actualValue, expectedValue := strings.Join(list.Values(), ""), "abcd"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestListSet(t *testing.T) {
+	list := New[string]()
+	list.Set(0, "a")
+	list.Set(1, "b")
+	if actualValue := list.Size(); actualValue != 2 {
+		t.Errorf("Got %v expected %v", actualValue, 2)
+	}
+	list.Set(2, "c") // append
+	if actualValue := list.Size(); actualValue != 3 {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+	list.Set(4, "d")  // ignore
+	list.Set(1, "bb") // update
+	if actualValue := list.Size(); actualValue != 3 {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+	if actualValue, expectedValue := list.Values(), []string{"a", "bb", "c"}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestListEach(t *testing.T) {
+	list := New[string]()
+	list.Add("a", "b", "c")
+	list.Each(func(index int, value string) {
+		switch index {
+		case 0:
+			if actualValue, expectedValue := value, "a"; actualValue != expectedValue {
+				t.Errorf("Got %v expected %v", actualValue, expectedValue)
+			}
+		case 1:
+			if actualValue, expectedValue := value, "b"; actualValue != expectedValue {
+				t.Errorf("Got %v expected %v", actualValue, expectedValue)
+			}
+		case 2:
+			if actualValue, expectedValue := value, "c"; actualValue != expectedValue {
+				t.Errorf("Got %v expected %v", actualValue, expectedValue)
+			}
+		default:
+			t.Errorf("Too many")
+		}
+	})
+}
+
+func TestListMap(t *testing.T) {
+	list := New[string]()
+	list.Add("a", "b", "c")
+	mappedList := list.Map(func(index int, value string) string {
+		return "mapped: " + value
+	})
+	if actualValue, _ := mappedList.Get(0); actualValue != "mapped: a" {
+		t.Errorf("Got %v expected %v", actualValue, "mapped: a")
+	}
+	if actualValue, _ := mappedList.Get(1); actualValue != "mapped: b" {
+		t.Errorf("Got %v expected %v", actualValue, "mapped: b")
+	}
+	if actualValue, _ := mappedList.Get(2); actualValue != "mapped: c" {
+		t.Errorf("Got %v expected %v", actualValue, "mapped: c")
+	}
+	if mappedList.Size() != 3 {
+		t.Errorf("Got %v expected %v", mappedList.Size(), 3)
+	}
+}
+
+func TestListSelect(t *testing.T) {
+	list := New[string]()
+	list.Add("a", "b", "c")
+	selectedList := list.Select(func(index int, value string) bool {
+		return value >= "a" && value <= "b"
+	})
+	if actualValue, _ := selectedList.Get(0); actualValue != "a" {
+		t.Errorf("Got %v expected %v", actualValue, "value: a")
+	}
+	if actualValue, _ := selectedList.Get(1); actualValue != "b" {
+		t.Errorf("Got %v expected %v", actualValue, "value: b")
+	}
+	if selectedList.Size() != 2 {
+		t.Errorf("Got %v expected %v", selectedList.Size(), 3)
+	}
+}
+
+func TestListAny(t *testing.T) {
+	list := New[string]()
+	list.Add("a", "b", "c")
+	any := list.Any(func(index int, value string) bool {
+		return value == "c"
+	})
+	if any != true {
+		t.Errorf("Got %v expected %v", any, true)
+	}
+	any = list.Any(func(index int, value string) bool {
+		return value == "x"
+	})
+	if any != false {
+		t.Errorf("Got %v expected %v", any, false)
+	}
+}
+func TestListAll(t *testing.T) {
+	list := New[string]()
+	list.Add("a", "b", "c")
+	all := list.All(func(index int, value string) bool {
+		return value >= "a" && value <= "c"
+	})
+	if all != true {
+		t.Errorf("Got %v expected %v", all, true)
+	}
+	all = list.All(func(index int, value string) bool {
+		return value >= "a" && value <= "b"
+	})
+	if all != false {
+		t.Errorf("Got %v expected %v", all, false)
+	}
+}
+func TestListFind(t *testing.T) {
+	list := New[string]()
+	list.Add("a", "b", "c")
+	foundIndex, foundValue := list.Find(func(index int, value string) bool {
+		return value == "c"
+	})
+	if foundValue != "c" || foundIndex != 2 {
+		t.Errorf("Got %v at %v expected %v at %v", foundValue, foundIndex, "c", 2)
+	}
+	foundIndex, foundValue = list.Find(func(index int, value string) bool {
+		return value == "x"
+	})
+	if foundValue != "" || foundIndex != -1 {
+		t.Errorf("Got %v at %v expected %v at %v", foundValue, foundIndex, nil, nil)
+	}
+}
+func TestListChaining(t *testing.T) {
+	list := New[string]()
+	list.Add("a", "b", "c")
+	chainedList := list.Select(func(index int, value string) bool {
+		return value > "a"
+	}).Map(func(index int, value string) string {
+		return value + value
+	})
+	if chainedList.Size() != 2 {
+		t.Errorf("Got %v expected %v", chainedList.Size(), 2)
+	}
+	if actualValue, ok := chainedList.Get(0); actualValue != "bb" || !ok {
+		t.Errorf("Got %v expected %v", actualValue, "b")
+	}
+	if actualValue, ok := chainedList.Get(1); actualValue != "cc" || !ok {
+		t.Errorf("Got %v expected %v", actualValue, "c")
+	}
+}
+
+func TestListIteratorNextOnEmpty(t *testing.T) {
+	list := New[string]()
+	it := list.Iterator()
+	for it.Next() {
+		t.Errorf("Shouldn't iterate on empty list")
+	}
+}
+
+func TestListIteratorNext(t *testing.T) {
+	list := New[string]()
+	list.Add("a", "b", "c")
+	it := list.Iterator()
+	count := 0
+	for it.Next() {
+		count++
+		index := it.Index()
+		value := it.Value()
+		switch index {
+		case 0:
+			if actualValue, expectedValue := value, "a"; actualValue != expectedValue {
+				t.Errorf("Got %v expected %v", actualValue, expectedValue)
+			}
+		case 1:
+			if actualValue, expectedValue := value, "b"; actualValue != expectedValue {
+				t.Errorf("Got %v expected %v", actualValue, expectedValue)
+			}
+		case 2:
+			if actualValue, expectedValue := value, "c"; actualValue != expectedValue {
+				t.Errorf("Got %v expected %v", actualValue, expectedValue)
+			}
+		default:
+			t.Errorf("Too many")
+		}
+	}
+	if actualValue, expectedValue := count, 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestListIteratorPrevOnEmpty(t *testing.T) {
+	list := New[string]()
+	it := list.Iterator()
+	for it.Prev() {
+		t.Errorf("Shouldn't iterate on empty list")
+	}
+}
+
+func TestListIteratorPrev(t *testing.T) {
+	list := New[string]()
+	list.Add("a", "b", "c")
+	it := list.Iterator()
+	for it.Next() {
+	}
+	count := 0
+	for it.Prev() {
+		count++
+		index := it.Index()
+		value := it.Value()
+		switch index {
+		case 0:
+			if actualValue, expectedValue := value, "a"; actualValue != expectedValue {
+				t.Errorf("Got %v expected %v", actualValue, expectedValue)
+			}
+		case 1:
+			if actualValue, expectedValue := value, "b"; actualValue != expectedValue {
+				t.Errorf("Got %v expected %v", actualValue, expectedValue)
+			}
+		case 2:
+			if actualValue, expectedValue := value, "c"; actualValue != expectedValue {
+				t.Errorf("Got %v expected %v", actualValue, expectedValue)
+			}
+		default:
+			t.Errorf("Too many")
+		}
+	}
+	if actualValue, expectedValue := count, 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestListIteratorBegin(t *testing.T) {
+	list := New[string]()
+	it := list.Iterator()
+	it.Begin()
+	list.Add("a", "b", "c")
+	for it.Next() {
+	}
+	it.Begin()
+	it.Next()
+	if index, value := it.Index(), it.Value(); index != 0 || value != "a" {
+		t.Errorf("Got %v,%v expected %v,%v", index, value, 0, "a")
+	}
+}
+
+func TestListIteratorEnd(t *testing.T) {
+	list := New[string]()
+	it := list.Iterator()
+
+	if index := it.Index(); index != -1 {
+		t.Errorf("Got %v expected %v", index, -1)
+	}
+
+	it.End()
+	if index := it.Index(); index != 0 {
+		t.Errorf("Got %v expected %v", index, 0)
+	}
+
+	list.Add("a", "b", "c")
+	it.End()
+	if index := it.Index(); index != list.Size() {
+		t.Errorf("Got %v expected %v", index, list.Size())
+	}
+
+	it.Prev()
+	if index, value := it.Index(), it.Value(); index != list.Size()-1 || value != "c" {
+		t.Errorf("Got %v,%v expected %v,%v", index, value, list.Size()-1, "c")
+	}
+}
+
+func TestListIteratorFirst(t *testing.T) {
+	list := New[string]()
+	it := list.Iterator()
+	if actualValue, expectedValue := it.First(), false; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	list.Add("a", "b", "c")
+	if actualValue, expectedValue := it.First(), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if index, value := it.Index(), it.Value(); index != 0 || value != "a" {
+		t.Errorf("Got %v,%v expected %v,%v", index, value, 0, "a")
+	}
+}
+
+func TestListIteratorLast(t *testing.T) {
+	list := New[string]()
+	it := list.Iterator()
+	if actualValue, expectedValue := it.Last(), false; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	list.Add("a", "b", "c")
+	if actualValue, expectedValue := it.Last(), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if index, value := it.Index(), it.Value(); index != 2 || value != "c" {
+		t.Errorf("Got %v,%v expected %v,%v", index, value, 2, "c")
+	}
+}
+
+func TestListIteratorNextTo(t *testing.T) {
+	// Sample seek function, i.e. string starting with "b"
+	seek := func(index int, value string) bool {
+		return strings.HasSuffix(value, "b")
+	}
+
+	// NextTo (empty)
+	{
+		list := New[string]()
+		it := list.Iterator()
+		for it.NextTo(seek) {
+			t.Errorf("Shouldn't iterate on empty list")
+		}
+	}
+
+	// NextTo (not found)
+	{
+		list := New[string]()
+		list.Add("xx", "yy")
+		it := list.Iterator()
+		for it.NextTo(seek) {
+			t.Errorf("Shouldn't iterate on empty list")
+		}
+	}
+
+	// NextTo (found)
+	{
+		list := New[string]()
+		list.Add("aa", "bb", "cc")
+		it := list.Iterator()
+		it.Begin()
+		if !it.NextTo(seek) {
+			t.Errorf("Shouldn't iterate on empty list")
+		}
+		if index, value := it.Index(), it.Value(); index != 1 || value != "bb" {
+			t.Errorf("Got %v,%v expected %v,%v", index, value, 1, "bb")
+		}
+		if !it.Next() {
+			t.Errorf("Should go to first element")
+		}
+		if index, value := it.Index(), it.Value(); index != 2 || value != "cc" {
+			t.Errorf("Got %v,%v expected %v,%v", index, value, 2, "cc")
+		}
+		if it.Next() {
+			t.Errorf("Should not go past last element")
+		}
+	}
+}
+
+func TestListIteratorPrevTo(t *testing.T) {
+	// Sample seek function, i.e. string starting with "b"
+	seek := func(index int, value string) bool {
+		return strings.HasSuffix(value, "b")
+	}
+
+	// PrevTo (empty)
+	{
+		list := New[string]()
+		it := list.Iterator()
+		it.End()
+		for it.PrevTo(seek) {
+			t.Errorf("Shouldn't iterate on empty list")
+		}
+	}
+
+	// PrevTo (not found)
+	{
+		list := New[string]()
+		list.Add("xx", "yy")
+		it := list.Iterator()
+		it.End()
+		for it.PrevTo(seek) {
+			t.Errorf("Shouldn't iterate on empty list")
+		}
+	}
+
+	// PrevTo (found)
+	{
+		list := New[string]()
+		list.Add("aa", "bb", "cc")
+		it := list.Iterator()
+		it.End()
+		if !it.PrevTo(seek) {
+			t.Errorf("Shouldn't iterate on empty list")
+		}
+		if index, value := it.Index(), it.Value(); index != 1 || value != "bb" {
+			t.Errorf("Got %v,%v expected %v,%v", index, value, 1, "bb")
+		}
+		if !it.Prev() {
+			t.Errorf("Should go to first element")
+		}
+		if index, value := it.Index(), it.Value(); index != 0 || value != "aa" {
+			t.Errorf("Got %v,%v expected %v,%v", index, value, 0, "aa")
+		}
+		if it.Prev() {
+			t.Errorf("Should not go before first element")
+		}
+	}
+}
+
+func TestListIteratorRemove(t *testing.T) {
+	list := New[int](1, 2, 3, 4, 5, 6)
+	it := list.Iterator()
+	for it.Next() {
+		if it.Value()%2 == 0 {
+			it.Remove()
+		}
+	}
+	if actualValue, expectedValue := list.Values(), []int{1, 3, 5}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := list.Size(), 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// remove everything, including first and last
+	it = list.Iterator()
+	for it.Next() {
+		it.Remove()
+		it.Remove() // no current element, no-op
+	}
+	if actualValue := list.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	list.Add(7, 8)
+	if actualValue, expectedValue := list.Values(), []int{7, 8}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// indices follow removals
+	list = New[int](1, 2, 3)
+	it = list.Iterator()
+	it.Next()
+	it.Remove()
+	if actualValue := it.Next(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue, expectedValue := it.Index(), 0; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := it.Value(), 2; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestListIteratorSetAndInsert(t *testing.T) {
+	list := New[string]("a", "c", "e")
+	it := list.Iterator()
+	it.Set("x") // no current element, no-op
+	it.InsertBefore("x")
+	it.InsertAfter("x")
+	for it.Next() {
+		switch it.Value() {
+		case "a":
+			it.InsertBefore("_")
+			it.InsertAfter("b")
+		case "c":
+			it.Set("C")
+			it.InsertAfter("d")
+		case "e":
+			it.InsertAfter("f")
+		}
+	}
+	if actualValue, expectedValue := list.Values(), []string{"_", "a", "b", "C", "d", "e", "f"}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := list.Size(), 7; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// last element is updated on insert after the last one
+	list.Add("g")
+	if actualValue, expectedValue := list.Values(), []string{"_", "a", "b", "C", "d", "e", "f", "g"}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// indices follow insertions
+	it = list.Iterator()
+	for it.Next() {
+		if actualValue, _ := list.Get(it.Index()); actualValue != it.Value() {
+			t.Errorf("Got %v expected %v", actualValue, it.Value())
+		}
+		it.InsertBefore("-")
+	}
+	if actualValue, expectedValue := list.Size(), 16; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestListRemoveIfAndRetainIf(t *testing.T) {
+	list := New[int](1, 2, 3, 4, 5, 6, 7, 8, 9, 10)
+	list.RemoveIf(func(value int) bool { return value%3 == 0 })
+	if actualValue, expectedValue := list.Values(), []int{1, 2, 4, 5, 7, 8, 10}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	list.RetainIf(func(value int) bool { return value%2 == 0 })
+	if actualValue, expectedValue := list.Values(), []int{2, 4, 8, 10}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	list.RemoveIf(func(value int) bool { return true })
+	if actualValue := list.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	list.Add(1)
+	if actualValue, expectedValue := list.Values(), []int{1}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestListIteratorRemoveReverse(t *testing.T) {
+	list := New[int](1, 2, 3, 4, 5)
+	it := list.Iterator()
+	for it.End(); it.Prev(); {
+		if it.Value()%2 == 1 {
+			it.Remove()
+		}
+	}
+	if actualValue, expectedValue := list.Values(), []int{2, 4}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// Prev after Remove moves to the element before the removed one
+	list = New[int](1, 2, 3)
+	it = list.Iterator()
+	it.Next()
+	it.Next()
+	it.Remove()
+	if actualValue := it.Prev(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue, expectedValue := it.Value(), 1; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	it.Remove()
+	if actualValue := it.Prev(); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if actualValue := it.Next(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue, expectedValue := it.Value(), 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestListRemoveRange(t *testing.T) {
+	list := New[int](0, 1, 2, 3, 4, 5)
+	list.RemoveRange(1, 3)
+	if actualValue, expectedValue := list.Values(), []int{0, 3, 4, 5}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	list.RemoveRange(2, 4)
+	if actualValue, expectedValue := list.Values(), []int{0, 3}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	// invalid or empty ranges are ignored
+	list.RemoveRange(-1, 1)
+	list.RemoveRange(1, 3)
+	list.RemoveRange(1, 1)
+	list.RemoveRange(1, 0)
+	if actualValue, expectedValue := list.Values(), []int{0, 3}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	list.RemoveRange(0, 2)
+	if actualValue, expectedValue := list.Size(), 0; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	list.Add(7)
+	if actualValue, expectedValue := list.Values(), []int{7}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestListReverseAndRotate(t *testing.T) {
+	list := New[int]()
+	list.Reverse()
+	list.Rotate(3)
+	if actualValue, expectedValue := list.Size(), 0; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	list.Add(1, 2, 3, 4, 5)
+	list.Reverse()
+	if actualValue, expectedValue := list.Values(), []int{5, 4, 3, 2, 1}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	list.Reverse()
+	list.Add(6)
+	if actualValue, expectedValue := list.Values(), []int{1, 2, 3, 4, 5, 6}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	tests := []struct {
+		distance int
+		expected []int
+	}{
+		{0, []int{1, 2, 3, 4, 5, 6}},
+		{1, []int{6, 1, 2, 3, 4, 5}},
+		{2, []int{5, 6, 1, 2, 3, 4}},
+		{-1, []int{2, 3, 4, 5, 6, 1}},
+		{6, []int{1, 2, 3, 4, 5, 6}},
+		{13, []int{6, 1, 2, 3, 4, 5}},
+		{-8, []int{3, 4, 5, 6, 1, 2}},
+	}
+	for _, test := range tests {
+		list := New[int](1, 2, 3, 4, 5, 6)
+		list.Rotate(test.distance)
+		if actualValue := list.Values(); !slices.Equal(actualValue, test.expected) {
+			t.Errorf("Rotate(%v): Got %v expected %v", test.distance, actualValue, test.expected)
+		}
+		list.Add(7)
+		if actualValue, expectedValue := list.Size(), 7; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		if actualValue, _ := list.Get(6); actualValue != 7 {
+			t.Errorf("Got %v expected %v", actualValue, 7)
+		}
+	}
+}
+
+func TestListAddAll(t *testing.T) {
+	list := New[int](1, 2)
+	list.AddAll(New[int](3, 4))
+	list.AddAll(list)
+	if actualValue, expectedValue := list.Values(), []int{1, 2, 3, 4, 1, 2, 3, 4}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestListSubList(t *testing.T) {
+	list := New[int](0, 1, 2, 3, 4, 5, 6, 7)
+	view := list.SubList(2, 6)
+	if actualValue, expectedValue := view.Values(), []int{2, 3, 4, 5}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, ok := view.Get(0); actualValue != 2 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 2)
+	}
+	if _, ok := view.Get(4); ok {
+		t.Errorf("Got %v expected %v", ok, false)
+	}
+	if actualValue := view.Contains(2, 5); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := view.Contains(1); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+
+	// writes go through to the list and the other way around
+	view.Set(0, 20)
+	list.Set(5, 50)
+	if actualValue, expectedValue := view.Values(), []int{20, 3, 4, 50}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	view.Reverse()
+	view.Swap(0, 3)
+	if actualValue, expectedValue := list.Values(), []int{0, 1, 20, 4, 3, 50, 6, 7}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	view.Sort(cmp.Compare[int])
+	view.Rotate(1)
+	if actualValue, expectedValue := list.Values(), []int{0, 1, 50, 3, 4, 20, 6, 7}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// structural changes through the view resize it
+	view.Add(8)
+	view.Insert(0, 9)
+	view.Remove(1)
+	if actualValue, expectedValue := view.Values(), []int{9, 3, 4, 20, 8}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	view.RemoveIf(func(value int) bool { return value > 5 })
+	if actualValue, expectedValue := view.Size(), 2; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	view.AddAll(New[int](5, 5))
+	view.RemoveRange(2, 3)
+	if actualValue, expectedValue := list.Values(), []int{0, 1, 3, 4, 5, 6, 7}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// nested views resize their parents
+	inner := view.SubList(1, 3)
+	inner.Clear()
+	if actualValue, expectedValue := view.Values(), []int{3}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	inner.Add(1, 2)
+	if actualValue, expectedValue := view.Values(), []int{3, 1, 2}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	view.Clear()
+	if actualValue, expectedValue := list.Values(), []int{0, 1, 6, 7}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue := view.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue, expectedValue := view.String(), "View\n"; !strings.HasSuffix(actualValue, expectedValue) {
+		t.Errorf("Got %v expected suffix %v", actualValue, expectedValue)
+	}
+
+	// structural changes of the list invalidate the view
+	list.Add(8)
+	testutils.ConcurrentModificationPanic(t, func() { view.Size() })
+	testutils.ConcurrentModificationPanic(t, func() { inner.Values() })
+
+	// invalid ranges
+	for _, r := range [][2]int{{-1, 2}, {0, 6}, {3, 2}} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("SubList(%v, %v) should panic", r[0], r[1])
+				}
+			}()
+			list.SubList(r[0], r[1])
+		}()
+	}
+	if actualValue := list.SubList(5, 5).Size(); actualValue != 0 {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+}
+
+func TestListIteratorFailFast(t *testing.T) {
+	list := New[int](1, 2, 3)
+	it := list.Iterator()
+	it.Next()
+	list.Add(4)
+	testutils.ConcurrentModificationPanic(t, func() { it.Next() })
+	testutils.ConcurrentModificationPanic(t, func() { it.Prev() })
+
+	// reset iterator is valid again
+	count := 0
+	for it.Begin(); it.Next(); {
+		count++
+	}
+	if actualValue, expectedValue := count, 4; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// replacing values is not a structural modification
+	it.First()
+	list.Set(0, 10)
+	list.Swap(1, 2)
+	if actualValue := it.Next(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+
+	for _, modify := range []func(){
+		func() { list.Remove(0) },
+		func() { list.Insert(1, 5) },
+		func() { list.Sort(cmp.Compare[int]) },
+		func() { list.RemoveIf(func(value int) bool { return value == 5 }) },
+		func() { list.Clear() },
+	} {
+		list.Add(1, 2, 3)
+		it.First()
+		modify()
+		testutils.ConcurrentModificationPanic(t, func() { it.Next() })
+	}
+
+	list.SetFailFast(false)
+	list.Add(1, 2, 3)
+	it.First()
+	list.Remove(0)
+	it.Next() // no panic
+}
+
+func TestListRandomOperations(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	list := New[int]()
+	var expected []int
+	for i := 0; i < 5000; i++ {
+		size := len(expected)
+		switch op := random.Intn(10); {
+		case op < 4:
+			index := random.Intn(size + 1)
+			values := make([]int, random.Intn(2*chunkSize)+1)
+			for j := range values {
+				values[j] = random.Intn(1000)
+			}
+			list.Insert(index, values...)
+			expected = slices.Insert(expected, index, values...)
+		case op < 6 && size > 0:
+			index := random.Intn(size)
+			list.Remove(index)
+			expected = slices.Delete(expected, index, index+1)
+		case op < 7 && size > 0:
+			from := random.Intn(size)
+			to := from + random.Intn(size-from) + 1
+			list.RemoveRange(from, to)
+			expected = slices.Delete(expected, from, to)
+		case op < 8 && size > 0:
+			index, value := random.Intn(size), random.Intn(1000)
+			list.Set(index, value)
+			expected[index] = value
+		case op < 9:
+			distance := random.Intn(2*size+1) - size
+			list.Rotate(distance)
+			if size > 0 {
+				distance = (distance%size + size) % size
+				expected = append(expected[size-distance:], expected[:size-distance]...)
+			}
+		default:
+			value := random.Intn(1000)
+			list.RemoveIf(func(v int) bool { return v == value })
+			expected = slices.DeleteFunc(expected, func(v int) bool { return v == value })
+		}
+		if actualValue, expectedValue := list.Size(), len(expected); actualValue != expectedValue {
+			t.Fatalf("Got %v expected %v", actualValue, expectedValue)
+		}
+		if len(expected) > 0 {
+			index := random.Intn(len(expected))
+			if actualValue, ok := list.Get(index); actualValue != expected[index] || !ok {
+				t.Fatalf("Got %v expected %v", actualValue, expected[index])
+			}
+		}
+	}
+	if actualValue := list.Values(); !slices.Equal(actualValue, expected) {
+		t.Errorf("Got %v expected %v", actualValue, expected)
+	}
+	i := 0
+	for it := list.Iterator(); it.Next(); i++ {
+		if actualValue := it.Value(); actualValue != expected[i] {
+			t.Fatalf("Got %v expected %v", actualValue, expected[i])
+		}
+	}
+}
+
+func TestListConcatAndSplit(t *testing.T) {
+	values := make([]int, 3*chunkSize)
+	for i := range values {
+		values[i] = i
+	}
+	list := New[int](values[:chunkSize+10]...)
+	other := New[int](values[chunkSize+10:]...)
+	list.Concat(other)
+	if actualValue := list.Values(); !slices.Equal(actualValue, values) {
+		t.Errorf("Got %v expected %v", actualValue, values)
+	}
+	if actualValue := other.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	list.Concat(list)
+	list.Concat(other)
+	if actualValue, expectedValue := list.Size(), len(values); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	for _, index := range []int{len(values) - 1, 2*chunkSize + 1, chunkSize, 7, 0} {
+		tail := list.Split(index)
+		if actualValue, expectedValue := list.Values(), values[:index]; !slices.Equal(actualValue, expectedValue) {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		if actualValue, expectedValue := tail.Values(), values[index:]; !slices.Equal(actualValue, expectedValue) {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		list.Concat(tail)
+	}
+	if actualValue := list.Split(len(values)); actualValue.Size() != 0 || list.Size() != len(values) {
+		t.Errorf("Got %v %v expected %v %v", actualValue.Size(), list.Size(), 0, len(values))
+	}
+	if actualValue := list.Split(-1); actualValue.Size() != 0 || list.Size() != len(values) {
+		t.Errorf("Got %v %v expected %v %v", actualValue.Size(), list.Size(), 0, len(values))
+	}
+
+	it := list.Iterator()
+	it.Next()
+	list.Concat(New[int](1))
+	testutils.ConcurrentModificationPanic(t, func() { it.Next() })
+}
+
+func TestListSplitFailFast(t *testing.T) {
+	list := New[int](1, 2, 3)
+	list.SetFailFast(false)
+	for _, tail := range []*List[int]{list.Split(1), list.Split(5)} {
+		tail.Add(4)
+		it := tail.Iterator()
+		it.Next()
+		tail.Add(5)
+		it.Next() // no panic
+	}
+}
+
+func TestListChunks(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	list := New[int]()
+	for i := 0; i < 20*chunkSize; i++ {
+		list.Insert(random.Intn(list.Size()+1), i)
+	}
+	for i := 0; i < 5*chunkSize; i++ {
+		list.Remove(random.Intn(list.Size()))
+		list.RemoveRange(5, 7)
+		list.Rotate(random.Intn(list.Size()))
+		list.Concat(list.Split(random.Intn(list.Size())))
+	}
+	var chunks []int
+	eachChunk(list.root, 0, list.Size(), func(chunk []int) bool {
+		chunks = append(chunks, len(chunk))
+		return true
+	})
+	for i := 1; i < len(chunks); i++ {
+		if chunks[i-1] < chunkSize/2 && chunks[i] < chunkSize/2 {
+			t.Errorf("Got adjacent chunks of %v and %v values", chunks[i-1], chunks[i])
+		}
+	}
+	if actualValue, expectedValue := len(chunks), 2*list.Size()/(chunkSize/2)+1; actualValue > expectedValue {
+		t.Errorf("Got %v chunks expected at most %v", actualValue, expectedValue)
+	}
+}
+
+func TestText(t *testing.T) {
+	text := NewString("héllo wörld")
+	InsertString(text, 5, ", dear")
+	if actualValue, expectedValue := Text(text), "héllo, dear wörld"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := Substring(text, 12, 17), "wörld"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	text.RemoveRange(5, 11)
+	if actualValue, expectedValue := Text(text), "héllo wörld"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := Text(NewString("")), ""; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	large := strings.Repeat("abcdefghij", 100)
+	text = NewString(large)
+	InsertString(text, 500, "-")
+	if actualValue, expectedValue := Text(text), large[:500]+"-"+large[500:]; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	data := NewBytes([]byte("abc"))
+	data.Insert(1, 'x')
+	if actualValue, expectedValue := string(Bytes(data)), "axbc"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestListSerialization(t *testing.T) {
+	list := New[string]()
+	list.Add("a", "b", "c")
+
+	var err error
+	assert := func() {
+		if actualValue, expectedValue := list.Values(), []string{"a", "b", "c"}; !slices.Equal(actualValue, expectedValue) {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		if actualValue, expectedValue := list.Size(), 3; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		if err != nil {
+			t.Errorf("Got error %v", err)
+		}
+	}
+
+	assert()
+
+	bytes, err := list.ToJSON()
+	assert()
+
+	err = list.FromJSON(bytes)
+	assert()
+
+	bytes, err = json.Marshal([]any{"a", "b", "c", list})
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+
+	err = json.Unmarshal([]byte(`["a","b","c"]`), &list)
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	assert()
+}
+
+func TestListString(t *testing.T) {
+	c := New[int]()
+	c.Add(1)
+	if !strings.HasPrefix(c.String(), "Rope") {
+		t.Errorf("String should start with container name")
+	}
+}
+
+func benchmarkGet(b *testing.B, list *List[int], size int) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			list.Get(n)
+		}
+	}
+}
+
+func benchmarkAdd(b *testing.B, list *List[int], size int) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			list.Add(n)
+		}
+	}
+}
+
+func benchmarkRemove(b *testing.B, list *List[int], size int) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			list.Remove(n)
+		}
+	}
+}
+
+func BenchmarkRopeGet100(b *testing.B) {
+	b.StopTimer()
+	size := 100
+	list := New[int]()
+	for n := 0; n < size; n++ {
+		list.Add(n)
+	}
+	b.StartTimer()
+	benchmarkGet(b, list, size)
+}
+
+func BenchmarkRopeGet1000(b *testing.B) {
+	b.StopTimer()
+	size := 1000
+	list := New[int]()
+	for n := 0; n < size; n++ {
+		list.Add(n)
+	}
+	b.StartTimer()
+	benchmarkGet(b, list, size)
+}
+
+func BenchmarkRopeGet10000(b *testing.B) {
+	b.StopTimer()
+	size := 10000
+	list := New[int]()
+	for n := 0; n < size; n++ {
+		list.Add(n)
+	}
+	b.StartTimer()
+	benchmarkGet(b, list, size)
+}
+
+func BenchmarkRopeGet100000(b *testing.B) {
+	b.StopTimer()
+	size := 100000
+	list := New[int]()
+	for n := 0; n < size; n++ {
+		list.Add(n)
+	}
+	b.StartTimer()
+	benchmarkGet(b, list, size)
+}
+
+func BenchmarkRopeAdd100(b *testing.B) {
+	b.StopTimer()
+	size := 100
+	list := New[int]()
+	b.StartTimer()
+	benchmarkAdd(b, list, size)
+}
+
+func BenchmarkRopeAdd1000(b *testing.B) {
+	b.StopTimer()
+	size := 1000
+	list := New[int]()
+	for n := 0; n < size; n++ {
+		list.Add(n)
+	}
+	b.StartTimer()
+	benchmarkAdd(b, list, size)
+}
+
+func BenchmarkRopeAdd10000(b *testing.B) {
+	b.StopTimer()
+	size := 10000
+	list := New[int]()
+	for n := 0; n < size; n++ {
+		list.Add(n)
+	}
+	b.StartTimer()
+	benchmarkAdd(b, list, size)
+}
+
+func BenchmarkRopeAdd100000(b *testing.B) {
+	b.StopTimer()
+	size := 100000
+	list := New[int]()
+	for n := 0; n < size; n++ {
+		list.Add(n)
+	}
+	b.StartTimer()
+	benchmarkAdd(b, list, size)
+}
+
+func BenchmarkRopeRemove100(b *testing.B) {
+	b.StopTimer()
+	size := 100
+	list := New[int]()
+	for n := 0; n < size; n++ {
+		list.Add(n)
+	}
+	b.StartTimer()
+	benchmarkRemove(b, list, size)
+}
+
+func BenchmarkRopeRemove1000(b *testing.B) {
+	b.StopTimer()
+	size := 1000
+	list := New[int]()
+	for n := 0; n < size; n++ {
+		list.Add(n)
+	}
+	b.StartTimer()
+	benchmarkRemove(b, list, size)
+}
+
+func BenchmarkRopeRemove10000(b *testing.B) {
+	b.StopTimer()
+	size := 10000
+	list := New[int]()
+	for n := 0; n < size; n++ {
+		list.Add(n)
+	}
+	b.StartTimer()
+	benchmarkRemove(b, list, size)
+}
+
+func BenchmarkRopeRemove100000(b *testing.B) {
+	b.StopTimer()
+	size := 100000
+	list := New[int]()
+	for n := 0; n < size; n++ {
+		list.Add(n)
+	}
+	b.StartTimer()
+	benchmarkRemove(b, list, size)
+}
@@ -0,0 +1,50 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package rope
+
+import (
+	"strings"
+
+	"github.com/emirpasic/gods/v2/lists"
+)
+
+// NewString instantiates a new list of the runes of the string.
+func NewString(s string) *List[rune] {
+	return New([]rune(s)...)
+}
+
+// NewBytes instantiates a new list of the bytes.
+func NewBytes(b []byte) *List[byte] {
+	return New(b...)
+}
+
+// InsertString inserts the runes of the string at specified index position, see List.Insert.
+func InsertString(list *List[rune], index int, s string) {
+	list.Insert(index, []rune(s)...)
+}
+
+// Substring returns the runes from index "from" (inclusive) to "to" (exclusive) as a string.
+// Panics if the range is not within the list, i.e. unless 0 <= from <= to <= Size().
+func Substring(list *List[rune], from, to int) string {
+	lists.CheckRange(from, to, list.Size())
+	var builder strings.Builder
+	eachChunk(list.root, from, to, func(chunk []rune) bool {
+		for _, r := range chunk {
+			builder.WriteRune(r)
+		}
+		return true
+	})
+	return builder.String()
+}
+
+// Text returns the runes of the list as a string.
+func Text(list *List[rune]) string {
+	return Substring(list, 0, list.Size())
+}
+
+// Bytes returns the bytes of the list.
+func Bytes(list *List[byte]) []byte {
+	return list.Values()
+}
@@ -0,0 +1,83 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package rope
+
+import (
+	"github.com/emirpasic/gods/v2/lists"
+	"github.com/emirpasic/gods/v2/utils"
+)
+
+// Assert Backing implementation
+var _ lists.Backing[int] = backing[int]{}
+
+// SubList returns a live view of the elements of the list from index "from" (inclusive) to "to" (exclusive),
+// see lists.View.
+// Panics if the range is not within the list, i.e. unless 0 <= from <= to <= Size().
+func (list *List[T]) SubList(from, to int) lists.List[T] {
+	return lists.NewView[T](backing[T]{list}, from, to)
+}
+
+// backing exposes the list to its views.
+type backing[T comparable] struct {
+	list *List[T]
+}
+
+func (b backing[T]) Name() string {
+	return "Rope"
+}
+
+func (b backing[T]) ModCount() int {
+	return b.list.modCount
+}
+
+func (b backing[T]) FailFast() bool {
+	return !b.list.failFastDisabled
+}
+
+func (b backing[T]) Size() int {
+	return b.list.Size()
+}
+
+func (b backing[T]) Get(index int) T {
+	chunk, start := b.list.chunkAt(index)
+	return chunk[index-start]
+}
+
+func (b backing[T]) Set(index int, value T) {
+	chunk, start := b.list.chunkAt(index)
+	chunk[index-start] = value
+}
+
+func (b backing[T]) Swap(i, j int) {
+	b.list.Swap(i, j)
+}
+
+func (b backing[T]) Insert(index int, values ...T) {
+	b.list.Insert(index, values...)
+}
+
+func (b backing[T]) Values(from, to int) []T {
+	return b.list.values(from, to)
+}
+
+func (b backing[T]) RemoveRange(from, to int) {
+	b.list.removeRange(from, to)
+}
+
+func (b backing[T]) Reverse(from, to int) {
+	b.list.reverse(from, to)
+}
+
+func (b backing[T]) Rotate(from, to, distance int) {
+	b.list.rotate(from, to, distance)
+}
+
+func (b backing[T]) RemoveIf(from, to int, predicate func(value T) bool) int {
+	return b.list.removeIf(from, to, predicate)
+}
+
+func (b backing[T]) Sort(from, to int, comparator utils.Comparator[T]) {
+	b.list.sortRange(from, to, comparator)
+}
@@ -4,21 +4,28 @@
 
 package singlylinkedlist
 
-import "github.com/emirpasic/gods/v2/containers"
+import (
+	"github.com/emirpasic/gods/v2/containers"
+	"github.com/emirpasic/gods/v2/lists"
+)
 
 // Assert Iterator implementation
 var _ containers.IteratorWithIndex[int] = (*Iterator[int])(nil)
+var _ lists.ListIterator[int] = (*Iterator[int])(nil)
 
 // Iterator holding the iterator's state
 type Iterator[T comparable] struct {
-	list    *List[T]
-	index   int
-	element *element[T]
+	list     *List[T]
+	index    int
+	element  *element[T]
+	prev     *element[T] // element before the current one, needed to unlink the current one
+	removed  bool        // current element was removed
+	modCount int         // list's modification count the iterator is in sync with
 }
 
 // Iterator returns a stateful iterator whose values can be fetched by an index.
 func (list *List[T]) Iterator() *Iterator[T] {
-	return &Iterator[T]{list: list, index: -1, element: nil}
+	return &Iterator[T]{list: list, index: -1, element: nil, modCount: list.modCount}
 }
 
 // Next moves the iterator to the next element and returns true if there was a next element in the container.
@@ -26,16 +33,21 @@ func (list *List[T]) Iterator() *Iterator[T] {
 // If Next() was called for the first time, then it will point the iterator to the first element if it exists.
 // Modifies the state of the iterator.
 func (iterator *Iterator[T]) Next() bool {
+	iterator.checkModification()
+	iterator.removed = false
 	if iterator.index < iterator.list.size {
 		iterator.index++
 	}
 	if !iterator.list.withinRange(iterator.index) {
 		iterator.element = nil
+		iterator.prev = nil
 		return false
 	}
 	if iterator.index == 0 {
+		iterator.prev = nil
 		iterator.element = iterator.list.first
 	} else {
+		iterator.prev = iterator.element
 		iterator.element = iterator.element.next
 	}
 	return true
@@ -58,6 +70,9 @@ func (iterator *Iterator[T]) Index() int {
 func (iterator *Iterator[T]) Begin() {
 	iterator.index = -1
 	iterator.element = nil
+	iterator.prev = nil
+	iterator.removed = false
+	iterator.modCount = iterator.list.modCount
 }
 
 // First moves the iterator to the first element and returns true if there was a first element in the container.
@@ -81,3 +96,88 @@ func (iterator *Iterator[T]) NextTo(f func(index int, value T) bool) bool {
 	}
 	return false
 }
+
+// Remove removes the current element from the list in O(1).
+// The iterator is left before the element that followed the removed one, call Next() to move to it.
+// Does nothing if there is no current element.
+func (iterator *Iterator[T]) Remove() {
+	if !iterator.valid() {
+		return
+	}
+	list, removed := iterator.list, iterator.element
+	if iterator.prev == nil {
+		list.first = removed.next
+	} else {
+		iterator.prev.next = removed.next
+	}
+	if removed == list.last {
+		list.last = iterator.prev
+	}
+	list.size--
+	list.modCount++
+	iterator.modCount = list.modCount
+	iterator.element = iterator.prev
+	iterator.index--
+	iterator.removed = true
+}
+
+// Set replaces the value of the current element.
+// Does nothing if there is no current element.
+func (iterator *Iterator[T]) Set(value T) {
+	if iterator.valid() {
+		iterator.element.value = value
+	}
+}
+
+// InsertBefore inserts the value in front of the current element in O(1), the iterator stays on the current element.
+// Does nothing if there is no current element.
+func (iterator *Iterator[T]) InsertBefore(value T) {
+	if !iterator.valid() {
+		return
+	}
+	newElement := &element[T]{value: value, next: iterator.element}
+	if iterator.prev == nil {
+		iterator.list.first = newElement
+	} else {
+		iterator.prev.next = newElement
+	}
+	iterator.prev = newElement
+	iterator.list.size++
+	iterator.list.modCount++
+	iterator.modCount = iterator.list.modCount
+	iterator.index++
+}
+
+// InsertAfter inserts the value after the current element in O(1), the next call to Next() moves to it.
+// Does nothing if there is no current element.
+func (iterator *Iterator[T]) InsertAfter(value T) {
+	if !iterator.valid() {
+		return
+	}
+	newElement := &element[T]{value: value, next: iterator.element.next}
+	iterator.element.next = newElement
+	if iterator.element == iterator.list.last {
+		iterator.list.last = newElement
+	}
+	iterator.list.size++
+	iterator.list.modCount++
+	iterator.modCount = iterator.list.modCount
+}
+
+// valid returns true if the iterator is on an element of the list.
+func (iterator *Iterator[T]) valid() bool {
+	return !iterator.removed && iterator.list.withinRange(iterator.index)
+}
+
+// checkModification panics if the list was structurally modified other than through the iterator,
+// unless fail-fast iteration is disabled for the list.
+func (iterator *Iterator[T]) checkModification() {
+	if iterator.index == -1 {
+		// not on an element yet, nothing to invalidate
+		iterator.modCount = iterator.list.modCount
+		return
+	}
+	if iterator.modCount != iterator.list.modCount && !iterator.list.failFastDisabled {
+		panic(&containers.ConcurrentModificationError{Container: "SinglyLinkedList"})
+	}
+}
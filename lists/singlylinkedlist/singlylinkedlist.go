@@ -11,21 +11,24 @@ package singlylinkedlist
 
 import (
 	"fmt"
-	"slices"
 	"strings"
 
+	"github.com/emirpasic/gods/v2/containers"
 	"github.com/emirpasic/gods/v2/lists"
 	"github.com/emirpasic/gods/v2/utils"
 )
 
 // Assert List implementation
 var _ lists.List[int] = (*List[int])(nil)
+var _ containers.FailFast = (*List[int])(nil)
 
 // List holds the elements, where each element points to the next element
 type List[T comparable] struct {
-	first *element[T]
-	last  *element[T]
-	size  int
+	first            *element[T]
+	last             *element[T]
+	size             int
+	modCount         int  // number of structural modifications, checked by iterators
+	failFastDisabled bool // iterators do not check for modifications
 }
 
 type element[T comparable] struct {
@@ -54,6 +57,7 @@ func (list *List[T]) Add(values ...T) {
 			list.last = newElement
 		}
 		list.size++
+		list.modCount++
 	}
 }
 
@@ -72,6 +76,7 @@ func (list *List[T]) Prepend(values ...T) {
 			list.last = newElement
 		}
 		list.size++
+		list.modCount++
 	}
 }
 
@@ -122,6 +127,7 @@ func (list *List[T]) Remove(index int) {
 	element = nil
 
 	list.size--
+	list.modCount++
 }
 
 // Contains checks if values (one or more) are present in the set.
@@ -186,24 +192,30 @@ func (list *List[T]) Size() int {
 // Clear removes all elements from the list.
 func (list *List[T]) Clear() {
 	list.size = 0
+	list.modCount++
 	list.first = nil
 	list.last = nil
 }
 
-// Sort sort values (in-place) using.
+// Sort sorts values (in-place) using a Comparator.
+// The sort is a stable merge sort that relinks the elements in O(n log n) without allocating.
 func (list *List[T]) Sort(comparator utils.Comparator[T]) {
+	list.sortRange(0, list.size, comparator)
+}
 
-	if list.size < 2 {
+// Merge moves all elements of the other list into this list in O(n+m) without allocating, leaving the other list empty.
+// Both lists have to be sorted by the comparator, the result is sorted as well. Values of this list come before equal
+// values of the other list.
+func (list *List[T]) Merge(other *List[T], comparator utils.Comparator[T]) {
+	if other == list || other.size == 0 {
 		return
 	}
-
-	values := list.Values()
-	slices.SortFunc(values, comparator)
-
-	list.Clear()
-
-	list.Add(values...)
-
+	first, last := merge(list.first, other.first, comparator)
+	list.first, list.last = first, last
+	list.size += other.size
+	list.modCount++
+	other.first, other.last, other.size = nil, nil, 0
+	other.modCount++
 }
 
 // Swap swaps values of two elements at the given indices.
@@ -236,6 +248,7 @@ func (list *List[T]) Insert(index int, values ...T) {
 	}
 
 	list.size += len(values)
+	list.modCount++
 
 	var beforeElement *element[T]
 	foundElement := list.first
@@ -286,6 +299,49 @@ func (list *List[T]) Set(index int, value T) {
 	foundElement.value = value
 }
 
+// RemoveIf removes all elements for which the predicate returns true, in a single pass over the list.
+func (list *List[T]) RemoveIf(predicate func(value T) bool) {
+	list.removeIf(0, list.size, predicate)
+}
+
+// RetainIf removes all elements for which the predicate returns false, in a single pass over the list.
+func (list *List[T]) RetainIf(predicate func(value T) bool) {
+	list.RemoveIf(func(value T) bool { return !predicate(value) })
+}
+
+// RemoveRange removes the elements from index "from" (inclusive) to "to" (exclusive).
+// Does not do anything if the range is not within the list.
+func (list *List[T]) RemoveRange(from, to int) {
+	if from < 0 || to > list.size || from >= to {
+		return
+	}
+	list.removeRange(from, to)
+}
+
+// Reverse reverses the order of the elements in place by reversing the links between them.
+func (list *List[T]) Reverse() {
+	list.reverse(0, list.size)
+}
+
+// Rotate rotates the elements by the given distance, i.e. the element at index i moves to index
+// (i + distance) modulo Size(). A negative distance rotates towards the front,
+// e.g. [a,b,c,d] rotated by 1 is [d,a,b,c] and rotated by -1 is [b,c,d,a].
+// Elements are relinked in O(1) once the new front is found.
+func (list *List[T]) Rotate(distance int) {
+	list.rotate(0, list.size, distance)
+}
+
+// AddAll appends all values of another list at the end of the list.
+func (list *List[T]) AddAll(another lists.List[T]) {
+	list.Add(another.Values()...)
+}
+
+// SetFailFast enables or disables the checks of iterators for modifications of the list done other than through
+// the iterator itself, see containers.ConcurrentModificationError. Checks are enabled by default.
+func (list *List[T]) SetFailFast(enabled bool) {
+	list.failFastDisabled = !enabled
+}
+
 // String returns a string representation of container
 func (list *List[T]) String() string {
 	str := "SinglyLinkedList\n"
@@ -301,3 +357,201 @@ func (list *List[T]) String() string {
 func (list *List[T]) withinRange(index int) bool {
 	return index >= 0 && index < list.size
 }
+
+// elementAt returns the element at the index, which has to be within range, or nil for index -1.
+func (list *List[T]) elementAt(index int) *element[T] {
+	if index < 0 {
+		return nil
+	}
+	e := list.first
+	for i := 0; i < index; i++ {
+		e = e.next
+	}
+	return e
+}
+
+// removeRange removes the elements from index "from" (inclusive) to "to" (exclusive), which has to be a valid range.
+func (list *List[T]) removeRange(from, to int) {
+	before := list.elementAt(from - 1)
+	after := list.next(before)
+	for i := from; i < to; i++ {
+		after = after.next
+	}
+	list.link(before, after)
+	list.size -= to - from
+	list.modCount++
+}
+
+// removeIf removes the elements within the range for which the predicate returns true and returns their number.
+func (list *List[T]) removeIf(from, to int, predicate func(value T) bool) int {
+	removed := 0
+	prev := list.elementAt(from - 1)
+	e := list.next(prev)
+	for i := from; i < to; i++ {
+		next := e.next
+		if predicate(e.value) {
+			list.link(prev, next)
+			removed++
+		} else {
+			prev = e
+		}
+		e = next
+	}
+	if removed > 0 {
+		list.size -= removed
+		list.modCount++
+	}
+	return removed
+}
+
+// sortRange sorts the elements within the range by relinking them in sorted order, see mergeSort.
+func (list *List[T]) sortRange(from, to int, comparator utils.Comparator[T]) {
+	if to-from < 2 {
+		return
+	}
+	before := list.elementAt(from - 1)
+	first := list.next(before)
+	last := first
+	for i := from + 1; i < to; i++ {
+		last = last.next
+	}
+	after := last.next
+	last.next = nil
+	first, last = mergeSort(first, to-from, comparator)
+	list.link(before, first)
+	list.link(last, after)
+	list.modCount++
+}
+
+// reverse reverses the order of the elements within the range by reversing the links between them.
+func (list *List[T]) reverse(from, to int) {
+	if to-from < 2 {
+		return
+	}
+	before := list.elementAt(from - 1)
+	first := list.next(before)
+	prev, e := (*element[T])(nil), first
+	for i := from; i < to; i++ {
+		next := e.next
+		e.next = prev
+		prev, e = e, next
+	}
+	// prev is the last element of the range, e the one following it
+	list.link(before, prev)
+	list.link(first, e)
+	list.modCount++
+}
+
+// rotate rotates the elements within the range by the given distance by relinking its two parts.
+func (list *List[T]) rotate(from, to, distance int) {
+	n := to - from
+	if n < 2 {
+		return
+	}
+	distance = (distance%n + n) % n
+	if distance == 0 {
+		return
+	}
+	before := list.elementAt(from - 1)
+	first := list.next(before)
+	midPrev := first // last element of the part that moves to the back
+	for i := 1; i < n-distance; i++ {
+		midPrev = midPrev.next
+	}
+	last := midPrev
+	for i := 0; i < distance; i++ {
+		last = last.next
+	}
+	mid, after := midPrev.next, last.next
+	list.link(before, mid)
+	list.link(last, first)
+	list.link(midPrev, after)
+	list.modCount++
+}
+
+// next returns the element following e, or the first element if e is nil.
+func (list *List[T]) next(e *element[T]) *element[T] {
+	if e == nil {
+		return list.first
+	}
+	return e.next
+}
+
+// link makes b follow a, either of which may be nil for the front or back of the list.
+func (list *List[T]) link(a, b *element[T]) {
+	if a == nil {
+		list.first = b
+	} else {
+		a.next = b
+	}
+	if b == nil {
+		list.last = a
+	}
+}
+
+// mergeSort sorts the chain of n elements starting at first, whose last element has no next, with a bottom-up
+// merge sort, i.e. by merging runs of doubling width in place. Returns the first and last element of the sorted chain.
+func mergeSort[T comparable](first *element[T], n int, comparator utils.Comparator[T]) (*element[T], *element[T]) {
+	var last *element[T]
+	for width := 1; width < n; width *= 2 {
+		rest := first
+		first, last = nil, nil
+		for rest != nil {
+			left := rest
+			right := split(left, width)
+			rest = split(right, width)
+			mergedFirst, mergedLast := merge(left, right, comparator)
+			if last == nil {
+				first = mergedFirst
+			} else {
+				last.next = mergedFirst
+			}
+			last = mergedLast
+		}
+	}
+	return first, last
+}
+
+// split cuts the chain starting at e after n elements and returns the rest of it, which may be nil.
+func split[T comparable](e *element[T], n int) *element[T] {
+	for i := 1; e != nil && i < n; i++ {
+		e = e.next
+	}
+	if e == nil {
+		return nil
+	}
+	rest := e.next
+	e.next = nil
+	return rest
+}
+
+// merge merges the sorted chains a and b, taking elements from a first among equal ones, and returns the first
+// and last element of the merged chain. Either chain may be empty, but not both.
+func merge[T comparable](a, b *element[T], comparator utils.Comparator[T]) (first, last *element[T]) {
+	for a != nil && b != nil {
+		var e *element[T]
+		if comparator(a.value, b.value) <= 0 {
+			e, a = a, a.next
+		} else {
+			e, b = b, b.next
+		}
+		if last == nil {
+			first = e
+		} else {
+			last.next = e
+		}
+		last = e
+	}
+	if a == nil {
+		a = b
+	}
+	if last == nil {
+		first = a
+	} else {
+		last.next = a
+	}
+	for ; a != nil; a = a.next {
+		last = a
+	}
+	return first, last
+}
@@ -0,0 +1,230 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package sortedlist implements a list that keeps its values in the order defined by a comparator.
+//
+// Values are held in an array list, so they can be looked up by value in O(log n) and by index in O(1).
+// Equal values are kept in the order they were added. Positional writes (Insert and Set) are only accepted if they
+// keep the list sorted, which is why the list does not implement the lists.List interface.
+//
+// Structure is not thread safe.
+//
+// Reference: https://en.wikipedia.org/wiki/List_%28abstract_data_type%29
+package sortedlist
+
+import (
+	"cmp"
+	"fmt"
+	"strings"
+
+	"github.com/emirpasic/gods/v2/containers"
+	"github.com/emirpasic/gods/v2/lists/arraylist"
+	"github.com/emirpasic/gods/v2/utils"
+)
+
+// Assert Container implementation
+var _ containers.Container[int] = (*List[int])(nil)
+var _ containers.FailFast = (*List[int])(nil)
+
+// List holds the elements sorted by the comparator in an array list
+type List[T comparable] struct {
+	list       *arraylist.List[T]
+	comparator utils.Comparator[T]
+}
+
+// New instantiates a new list sorted in the natural order of its values and adds the passed values, if any.
+func New[T cmp.Ordered](values ...T) *List[T] {
+	return NewWith[T](cmp.Compare[T], values...)
+}
+
+// NewWith instantiates a new list sorted by the custom comparator and adds the passed values, if any.
+func NewWith[T comparable](comparator utils.Comparator[T], values ...T) *List[T] {
+	list := &List[T]{list: arraylist.New[T](), comparator: comparator}
+	if len(values) > 0 {
+		list.Add(values...)
+	}
+	return list
+}
+
+// Add inserts values (one or more) at their position in the order, each one after all values equal to it.
+func (list *List[T]) Add(values ...T) {
+	for _, value := range values {
+		list.list.InsertSorted(value, list.comparator)
+	}
+}
+
+// Get returns the element at index.
+// Second return parameter is true if index is within bounds of the list and list is not empty, otherwise false.
+func (list *List[T]) Get(index int) (T, bool) {
+	return list.list.Get(index)
+}
+
+// Remove removes the element at the given index from the list.
+func (list *List[T]) Remove(index int) {
+	list.list.Remove(index)
+}
+
+// RemoveValue removes the first occurrence of the value from the list and returns true if it was found.
+func (list *List[T]) RemoveValue(value T) bool {
+	index := list.IndexOf(value)
+	if index < 0 {
+		return false
+	}
+	list.list.Remove(index)
+	return true
+}
+
+// Contains checks if values (one or more) are present in the list, using binary search.
+// All values have to be present in the list for the method to return true.
+// Returns true if no arguments are passed at all, i.e. set is always super-set of empty set.
+func (list *List[T]) Contains(values ...T) bool {
+	for _, value := range values {
+		if list.IndexOf(value) < 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// IndexOf returns the index of the first occurrence of the value, or -1 if it is not in the list.
+// The value is searched for in O(log n) among the values equal to it by the comparator.
+func (list *List[T]) IndexOf(value T) int {
+	for index := list.LowerBound(value); index < list.list.Size(); index++ {
+		element, _ := list.list.Get(index)
+		if list.comparator(element, value) != 0 {
+			break
+		}
+		if element == value {
+			return index
+		}
+	}
+	return -1
+}
+
+// BinarySearch searches the value in the list in O(log n).
+// Returns the index of the value and true if found, otherwise the index where it would be inserted and false.
+// If values equal to it by the comparator are present multiple times, the index of the first one is returned.
+func (list *List[T]) BinarySearch(value T) (index int, found bool) {
+	return list.list.BinarySearch(value, list.comparator)
+}
+
+// LowerBound returns the index of the first value that is not less than the given value, or Size() if there is none.
+func (list *List[T]) LowerBound(value T) int {
+	return list.list.LowerBound(value, list.comparator)
+}
+
+// UpperBound returns the index of the first value that is greater than the given value, or Size() if there is none.
+func (list *List[T]) UpperBound(value T) int {
+	return list.list.UpperBound(value, list.comparator)
+}
+
+// Insert inserts values at specified index position shifting the value at that position (if any) and any
+// subsequent elements to the right, and returns true.
+// Does not do anything and returns false if position is negative or bigger than list's size,
+// or if the values would not be in order at that position.
+// Note: position equal to list's size is valid, i.e. append.
+func (list *List[T]) Insert(index int, values ...T) bool {
+	if index < 0 || index > list.list.Size() {
+		return false
+	}
+	previous, hasPrevious := list.list.Get(index - 1)
+	for _, value := range values {
+		if hasPrevious && list.comparator(previous, value) > 0 {
+			return false
+		}
+		previous, hasPrevious = value, true
+	}
+	if next, hasNext := list.list.Get(index); hasNext && hasPrevious && list.comparator(previous, next) > 0 {
+		return false
+	}
+	list.list.Insert(index, values...)
+	return true
+}
+
+// Set replaces the value at specified index and returns true.
+// Does not do anything and returns false if position is negative or bigger than list's size,
+// or if the value would not be in order at that position.
+// Note: position equal to list's size is valid, i.e. append.
+func (list *List[T]) Set(index int, value T) bool {
+	if index == list.list.Size() {
+		return list.Insert(index, value)
+	}
+	if _, ok := list.list.Get(index); !ok {
+		return false
+	}
+	if previous, ok := list.list.Get(index - 1); ok && list.comparator(previous, value) > 0 {
+		return false
+	}
+	if next, ok := list.list.Get(index + 1); ok && list.comparator(value, next) > 0 {
+		return false
+	}
+	list.list.Set(index, value)
+	return true
+}
+
+// RemoveIf removes all elements for which the predicate returns true.
+// Remaining elements are compacted in a single pass, i.e. in O(n).
+func (list *List[T]) RemoveIf(predicate func(value T) bool) {
+	list.list.RemoveIf(predicate)
+}
+
+// RetainIf removes all elements for which the predicate returns false.
+// Remaining elements are compacted in a single pass, i.e. in O(n).
+func (list *List[T]) RetainIf(predicate func(value T) bool) {
+	list.list.RetainIf(predicate)
+}
+
+// RemoveRange removes the elements from index "from" (inclusive) to "to" (exclusive), shifting any subsequent
+// elements to the left.
+// Does not do anything if the range is not within the list.
+func (list *List[T]) RemoveRange(from, to int) {
+	list.list.RemoveRange(from, to)
+}
+
+// Comparator returns the comparator defining the order of the list.
+func (list *List[T]) Comparator() utils.Comparator[T] {
+	return list.comparator
+}
+
+// Empty returns true if list does not contain any elements.
+func (list *List[T]) Empty() bool {
+	return list.list.Empty()
+}
+
+// Size returns number of elements within the list.
+func (list *List[T]) Size() int {
+	return list.list.Size()
+}
+
+// Clear removes all elements from the list.
+func (list *List[T]) Clear() {
+	list.list.Clear()
+}
+
+// Values returns all elements in the list, in order.
+func (list *List[T]) Values() []T {
+	return list.list.Values()
+}
+
+// SetFailFast enables or disables the checks of iterators for modifications of the list done other than through
+// the iterator itself, see containers.ConcurrentModificationError. Checks are enabled by default.
+func (list *List[T]) SetFailFast(enabled bool) {
+	list.list.SetFailFast(enabled)
+}
+
+// String returns a string representation of container
+func (list *List[T]) String() string {
+	str := "SortedList\n"
+	values := make([]string, 0, list.list.Size())
+	for _, value := range list.list.Values() {
+		values = append(values, fmt.Sprintf("%v", value))
+	}
+	str += strings.Join(values, ", ")
+	return str
+}
+
+// newEmpty returns a new empty list with the same comparator.
+func (list *List[T]) newEmpty() *List[T] {
+	return NewWith[T](list.comparator)
+}
@@ -0,0 +1,261 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package sortedlist
+
+import (
+	"cmp"
+	"encoding/json"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/emirpasic/gods/v2/testutils"
+)
+
+type pair struct {
+	key, value int
+}
+
+func byKey(a, b pair) int {
+	return cmp.Compare(a.key, b.key)
+}
+
+func TestListNew(t *testing.T) {
+	list1 := New[int]()
+	if actualValue := list1.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+
+	list2 := New[int](3, 1, 2)
+	if actualValue, expectedValue := list2.Values(), []int{1, 2, 3}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, ok := list2.Get(3); actualValue != 0 || ok {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+
+	list3 := NewWith[int](func(a, b int) int { return cmp.Compare(b, a) }, 1, 3, 2)
+	if actualValue, expectedValue := list3.Values(), []int{3, 2, 1}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestListAdd(t *testing.T) {
+	list := NewWith[pair](byKey)
+	list.Add(pair{2, 0}, pair{1, 1}, pair{2, 2})
+	list.Add(pair{1, 3})
+	list.Add(pair{0, 4}, pair{3, 5})
+	expected := []pair{{0, 4}, {1, 1}, {1, 3}, {2, 0}, {2, 2}, {3, 5}}
+	if actualValue := list.Values(); !slices.Equal(actualValue, expected) {
+		t.Errorf("Got %v expected %v", actualValue, expected)
+	}
+	if actualValue := list.Size(); actualValue != 6 {
+		t.Errorf("Got %v expected %v", actualValue, 6)
+	}
+}
+
+func TestListSearch(t *testing.T) {
+	list := NewWith[pair](byKey, pair{1, 0}, pair{3, 1}, pair{3, 2}, pair{5, 3})
+	if index, found := list.BinarySearch(pair{3, 9}); index != 1 || !found {
+		t.Errorf("Got %v %v expected %v %v", index, found, 1, true)
+	}
+	if index, found := list.BinarySearch(pair{4, 0}); index != 3 || found {
+		t.Errorf("Got %v %v expected %v %v", index, found, 3, false)
+	}
+	if actualValue := list.LowerBound(pair{3, 0}); actualValue != 1 {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+	if actualValue := list.UpperBound(pair{3, 0}); actualValue != 3 {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+	if actualValue := list.IndexOf(pair{3, 2}); actualValue != 2 {
+		t.Errorf("Got %v expected %v", actualValue, 2)
+	}
+	if actualValue := list.IndexOf(pair{3, 9}); actualValue != -1 {
+		t.Errorf("Got %v expected %v", actualValue, -1)
+	}
+	if actualValue := list.Contains(pair{1, 0}, pair{5, 3}); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := list.Contains(pair{1, 0}, pair{5, 9}); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if actualValue := list.Contains(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+}
+
+func TestListRemove(t *testing.T) {
+	list := New[int](1, 2, 2, 3, 4, 5, 6)
+	list.Remove(0)
+	list.Remove(10)
+	if actualValue := list.RemoveValue(2); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := list.RemoveValue(7); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	list.RemoveRange(3, 4)
+	list.RemoveIf(func(value int) bool { return value == 3 })
+	if actualValue, expectedValue := list.Values(), []int{2, 4, 6}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	list.RetainIf(func(value int) bool { return value > 2 })
+	if actualValue, expectedValue := list.Values(), []int{4, 6}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	list.Clear()
+	if actualValue := list.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+}
+
+func TestListInsert(t *testing.T) {
+	list := New[int](1, 3, 5)
+	tests := []struct {
+		index  int
+		values []int
+		ok     bool
+	}{
+		{-1, []int{0}, false},
+		{4, []int{6}, false},
+		{1, []int{4}, false},
+		{1, []int{0}, false},
+		{1, []int{2, 2, 1}, false},
+		{1, []int{1, 2, 3}, true},
+		{0, []int{0}, true},
+		{7, []int{5, 6}, true},
+		{0, []int{}, true},
+	}
+	for _, test := range tests {
+		if actualValue := list.Insert(test.index, test.values...); actualValue != test.ok {
+			t.Errorf("Insert(%v, %v): got %v expected %v", test.index, test.values, actualValue, test.ok)
+		}
+	}
+	if actualValue, expectedValue := list.Values(), []int{0, 1, 1, 2, 3, 3, 5, 5, 6}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestListSet(t *testing.T) {
+	list := New[int](1, 3, 5)
+	tests := []struct {
+		index, value int
+		ok           bool
+	}{
+		{-1, 0, false},
+		{4, 6, false},
+		{1, 6, false},
+		{1, 0, false},
+		{0, 4, false},
+		{1, 4, true},
+		{0, 0, true},
+		{2, 4, true},
+		{3, 3, false},
+		{3, 7, true},
+	}
+	for _, test := range tests {
+		if actualValue := list.Set(test.index, test.value); actualValue != test.ok {
+			t.Errorf("Set(%v, %v): got %v expected %v", test.index, test.value, actualValue, test.ok)
+		}
+	}
+	if actualValue, expectedValue := list.Values(), []int{0, 4, 4, 7}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestListEnumerable(t *testing.T) {
+	list := New[int](3, 1, 2)
+	list.Each(func(index int, value int) {
+		if value != index+1 {
+			t.Errorf("Got %v expected %v", value, index+1)
+		}
+	})
+	mapped := list.Map(func(index int, value int) int { return -value })
+	if actualValue, expectedValue := mapped.Values(), []int{-3, -2, -1}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	selected := list.Select(func(index int, value int) bool { return value > 1 })
+	if actualValue, expectedValue := selected.Values(), []int{2, 3}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue := list.Any(func(index int, value int) bool { return value == 3 }); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := list.All(func(index int, value int) bool { return value > 1 }); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if index, value := list.Find(func(index int, value int) bool { return value > 1 }); index != 1 || value != 2 {
+		t.Errorf("Got %v %v expected %v %v", index, value, 1, 2)
+	}
+}
+
+func TestListIterator(t *testing.T) {
+	list := New[int](4, 2, 3, 1)
+	it := list.Iterator()
+	count := 0
+	for it.Next() {
+		count++
+		if actualValue, expectedValue := it.Value(), count; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		if it.Value()%2 == 0 {
+			it.Remove()
+		}
+	}
+	if actualValue, expectedValue := list.Values(), []int{1, 3}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue := it.Last(); actualValue != true || it.Index() != 1 || it.Value() != 3 {
+		t.Errorf("Got %v %v %v expected %v %v %v", actualValue, it.Index(), it.Value(), true, 1, 3)
+	}
+	if actualValue := it.PrevTo(func(index int, value int) bool { return value == 1 }); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+
+	it.Begin()
+	it.Next()
+	list.Add(2)
+	testutils.ConcurrentModificationPanic(t, func() { it.Next() })
+	list.SetFailFast(false)
+	if actualValue := it.Next(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+}
+
+func TestListSerialization(t *testing.T) {
+	list := New[string]("c", "a", "b")
+
+	var err error
+	assert := func() {
+		if actualValue, expectedValue := list.Values(), []string{"a", "b", "c"}; !slices.Equal(actualValue, expectedValue) {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		if err != nil {
+			t.Errorf("Got error %v", err)
+		}
+	}
+
+	assert()
+
+	bytes, err := list.ToJSON()
+	assert()
+
+	err = list.FromJSON(bytes)
+	assert()
+
+	_, err = json.Marshal([]any{"a", "b", "c", list})
+	assert()
+
+	err = json.Unmarshal([]byte(`["b","c","a"]`), list)
+	assert()
+}
+
+func TestListString(t *testing.T) {
+	c := New[int](1)
+	if !strings.HasPrefix(c.String(), "SortedList") {
+		t.Errorf("String should start with container name")
+	}
+}
@@ -0,0 +1,286 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lists
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/emirpasic/gods/v2/containers"
+	"github.com/emirpasic/gods/v2/utils"
+)
+
+// Assert List implementation
+var _ List[int] = (*View[int])(nil)
+
+// Backing is the list a View operates on. Lists implement it (usually through an unexported adapter)
+// to provide live views of their ranges, see NewView.
+//
+// Indices and ranges passed to a backing are always within the list. Every structural modification of the list,
+// i.e. one that adds, removes or moves elements, has to change the value returned by ModCount.
+type Backing[T comparable] interface {
+	// Name returns the name of the list's container, e.g. "ArrayList".
+	Name() string
+	// ModCount returns the number of structural modifications of the list.
+	ModCount() int
+	// FailFast returns true if views panic once the list was structurally modified other than through them.
+	FailFast() bool
+	// Size returns the number of elements of the list.
+	Size() int
+	// Get returns the element at index.
+	Get(index int) T
+	// Set replaces the element at index.
+	Set(index int, value T)
+	// Swap swaps the elements at the two indices.
+	Swap(i, j int)
+	// Insert inserts the values at index, shifting the element at that position (if any) to the right.
+	Insert(index int, values ...T)
+	// Values returns the elements from index "from" (inclusive) to "to" (exclusive).
+	Values(from, to int) []T
+	// RemoveRange removes the elements from index "from" (inclusive) to "to" (exclusive).
+	RemoveRange(from, to int)
+	// RemoveIf removes the elements within the range for which the predicate returns true and returns their number.
+	RemoveIf(from, to int, predicate func(value T) bool) int
+	// Sort sorts the elements within the range using a Comparator.
+	Sort(from, to int, comparator utils.Comparator[T])
+	// Reverse reverses the order of the elements within the range.
+	Reverse(from, to int)
+	// Rotate rotates the elements within the range by the given distance, see List.Rotate.
+	Rotate(from, to, distance int)
+}
+
+// ConcurrentBacking is a Backing of a list that is safe for concurrent use, so that other goroutines may modify the
+// list between the check of a view and its operation. Views of such a list operate through the backing returned by
+// Expect.
+type ConcurrentBacking[T comparable] interface {
+	Backing[T]
+	// Expect returns a backing that checks atomically with every operation that the modification count of the list
+	// is the one pointed to, panicking with a containers.ConcurrentModificationError otherwise, and updates the count
+	// pointed to after every structural modification.
+	Expect(modCount *int) Backing[T]
+}
+
+// View is a live view of a range of a list, see List.SubList.
+//
+// The view holds no elements of its own, all operations go through to the backing list at the view's offset,
+// i.e. writes to the list within the range are visible through the view and vice versa. Adding or removing elements
+// through the view grows or shrinks the view (and the views it was created from).
+//
+// The range of a view is tracked by index, so structurally modifying the list other than through the view
+// invalidates it: using the view afterwards panics with a containers.ConcurrentModificationError,
+// unless fail-fast checks are disabled for the list.
+type View[T comparable] struct {
+	backing  Backing[T]
+	parent   *View[T] // view this view was created from, nil if created from the list
+	offset   int      // index of the view's first element within the list
+	size     int
+	modCount int // list's modification count the view is in sync with
+}
+
+// NewView returns a live view of the elements of the backing list from index "from" (inclusive) to "to" (exclusive).
+// Panics if the range is not within the list, i.e. unless 0 <= from <= to <= Size().
+func NewView[T comparable](backing Backing[T], from, to int) *View[T] {
+	CheckRange(from, to, backing.Size())
+	return &View[T]{backing: backing, offset: from, size: to - from, modCount: backing.ModCount()}
+}
+
+// Get returns the element at index within the view.
+// Second return parameter is true if index is within bounds of the view, otherwise false.
+func (view *View[T]) Get(index int) (T, bool) {
+	list := view.list()
+	if !view.withinRange(index) {
+		var t T
+		return t, false
+	}
+	return list.Get(view.offset + index), true
+}
+
+// Remove removes the element at the given index within the view from the list.
+func (view *View[T]) Remove(index int) {
+	view.RemoveRange(index, index+1)
+}
+
+// Add inserts values (one or more) into the list at the end of the view.
+func (view *View[T]) Add(values ...T) {
+	view.Insert(view.size, values...)
+}
+
+// Contains checks if values (one or more) are present in the view.
+// All values have to be present in the view for the method to return true.
+// Returns true if no arguments are passed at all, i.e. set is always super-set of empty set.
+func (view *View[T]) Contains(values ...T) bool {
+	elements := view.Values()
+	for _, value := range values {
+		if !slices.Contains(elements, value) {
+			return false
+		}
+	}
+	return true
+}
+
+// Sort sorts the values within the view (in-place) using a Comparator.
+func (view *View[T]) Sort(comparator utils.Comparator[T]) {
+	view.list().Sort(view.offset, view.offset+view.size, comparator)
+	view.resize(0)
+}
+
+// Swap swaps the two values at the specified positions within the view.
+func (view *View[T]) Swap(i, j int) {
+	list := view.list()
+	if view.withinRange(i) && view.withinRange(j) && i != j {
+		list.Swap(view.offset+i, view.offset+j)
+	}
+}
+
+// Insert inserts values at specified index position within the view, shifting the value at that position (if any)
+// and any subsequent elements to the right.
+// Does not do anything if position is negative or bigger than view's size
+// Note: position equal to view's size is valid, i.e. append to the view.
+func (view *View[T]) Insert(index int, values ...T) {
+	list := view.list()
+	if index < 0 || index > view.size || len(values) == 0 {
+		return
+	}
+	list.Insert(view.offset+index, values...)
+	view.resize(len(values))
+}
+
+// Set the value at specified index within the view
+// Does not do anything if position is negative or bigger than view's size
+// Note: position equal to view's size is valid, i.e. append to the view.
+func (view *View[T]) Set(index int, value T) {
+	list := view.list()
+	if !view.withinRange(index) {
+		if index == view.size {
+			view.Add(value)
+		}
+		return
+	}
+	list.Set(view.offset+index, value)
+}
+
+// RemoveIf removes all elements within the view for which the predicate returns true.
+func (view *View[T]) RemoveIf(predicate func(value T) bool) {
+	if removed := view.list().RemoveIf(view.offset, view.offset+view.size, predicate); removed > 0 {
+		view.resize(-removed)
+	}
+}
+
+// RetainIf removes all elements within the view for which the predicate returns false.
+func (view *View[T]) RetainIf(predicate func(value T) bool) {
+	view.RemoveIf(func(value T) bool { return !predicate(value) })
+}
+
+// SubList returns a live view of the elements of this view from index "from" (inclusive) to "to" (exclusive).
+// Panics if the range is not within the view, i.e. unless 0 <= from <= to <= Size().
+func (view *View[T]) SubList(from, to int) List[T] {
+	view.checkModification()
+	CheckRange(from, to, view.size)
+	return &View[T]{backing: view.backing, parent: view, offset: view.offset + from, size: to - from, modCount: view.modCount}
+}
+
+// RemoveRange removes the elements of the view from index "from" (inclusive) to "to" (exclusive) from the list.
+// Does not do anything if the range is not within the view.
+func (view *View[T]) RemoveRange(from, to int) {
+	list := view.list()
+	if from < 0 || to > view.size || from >= to {
+		return
+	}
+	list.RemoveRange(view.offset+from, view.offset+to)
+	view.resize(from - to)
+}
+
+// Reverse reverses the order of the elements within the view.
+func (view *View[T]) Reverse() {
+	view.list().Reverse(view.offset, view.offset+view.size)
+	view.resize(0)
+}
+
+// Rotate rotates the elements within the view by the given distance, see List.Rotate.
+func (view *View[T]) Rotate(distance int) {
+	view.list().Rotate(view.offset, view.offset+view.size, distance)
+	view.resize(0)
+}
+
+// AddAll inserts all values of another list into the list at the end of the view.
+func (view *View[T]) AddAll(another List[T]) {
+	view.Add(another.Values()...)
+}
+
+// Empty returns true if view does not contain any elements.
+func (view *View[T]) Empty() bool {
+	return view.Size() == 0
+}
+
+// Size returns number of elements within the view.
+func (view *View[T]) Size() int {
+	view.checkModification()
+	return view.size
+}
+
+// Clear removes all elements within the view from the list.
+func (view *View[T]) Clear() {
+	view.RemoveRange(0, view.size)
+}
+
+// Values returns all elements within the view.
+func (view *View[T]) Values() []T {
+	return view.list().Values(view.offset, view.offset+view.size)
+}
+
+// String returns a string representation of container
+func (view *View[T]) String() string {
+	str := view.backing.Name() + "View\n"
+	values := []string{}
+	for _, value := range view.Values() {
+		values = append(values, fmt.Sprintf("%v", value))
+	}
+	str += strings.Join(values, ", ")
+	return str
+}
+
+// withinRange checks that the index is within bounds of the view
+func (view *View[T]) withinRange(index int) bool {
+	return index >= 0 && index < view.size
+}
+
+// checkModification panics if the list was structurally modified other than through the view,
+// unless fail-fast checks are disabled for the list.
+func (view *View[T]) checkModification() {
+	if view.modCount != view.backing.ModCount() && view.backing.FailFast() {
+		panic(&containers.ConcurrentModificationError{Container: view.backing.Name()})
+	}
+}
+
+// list checks for modifications and returns the backing to operate on, which checks again with the operation if
+// the list is safe for concurrent use.
+func (view *View[T]) list() Backing[T] {
+	view.checkModification()
+	if backing, ok := view.backing.(ConcurrentBacking[T]); ok {
+		return backing.Expect(&view.modCount)
+	}
+	return view.backing
+}
+
+// resize adjusts the size of the view and the views it was created from by delta after a modification through it,
+// and brings them back in sync with the list.
+func (view *View[T]) resize(delta int) {
+	modCount := view.modCount // kept up to date by the backing of a concurrent list
+	if _, ok := view.backing.(ConcurrentBacking[T]); !ok {
+		modCount = view.backing.ModCount()
+	}
+	for v := view; v != nil; v = v.parent {
+		v.size += delta
+		v.modCount = modCount
+	}
+}
+
+// CheckRange panics unless 0 <= from <= to <= size, i.e. unless the range is within a list of the given size.
+func CheckRange(from, to, size int) {
+	if from < 0 || to > size || from > to {
+		panic(fmt.Sprintf("Invalid range [%d, %d) for list of size %d", from, to, size))
+	}
+}
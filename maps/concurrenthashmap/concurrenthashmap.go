@@ -0,0 +1,276 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package concurrenthashmap implements a map that is safe for concurrent use, backed by lock-striped hash tables.
+//
+// Keys are spread over a number of shards by their hash, each shard a native map guarded by its own read-write
+// mutex, so that goroutines working on keys of different shards do not contend. PutIfAbsent, Compute,
+// ComputeIfPresent and Merge update a key atomically.
+//
+// Range, the iterator, Keys and Values are weakly consistent: they visit the shards one after the other, each as it
+// was when it was visited, and never fail because of concurrent modifications.
+//
+// Elements are unordered in the map.
+//
+// Structure is thread safe.
+//
+// Reference: https://en.wikipedia.org/wiki/Hash_table
+package concurrenthashmap
+
+import (
+	"fmt"
+	"math/bits"
+	"runtime"
+	"sync"
+	"sync/atomic"
+
+	"github.com/emirpasic/gods/v2/maps"
+)
+
+// Assert Map implementation
+var _ maps.Map[string, int] = (*Map[string, int])(nil)
+
+// cacheLine is the assumed size of a cache line, by which the shards are padded to avoid false sharing.
+const cacheLine = 64
+
+// Map holds the elements in shards of go's native maps
+type Map[K comparable, V any] struct {
+	shards []shard[K, V]
+	mask   uint64
+	hasher Hasher[K]
+	size   atomic.Int64
+}
+
+type shard[K comparable, V any] struct {
+	mutex sync.RWMutex
+	m     map[K]V
+	_     [cacheLine]byte
+}
+
+// entry is a key-value pair of a shard snapshot.
+type entry[K comparable, V any] struct {
+	key   K
+	value V
+}
+
+// New instantiates a concurrent hash map with a number of shards suited to the number of processors.
+func New[K comparable, V any]() *Map[K, V] {
+	return NewWith[K, V](4*runtime.GOMAXPROCS(0), nil)
+}
+
+// NewWith instantiates a concurrent hash map with the number of shards, rounded up to a power of two, and the
+// hasher for the keys, or the hasher of NewHasher if nil. Panics if the number of shards is not positive.
+func NewWith[K comparable, V any](shards int, hasher Hasher[K]) *Map[K, V] {
+	if shards < 1 {
+		panic(fmt.Sprintf("concurrenthashmap: number of shards %v is not positive", shards))
+	}
+	if hasher == nil {
+		hasher = NewHasher[K]()
+	}
+	shards = 1 << bits.Len(uint(shards-1))
+	m := &Map[K, V]{shards: make([]shard[K, V], shards), mask: uint64(shards - 1), hasher: hasher}
+	for i := range m.shards {
+		m.shards[i].m = make(map[K]V)
+	}
+	return m
+}
+
+// Put inserts element into the map.
+func (m *Map[K, V]) Put(key K, value V) {
+	shard := m.shard(key)
+	shard.mutex.Lock()
+	defer shard.mutex.Unlock()
+	m.put(shard, key, value)
+}
+
+// Get searches the element in the map by key and returns its value or nil if key is not found in map.
+// Second return parameter is true if key was found, otherwise false.
+func (m *Map[K, V]) Get(key K) (value V, found bool) {
+	shard := m.shard(key)
+	shard.mutex.RLock()
+	defer shard.mutex.RUnlock()
+	value, found = shard.m[key]
+	return
+}
+
+// Remove removes the element from the map by key.
+func (m *Map[K, V]) Remove(key K) {
+	shard := m.shard(key)
+	shard.mutex.Lock()
+	defer shard.mutex.Unlock()
+	m.remove(shard, key)
+}
+
+// PutIfAbsent inserts the element into the map unless the key is present already.
+// Returns the value of the key in the map after the call, and true if it was present already (and was not replaced).
+func (m *Map[K, V]) PutIfAbsent(key K, value V) (actual V, loaded bool) {
+	shard := m.shard(key)
+	shard.mutex.Lock()
+	defer shard.mutex.Unlock()
+	if actual, loaded = shard.m[key]; loaded {
+		return actual, true
+	}
+	m.put(shard, key, value)
+	return value, false
+}
+
+// Compute atomically replaces the element of the key by the value returned by the function, which is called with the
+// current value of the key and whether the key is present. The key is removed if the function returns false.
+// Returns the value of the key after the call and whether it is present.
+// The function is called while the shard of the key is locked and must not use the map.
+func (m *Map[K, V]) Compute(key K, f func(value V, found bool) (newValue V, keep bool)) (value V, ok bool) {
+	shard := m.shard(key)
+	shard.mutex.Lock()
+	defer shard.mutex.Unlock()
+	value, found := shard.m[key]
+	newValue, keep := f(value, found)
+	return m.update(shard, key, newValue, keep)
+}
+
+// ComputeIfPresent atomically replaces the element of the key by the value returned by the function if the key is
+// present, see Compute. The function is not called if the key is not present.
+// Returns the value of the key after the call and whether it is present.
+func (m *Map[K, V]) ComputeIfPresent(key K, f func(value V) (newValue V, keep bool)) (value V, ok bool) {
+	shard := m.shard(key)
+	shard.mutex.Lock()
+	defer shard.mutex.Unlock()
+	value, found := shard.m[key]
+	if !found {
+		return value, false
+	}
+	newValue, keep := f(value)
+	return m.update(shard, key, newValue, keep)
+}
+
+// Merge atomically inserts the value if the key is not present, or otherwise replaces the element of the key by the
+// value returned by the function, which is called with the current value and the given value, see Compute.
+// Returns the value of the key after the call and whether it is present.
+func (m *Map[K, V]) Merge(key K, value V, f func(oldValue, value V) (newValue V, keep bool)) (V, bool) {
+	shard := m.shard(key)
+	shard.mutex.Lock()
+	defer shard.mutex.Unlock()
+	oldValue, found := shard.m[key]
+	if !found {
+		m.put(shard, key, value)
+		return value, true
+	}
+	newValue, keep := f(oldValue, value)
+	return m.update(shard, key, newValue, keep)
+}
+
+// Range calls the function for every element of the map until it returns false.
+// Range is weakly consistent, see the package documentation. The function may use the map.
+func (m *Map[K, V]) Range(f func(key K, value V) bool) {
+	for i := range m.shards {
+		for _, e := range m.snapshot(i) {
+			if !f(e.key, e.value) {
+				return
+			}
+		}
+	}
+}
+
+// Empty returns true if map does not contain any elements
+func (m *Map[K, V]) Empty() bool {
+	return m.Size() == 0
+}
+
+// Size returns number of elements in the map.
+func (m *Map[K, V]) Size() int {
+	return int(m.size.Load())
+}
+
+// Keys returns all keys (random order).
+func (m *Map[K, V]) Keys() []K {
+	keys := make([]K, 0, m.Size())
+	m.Range(func(key K, value V) bool {
+		keys = append(keys, key)
+		return true
+	})
+	return keys
+}
+
+// Values returns all values (random order).
+func (m *Map[K, V]) Values() []V {
+	values := make([]V, 0, m.Size())
+	m.Range(func(key K, value V) bool {
+		values = append(values, value)
+		return true
+	})
+	return values
+}
+
+// Clear removes all elements from the map.
+func (m *Map[K, V]) Clear() {
+	for i := range m.shards {
+		shard := &m.shards[i]
+		shard.mutex.Lock()
+		m.size.Add(-int64(len(shard.m)))
+		clear(shard.m)
+		shard.mutex.Unlock()
+	}
+}
+
+// String returns a string representation of container
+func (m *Map[K, V]) String() string {
+	str := "ConcurrentHashMap\n"
+	str += fmt.Sprintf("%v", m.native())
+	return str
+}
+
+// shard returns the shard of the key.
+func (m *Map[K, V]) shard(key K) *shard[K, V] {
+	return &m.shards[m.hasher(key)&m.mask]
+}
+
+// put inserts the element into the locked shard.
+func (m *Map[K, V]) put(shard *shard[K, V], key K, value V) {
+	size := len(shard.m)
+	shard.m[key] = value
+	if len(shard.m) > size {
+		m.size.Add(1)
+	}
+}
+
+// remove removes the element from the locked shard.
+func (m *Map[K, V]) remove(shard *shard[K, V], key K) {
+	size := len(shard.m)
+	delete(shard.m, key)
+	if len(shard.m) < size {
+		m.size.Add(-1)
+	}
+}
+
+// update puts the value into the locked shard if keep is true, or removes the key otherwise.
+func (m *Map[K, V]) update(shard *shard[K, V], key K, value V, keep bool) (V, bool) {
+	if !keep {
+		m.remove(shard, key)
+		var zero V
+		return zero, false
+	}
+	m.put(shard, key, value)
+	return value, true
+}
+
+// snapshot returns the elements of the shard at the index.
+func (m *Map[K, V]) snapshot(index int) []entry[K, V] {
+	shard := &m.shards[index]
+	shard.mutex.RLock()
+	defer shard.mutex.RUnlock()
+	entries := make([]entry[K, V], 0, len(shard.m))
+	for key, value := range shard.m {
+		entries = append(entries, entry[K, V]{key, value})
+	}
+	return entries
+}
+
+// native returns the elements of the map in go's native map.
+func (m *Map[K, V]) native() map[K]V {
+	elements := make(map[K]V, m.Size())
+	m.Range(func(key K, value V) bool {
+		elements[key] = value
+		return true
+	})
+	return elements
+}
@@ -0,0 +1,539 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package concurrenthashmap
+
+import (
+	"encoding/json"
+	"math"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/emirpasic/gods/v2/testutils"
+)
+
+func TestMapPut(t *testing.T) {
+	m := New[int, string]()
+	m.Put(5, "e")
+	m.Put(6, "f")
+	m.Put(7, "g")
+	m.Put(3, "c")
+	m.Put(4, "d")
+	m.Put(1, "x")
+	m.Put(2, "b")
+	m.Put(1, "a") //overwrite
+
+	if actualValue := m.Size(); actualValue != 7 {
+		t.Errorf("Got %v expected %v", actualValue, 7)
+	}
+	testutils.SameElements(t, m.Keys(), []int{1, 2, 3, 4, 5, 6, 7})
+	testutils.SameElements(t, m.Values(), []string{"a", "b", "c", "d", "e", "f", "g"})
+
+	tests := []struct {
+		key   int
+		value string
+		found bool
+	}{
+		{1, "a", true},
+		{2, "b", true},
+		{7, "g", true},
+		{8, "", false},
+	}
+	for _, test := range tests {
+		if actualValue, found := m.Get(test.key); actualValue != test.value || found != test.found {
+			t.Errorf("Got %v expected %v", actualValue, test.value)
+		}
+	}
+}
+
+func TestMapRemove(t *testing.T) {
+	m := New[int, string]()
+	m.Put(5, "e")
+	m.Put(6, "f")
+	m.Put(7, "g")
+	m.Put(3, "c")
+
+	m.Remove(5)
+	m.Remove(6)
+	m.Remove(8)
+	m.Remove(5)
+
+	testutils.SameElements(t, m.Keys(), []int{3, 7})
+	if actualValue := m.Size(); actualValue != 2 {
+		t.Errorf("Got %v expected %v", actualValue, 2)
+	}
+	if actualValue, found := m.Get(5); actualValue != "" || found {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+
+	m.Clear()
+	if actualValue := m.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := m.Keys(); len(actualValue) != 0 {
+		t.Errorf("Got %v expected %v", actualValue, "[]")
+	}
+}
+
+func TestMapPutIfAbsent(t *testing.T) {
+	m := New[string, int]()
+
+	if actualValue, loaded := m.PutIfAbsent("a", 1); actualValue != 1 || loaded {
+		t.Errorf("Got %v %v expected %v %v", actualValue, loaded, 1, false)
+	}
+	if actualValue, loaded := m.PutIfAbsent("a", 2); actualValue != 1 || !loaded {
+		t.Errorf("Got %v %v expected %v %v", actualValue, loaded, 1, true)
+	}
+	if actualValue, _ := m.Get("a"); actualValue != 1 {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+	if actualValue := m.Size(); actualValue != 1 {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+}
+
+func TestMapCompute(t *testing.T) {
+	m := New[string, int]()
+	increment := func(value int, found bool) (int, bool) {
+		return value + 1, true
+	}
+
+	if actualValue, ok := m.Compute("a", increment); actualValue != 1 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+	if actualValue, ok := m.Compute("a", increment); actualValue != 2 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 2)
+	}
+	if actualValue, ok := m.Compute("a", func(value int, found bool) (int, bool) { return 0, false }); actualValue != 0 || ok {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+	if actualValue := m.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+
+	// removing an absent key
+	m.Compute("b", func(value int, found bool) (int, bool) {
+		if found {
+			t.Errorf("Got %v expected %v", found, false)
+		}
+		return 0, false
+	})
+	if actualValue := m.Size(); actualValue != 0 {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+}
+
+func TestMapComputeIfPresent(t *testing.T) {
+	m := New[string, int]()
+	double := func(value int) (int, bool) { return 2 * value, true }
+
+	if actualValue, ok := m.ComputeIfPresent("a", double); actualValue != 0 || ok {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+	if actualValue := m.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+
+	m.Put("a", 3)
+	if actualValue, ok := m.ComputeIfPresent("a", double); actualValue != 6 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 6)
+	}
+	if actualValue, ok := m.ComputeIfPresent("a", func(value int) (int, bool) { return value, false }); actualValue != 0 || ok {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+	if actualValue, found := m.Get("a"); found {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+}
+
+func TestMapMerge(t *testing.T) {
+	m := New[string, string]()
+	concat := func(oldValue, value string) (string, bool) { return oldValue + value, true }
+
+	if actualValue, ok := m.Merge("a", "x", concat); actualValue != "x" || !ok {
+		t.Errorf("Got %v expected %v", actualValue, "x")
+	}
+	if actualValue, ok := m.Merge("a", "y", concat); actualValue != "xy" || !ok {
+		t.Errorf("Got %v expected %v", actualValue, "xy")
+	}
+	if actualValue, ok := m.Merge("a", "z", func(oldValue, value string) (string, bool) { return "", false }); actualValue != "" || ok {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+	if actualValue := m.Size(); actualValue != 0 {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+}
+
+func TestMapRange(t *testing.T) {
+	m := New[int, int]()
+	for i := 0; i < 100; i++ {
+		m.Put(i, i*i)
+	}
+
+	count := 0
+	m.Range(func(key int, value int) bool {
+		if value != key*key {
+			t.Errorf("Got %v expected %v", value, key*key)
+		}
+		// the map can be modified while ranging
+		m.Remove(key)
+		count++
+		return true
+	})
+	if actualValue := count; actualValue != 100 {
+		t.Errorf("Got %v expected %v", actualValue, 100)
+	}
+	if actualValue := m.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+
+	m.Put(1, 1)
+	m.Put(2, 4)
+	count = 0
+	m.Range(func(key int, value int) bool {
+		count++
+		return false
+	})
+	if actualValue := count; actualValue != 1 {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+}
+
+func TestMapIterator(t *testing.T) {
+	m := NewWith[string, int](4, nil)
+	it := m.Iterator()
+	for it.Next() {
+		t.Errorf("Shouldn't iterate on empty map")
+	}
+
+	m.Put("a", 1)
+	m.Put("b", 2)
+	m.Put("c", 3)
+	keys := []string{}
+	for it.Begin(); it.Next(); {
+		if actualValue, expectedValue := it.Value(), int(it.Key()[0]-'a'+1); actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		keys = append(keys, it.Key())
+	}
+	testutils.SameElements(t, keys, []string{"a", "b", "c"})
+	if actualValue := it.Next(); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+
+	if actualValue := it.First(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := it.NextTo(func(key string, value int) bool { return key == "z" }); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+
+	// the map can be modified while iterating
+	count := 0
+	for it.Begin(); it.Next(); {
+		m.Remove(it.Key())
+		count++
+	}
+	if actualValue := count; actualValue != 3 {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+}
+
+func TestMapConcurrent(t *testing.T) {
+	const goroutines, count = 8, 1000
+	m := New[int, int]()
+
+	var wg sync.WaitGroup
+	for g := 0; g < goroutines; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < count; i++ {
+				m.Merge(i%100, 1, func(oldValue, value int) (int, bool) { return oldValue + value, true })
+				m.PutIfAbsent(g*count+i+1000, i)
+				m.Get(i)
+				if i%10 == 0 {
+					m.Range(func(key int, value int) bool { return key < 50 })
+				}
+			}
+		}(g)
+	}
+	wg.Wait()
+
+	for i := 0; i < 100; i++ {
+		if actualValue, _ := m.Get(i); actualValue != goroutines*count/100 {
+			t.Fatalf("Got %v expected %v", actualValue, goroutines*count/100)
+		}
+	}
+	if actualValue, expectedValue := m.Size(), 100+goroutines*count; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := len(m.Keys()), 100+goroutines*count; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMapHasher(t *testing.T) {
+	type point struct {
+		x, y float64
+		_    int
+		name string
+	}
+	type key struct {
+		point point
+		id    [2]int8
+		ptr   *int
+		any   interface{}
+	}
+	i := 1
+
+	m := New[key, int]()
+	m.Put(key{point: point{x: 0, y: 1}, id: [2]int8{1, 2}, ptr: &i, any: "a"}, 1)
+	m.Put(key{point: point{x: math.Copysign(0, -1), y: 1}, id: [2]int8{1, 2}, ptr: &i, any: "a"}, 2) // -0 == 0
+	m.Put(key{any: 1}, 3)
+	m.Put(key{any: int8(1)}, 4)
+	m.Put(key{}, 5)
+
+	if actualValue := m.Size(); actualValue != 4 {
+		t.Errorf("Got %v expected %v", actualValue, 4)
+	}
+	if actualValue, found := m.Get(key{point: point{y: 1}, id: [2]int8{1, 2}, ptr: &i, any: "a"}); actualValue != 2 || !found {
+		t.Errorf("Got %v expected %v", actualValue, 2)
+	}
+	if actualValue, found := m.Get(key{any: 1}); actualValue != 3 || !found {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+
+	floats := New[float64, int]()
+	floats.Put(0, 1)
+	floats.Put(math.Copysign(0, -1), 2)
+	if actualValue := floats.Size(); actualValue != 1 {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+
+	type id uint16
+	ids := NewWith[id, string](1, nil)
+	ids.Put(1, "a")
+	if actualValue, found := ids.Get(1); actualValue != "a" || !found {
+		t.Errorf("Got %v expected %v", actualValue, "a")
+	}
+
+	// custom hasher
+	custom := NewWith[string, int](8, func(key string) uint64 { return uint64(len(key)) })
+	custom.Put("a", 1)
+	custom.Put("bb", 2)
+	if actualValue, found := custom.Get("bb"); actualValue != 2 || !found {
+		t.Errorf("Got %v expected %v", actualValue, 2)
+	}
+	if actualValue := len(custom.shards); actualValue != 8 {
+		t.Errorf("Got %v expected %v", actualValue, 8)
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("Got no panic expected a panic for an invalid number of shards")
+		}
+	}()
+	NewWith[string, int](0, nil)
+}
+
+func TestMapSerialization(t *testing.T) {
+	m := New[string, float64]()
+	m.Put("a", 1.0)
+	m.Put("b", 2.0)
+	m.Put("c", 3.0)
+
+	var err error
+	assert := func() {
+		testutils.SameElements(t, m.Keys(), []string{"a", "b", "c"})
+		testutils.SameElements(t, m.Values(), []float64{1.0, 2.0, 3.0})
+		if actualValue, expectedValue := m.Size(), 3; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		if err != nil {
+			t.Errorf("Got error %v", err)
+		}
+	}
+
+	assert()
+
+	bytes, err := m.ToJSON()
+	assert()
+
+	err = m.FromJSON(bytes)
+	assert()
+
+	bytes, err = json.Marshal([]interface{}{"a", "b", "c", m})
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+
+	err = json.Unmarshal([]byte(`{"a":1,"b":2}`), &m)
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	assert()
+}
+
+func TestMapString(t *testing.T) {
+	c := New[string, int]()
+	c.Put("a", 1)
+	if !strings.HasPrefix(c.String(), "ConcurrentHashMap") {
+		t.Errorf("String should start with container name")
+	}
+}
+
+func benchmarkGet(b *testing.B, m *Map[int, int], size int) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			m.Get(n)
+		}
+	}
+}
+
+func benchmarkPut(b *testing.B, m *Map[int, int], size int) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			m.Put(n, n)
+		}
+	}
+}
+
+func benchmarkRemove(b *testing.B, m *Map[int, int], size int) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			m.Remove(n)
+		}
+	}
+}
+
+func BenchmarkConcurrentHashMapGet100(b *testing.B) {
+	b.StopTimer()
+	size := 100
+	m := New[int, int]()
+	for n := 0; n < size; n++ {
+		m.Put(n, n)
+	}
+	b.StartTimer()
+	benchmarkGet(b, m, size)
+}
+
+func BenchmarkConcurrentHashMapGet1000(b *testing.B) {
+	b.StopTimer()
+	size := 1000
+	m := New[int, int]()
+	for n := 0; n < size; n++ {
+		m.Put(n, n)
+	}
+	b.StartTimer()
+	benchmarkGet(b, m, size)
+}
+
+func BenchmarkConcurrentHashMapGet10000(b *testing.B) {
+	b.StopTimer()
+	size := 10000
+	m := New[int, int]()
+	for n := 0; n < size; n++ {
+		m.Put(n, n)
+	}
+	b.StartTimer()
+	benchmarkGet(b, m, size)
+}
+
+func BenchmarkConcurrentHashMapGet100000(b *testing.B) {
+	b.StopTimer()
+	size := 100000
+	m := New[int, int]()
+	for n := 0; n < size; n++ {
+		m.Put(n, n)
+	}
+	b.StartTimer()
+	benchmarkGet(b, m, size)
+}
+
+func BenchmarkConcurrentHashMapPut100(b *testing.B) {
+	b.StopTimer()
+	size := 100
+	m := New[int, int]()
+	b.StartTimer()
+	benchmarkPut(b, m, size)
+}
+
+func BenchmarkConcurrentHashMapPut1000(b *testing.B) {
+	b.StopTimer()
+	size := 1000
+	m := New[int, int]()
+	for n := 0; n < size; n++ {
+		m.Put(n, n)
+	}
+	b.StartTimer()
+	benchmarkPut(b, m, size)
+}
+
+func BenchmarkConcurrentHashMapPut10000(b *testing.B) {
+	b.StopTimer()
+	size := 10000
+	m := New[int, int]()
+	for n := 0; n < size; n++ {
+		m.Put(n, n)
+	}
+	b.StartTimer()
+	benchmarkPut(b, m, size)
+}
+
+func BenchmarkConcurrentHashMapPut100000(b *testing.B) {
+	b.StopTimer()
+	size := 100000
+	m := New[int, int]()
+	for n := 0; n < size; n++ {
+		m.Put(n, n)
+	}
+	b.StartTimer()
+	benchmarkPut(b, m, size)
+}
+
+func BenchmarkConcurrentHashMapRemove100(b *testing.B) {
+	b.StopTimer()
+	size := 100
+	m := New[int, int]()
+	for n := 0; n < size; n++ {
+		m.Put(n, n)
+	}
+	b.StartTimer()
+	benchmarkRemove(b, m, size)
+}
+
+func BenchmarkConcurrentHashMapRemove1000(b *testing.B) {
+	b.StopTimer()
+	size := 1000
+	m := New[int, int]()
+	for n := 0; n < size; n++ {
+		m.Put(n, n)
+	}
+	b.StartTimer()
+	benchmarkRemove(b, m, size)
+}
+
+func BenchmarkConcurrentHashMapRemove10000(b *testing.B) {
+	b.StopTimer()
+	size := 10000
+	m := New[int, int]()
+	for n := 0; n < size; n++ {
+		m.Put(n, n)
+	}
+	b.StartTimer()
+	benchmarkRemove(b, m, size)
+}
+
+func BenchmarkConcurrentHashMapRemove100000(b *testing.B) {
+	b.StopTimer()
+	size := 100000
+	m := New[int, int]()
+	for n := 0; n < size; n++ {
+		m.Put(n, n)
+	}
+	b.StartTimer()
+	benchmarkRemove(b, m, size)
+}
@@ -0,0 +1,128 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package concurrenthashmap
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/maphash"
+	"math"
+	"reflect"
+	"unsafe"
+)
+
+// Hasher returns the hash of a key. Equal keys must have equal hashes.
+type Hasher[K comparable] func(key K) uint64
+
+// NewHasher returns a hasher for any comparable key type, seeded randomly.
+// Strings, numbers and pointers are hashed directly, other types such as structs, arrays and interfaces by
+// reflection, which is slower, see NewWith to provide a specialized hasher.
+func NewHasher[K comparable]() Hasher[K] {
+	seed := maphash.MakeSeed()
+	salt := maphash.String(seed, "")
+	var zero K
+	typ := reflect.TypeOf(&zero).Elem()
+	switch typ.Kind() {
+	case reflect.String:
+		return func(key K) uint64 {
+			return maphash.String(seed, *(*string)(unsafe.Pointer(&key)))
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Pointer, reflect.UnsafePointer, reflect.Chan:
+		switch typ.Size() {
+		case 1:
+			return func(key K) uint64 { return mix(uint64(*(*uint8)(unsafe.Pointer(&key))) ^ salt) }
+		case 2:
+			return func(key K) uint64 { return mix(uint64(*(*uint16)(unsafe.Pointer(&key))) ^ salt) }
+		case 4:
+			return func(key K) uint64 { return mix(uint64(*(*uint32)(unsafe.Pointer(&key))) ^ salt) }
+		case 8:
+			return func(key K) uint64 { return mix(*(*uint64)(unsafe.Pointer(&key)) ^ salt) }
+		}
+	case reflect.Float32:
+		return func(key K) uint64 { return mix(floatBits(float64(*(*float32)(unsafe.Pointer(&key)))) ^ salt) }
+	case reflect.Float64:
+		return func(key K) uint64 { return mix(floatBits(*(*float64)(unsafe.Pointer(&key))) ^ salt) }
+	}
+	return func(key K) uint64 {
+		var hash maphash.Hash
+		hash.SetSeed(seed)
+		writeValue(&hash, reflect.ValueOf(&key).Elem())
+		return hash.Sum64()
+	}
+}
+
+// writeValue writes the parts of the value that take part in its equality to the hash.
+func writeValue(hash *maphash.Hash, value reflect.Value) {
+	var buf [8]byte
+	switch value.Kind() {
+	case reflect.Bool:
+		if value.Bool() {
+			buf[0] = 1
+		}
+		hash.Write(buf[:1])
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		binary.LittleEndian.PutUint64(buf[:], uint64(value.Int()))
+		hash.Write(buf[:])
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		binary.LittleEndian.PutUint64(buf[:], value.Uint())
+		hash.Write(buf[:])
+	case reflect.Float32, reflect.Float64:
+		binary.LittleEndian.PutUint64(buf[:], floatBits(value.Float()))
+		hash.Write(buf[:])
+	case reflect.Complex64, reflect.Complex128:
+		c := value.Complex()
+		binary.LittleEndian.PutUint64(buf[:], floatBits(real(c)))
+		hash.Write(buf[:])
+		binary.LittleEndian.PutUint64(buf[:], floatBits(imag(c)))
+		hash.Write(buf[:])
+	case reflect.String:
+		hash.WriteString(value.String())
+	case reflect.Pointer, reflect.UnsafePointer, reflect.Chan:
+		binary.LittleEndian.PutUint64(buf[:], uint64(value.Pointer()))
+		hash.Write(buf[:])
+	case reflect.Array:
+		for i := 0; i < value.Len(); i++ {
+			writeValue(hash, value.Index(i))
+		}
+	case reflect.Struct:
+		typ := value.Type()
+		for i := 0; i < value.NumField(); i++ {
+			// blank fields do not take part in comparisons
+			if typ.Field(i).Name != "_" {
+				writeValue(hash, value.Field(i))
+			}
+		}
+	case reflect.Interface:
+		if value.IsNil() {
+			hash.WriteByte(0)
+			return
+		}
+		elem := value.Elem()
+		hash.WriteString(elem.Type().String())
+		writeValue(hash, elem)
+	default:
+		panic(fmt.Sprintf("concurrenthashmap: hash of unhashable type %v", value.Type()))
+	}
+}
+
+// floatBits returns the bits of the float, the same for positive and negative zero, which are equal.
+func floatBits(f float64) uint64 {
+	if f == 0 {
+		return 0
+	}
+	return math.Float64bits(f)
+}
+
+// mix spreads the bits of the value over the whole hash (finalizer of SplitMix64).
+func mix(x uint64) uint64 {
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+	return x
+}
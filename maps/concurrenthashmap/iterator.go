@@ -0,0 +1,85 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package concurrenthashmap
+
+import "github.com/emirpasic/gods/v2/containers"
+
+// Assert Iterator implementation
+var _ containers.IteratorWithKey[string, int] = (*Iterator[string, int])(nil)
+
+// Iterator holding the iterator's state
+type Iterator[K comparable, V any] struct {
+	m       *Map[K, V]
+	shard   int           // index of the shard whose elements are iterated
+	entries []entry[K, V] // elements of the shard when the iterator reached it
+	index   int           // index of the current element in entries
+}
+
+// Iterator returns a stateful iterator whose elements are key/value pairs.
+// The iterator is weakly consistent, see the package documentation.
+func (m *Map[K, V]) Iterator() *Iterator[K, V] {
+	return &Iterator[K, V]{m: m, shard: -1, index: -1}
+}
+
+// Next moves the iterator to the next element and returns true if there was a next element in the container.
+// If Next() returns true, then next element's key and value can be retrieved by Key() and Value().
+// If Next() was called for the first time, then it will point the iterator to the first element if it exists.
+// Modifies the state of the iterator.
+func (iterator *Iterator[K, V]) Next() bool {
+	if iterator.index < len(iterator.entries) {
+		iterator.index++
+	}
+	for iterator.index >= len(iterator.entries) {
+		if iterator.shard+1 >= len(iterator.m.shards) {
+			return false
+		}
+		iterator.shard++
+		iterator.entries = iterator.m.snapshot(iterator.shard)
+		iterator.index = 0
+	}
+	return true
+}
+
+// Value returns the current element's value.
+// Does not modify the state of the iterator.
+func (iterator *Iterator[K, V]) Value() V {
+	return iterator.entries[iterator.index].value
+}
+
+// Key returns the current element's key.
+// Does not modify the state of the iterator.
+func (iterator *Iterator[K, V]) Key() K {
+	return iterator.entries[iterator.index].key
+}
+
+// Begin resets the iterator to its initial state (one-before-first)
+// Call Next() to fetch the first element if any.
+func (iterator *Iterator[K, V]) Begin() {
+	iterator.shard = -1
+	iterator.entries = nil
+	iterator.index = -1
+}
+
+// First moves the iterator to the first element and returns true if there was a first element in the container.
+// If First() returns true, then first element's key and value can be retrieved by Key() and Value().
+// Modifies the state of the iterator
+func (iterator *Iterator[K, V]) First() bool {
+	iterator.Begin()
+	return iterator.Next()
+}
+
+// NextTo moves the iterator to the next element from current position that satisfies the condition given by the
+// passed function, and returns true if there was a next element in the container.
+// If NextTo() returns true, then next element's key and value can be retrieved by Key() and Value().
+// Modifies the state of the iterator.
+func (iterator *Iterator[K, V]) NextTo(f func(key K, value V) bool) bool {
+	for iterator.Next() {
+		key, value := iterator.Key(), iterator.Value()
+		if f(key, value) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,424 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package concurrentskiplistmap implements an ordered map that is safe for concurrent use, backed by a skip list.
+//
+// The skip list is a lazy, optimistic one: writers search without locking, then lock only the predecessors of the
+// node they link or unlink and validate that they are still adjacent, retrying otherwise. Removed nodes are first
+// marked and then unlinked, so that readers never lock and never see a half-linked node. Get, Floor, Ceiling, Min
+// and Max are lock-free.
+//
+// Range, the iterator, Keys and Values are weakly consistent: they walk the list as it is when they reach each
+// element, see every element that is present for the whole walk, and never fail because of concurrent modifications.
+//
+// Elements are ordered by key in the map.
+//
+// Structure is thread safe.
+//
+// References: https://en.wikipedia.org/wiki/Skip_list,
+// https://people.csail.mit.edu/shanir/publications/LazySkipList.pdf
+package concurrentskiplistmap
+
+import (
+	"cmp"
+	"fmt"
+	"math/bits"
+	"math/rand"
+	"runtime"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/emirpasic/gods/v2/maps"
+	"github.com/emirpasic/gods/v2/utils"
+)
+
+// Assert Map implementation
+var _ maps.Map[string, int] = (*Map[string, int])(nil)
+
+// maxLevel is the maximum number of levels of the skip list, enough for 2^32 elements.
+const maxLevel = 32
+
+// Map holds the elements in a skip list
+type Map[K comparable, V any] struct {
+	head       *node[K, V] // sentinel preceding all elements, with links on all levels
+	comparator utils.Comparator[K]
+	size       atomic.Int64
+}
+
+// node holds a key-value pair and its links to the following nodes, one per level it spans.
+type node[K comparable, V any] struct {
+	key         K
+	value       atomic.Pointer[V]
+	next        []atomic.Pointer[node[K, V]]
+	mutex       sync.Mutex
+	marked      atomic.Bool // the node is being removed
+	fullyLinked atomic.Bool // the node is linked on all its levels
+}
+
+// New instantiates a concurrent skip list map with the built-in comparator for K
+func New[K cmp.Ordered, V any]() *Map[K, V] {
+	return NewWith[K, V](cmp.Compare[K])
+}
+
+// NewWith instantiates a concurrent skip list map with the custom comparator.
+func NewWith[K comparable, V any](comparator utils.Comparator[K]) *Map[K, V] {
+	head := &node[K, V]{next: make([]atomic.Pointer[node[K, V]], maxLevel)}
+	head.fullyLinked.Store(true)
+	return &Map[K, V]{head: head, comparator: comparator}
+}
+
+// Put inserts key-value pair into the map, replacing the value if the key is present.
+func (m *Map[K, V]) Put(key K, value V) {
+	var preds, succs [maxLevel]*node[K, V]
+	levels := randomLevels()
+	for {
+		if level := m.find(key, &preds, &succs); level >= 0 {
+			found := succs[level]
+			if !found.marked.Load() {
+				for !found.fullyLinked.Load() {
+					// the node is being linked by another goroutine
+					runtime.Gosched()
+				}
+				found.value.Store(&value)
+				return
+			}
+			// the node is being removed, wait for it to be unlinked
+			runtime.Gosched()
+			continue
+		}
+
+		highest, valid := m.lock(&preds, levels, func(level int, pred *node[K, V]) bool {
+			succ := succs[level]
+			return pred.next[level].Load() == succ && (succ == nil || !succ.marked.Load())
+		})
+		if !valid {
+			unlock(&preds, highest)
+			continue
+		}
+
+		n := &node[K, V]{key: key, next: make([]atomic.Pointer[node[K, V]], levels)}
+		n.value.Store(&value)
+		for level := 0; level < levels; level++ {
+			n.next[level].Store(succs[level])
+		}
+		for level := 0; level < levels; level++ {
+			preds[level].next[level].Store(n)
+		}
+		n.fullyLinked.Store(true)
+		unlock(&preds, highest)
+		m.size.Add(1)
+		return
+	}
+}
+
+// Get searches the element in the map by key and returns its value or the 0-value if key is not found in the map.
+// Second return parameter is true if key was found, otherwise false.
+func (m *Map[K, V]) Get(key K) (value V, found bool) {
+	pred := m.head
+	for level := maxLevel - 1; level >= 0; level-- {
+		curr := pred.next[level].Load()
+		for curr != nil && m.comparator(curr.key, key) < 0 {
+			pred = curr
+			curr = pred.next[level].Load()
+		}
+		if curr != nil && m.comparator(curr.key, key) == 0 {
+			if !curr.live() {
+				return value, false
+			}
+			return *curr.value.Load(), true
+		}
+	}
+	return value, false
+}
+
+// Remove removes the element from the map by key.
+func (m *Map[K, V]) Remove(key K) {
+	var preds, succs [maxLevel]*node[K, V]
+	var victim *node[K, V]
+	for {
+		level := m.find(key, &preds, &succs)
+		if victim == nil {
+			if level < 0 {
+				return
+			}
+			victim = succs[level]
+			// only a fully linked node found on its highest level can be removed
+			if !victim.fullyLinked.Load() || len(victim.next)-1 != level || victim.marked.Load() {
+				return
+			}
+			victim.mutex.Lock()
+			if victim.marked.Load() {
+				// removed by another goroutine
+				victim.mutex.Unlock()
+				return
+			}
+			victim.marked.Store(true)
+		}
+
+		highest, valid := m.lock(&preds, len(victim.next), func(level int, pred *node[K, V]) bool {
+			return pred.next[level].Load() == victim
+		})
+		if !valid {
+			unlock(&preds, highest)
+			continue
+		}
+
+		for level := len(victim.next) - 1; level >= 0; level-- {
+			preds[level].next[level].Store(victim.next[level].Load())
+		}
+		victim.mutex.Unlock()
+		unlock(&preds, highest)
+		m.size.Add(-1)
+		return
+	}
+}
+
+// Empty returns true if map does not contain any elements
+func (m *Map[K, V]) Empty() bool {
+	return m.Size() == 0
+}
+
+// Size returns number of elements in the map.
+func (m *Map[K, V]) Size() int {
+	return int(m.size.Load())
+}
+
+// Keys returns all keys in-order
+func (m *Map[K, V]) Keys() []K {
+	keys := make([]K, 0, m.Size())
+	m.Range(func(key K, value V) bool {
+		keys = append(keys, key)
+		return true
+	})
+	return keys
+}
+
+// Values returns all values in-order based on the key.
+func (m *Map[K, V]) Values() []V {
+	values := make([]V, 0, m.Size())
+	m.Range(func(key K, value V) bool {
+		values = append(values, value)
+		return true
+	})
+	return values
+}
+
+// Clear removes all elements from the map.
+// Elements put concurrently may remain in the map.
+func (m *Map[K, V]) Clear() {
+	for n := m.first(); n != nil; n = n.successor() {
+		m.Remove(n.key)
+	}
+}
+
+// Min returns the minimum key and its value from the map.
+// Returns 0-value, 0-value, false if map is empty.
+func (m *Map[K, V]) Min() (key K, value V, ok bool) {
+	return entryOf(m.first())
+}
+
+// Max returns the maximum key and its value from the map.
+// Returns 0-value, 0-value, false if map is empty.
+func (m *Map[K, V]) Max() (key K, value V, ok bool) {
+	return entryOf(m.last())
+}
+
+// Floor finds the floor key-value pair for the input key.
+// In case that no floor is found, then both returned values will be 0-values and ok false.
+//
+// Floor key is defined as the largest key that is smaller than or equal to the given key.
+// A floor key may not be found, either because the map is empty, or because
+// all keys in the map are larger than the given key.
+func (m *Map[K, V]) Floor(key K) (foundKey K, foundValue V, ok bool) {
+	return entryOf(m.lower(key, true))
+}
+
+// Ceiling finds the ceiling key-value pair for the input key.
+// In case that no ceiling is found, then both returned values will be 0-values and ok false.
+//
+// Ceiling key is defined as the smallest key that is larger than or equal to the given key.
+// A ceiling key may not be found, either because the map is empty, or because
+// all keys in the map are smaller than the given key.
+func (m *Map[K, V]) Ceiling(key K) (foundKey K, foundValue V, ok bool) {
+	return entryOf(m.higher(key))
+}
+
+// Range calls f for each element in ascending order of keys, until f returns false.
+// Range is weakly consistent, see the package documentation.
+func (m *Map[K, V]) Range(f func(key K, value V) bool) {
+	for n := m.first(); n != nil; n = n.successor() {
+		if !f(n.key, *n.value.Load()) {
+			return
+		}
+	}
+}
+
+// RangeBetween calls f for each element with a key in the half-open interval [from, to) in ascending order of keys,
+// until f returns false.
+// RangeBetween is weakly consistent, see the package documentation.
+func (m *Map[K, V]) RangeBetween(from, to K, f func(key K, value V) bool) {
+	for n := m.higher(from); n != nil && m.comparator(n.key, to) < 0; n = n.successor() {
+		if !f(n.key, *n.value.Load()) {
+			return
+		}
+	}
+}
+
+// String returns a string representation of container
+func (m *Map[K, V]) String() string {
+	str := "ConcurrentSkipListMap\nmap["
+	m.Range(func(key K, value V) bool {
+		str += fmt.Sprintf("%v:%v ", key, value)
+		return true
+	})
+	return strings.TrimRight(str, " ") + "]"
+}
+
+// find searches the predecessors and successors of the key on all levels.
+// Returns the highest level on which the node of the key was found, or -1 if it was not found.
+func (m *Map[K, V]) find(key K, preds, succs *[maxLevel]*node[K, V]) int {
+	found := -1
+	pred := m.head
+	for level := maxLevel - 1; level >= 0; level-- {
+		curr := pred.next[level].Load()
+		for curr != nil && m.comparator(curr.key, key) < 0 {
+			pred = curr
+			curr = pred.next[level].Load()
+		}
+		if found < 0 && curr != nil && m.comparator(curr.key, key) == 0 {
+			found = level
+		}
+		preds[level] = pred
+		succs[level] = curr
+	}
+	return found
+}
+
+// lock locks the distinct predecessors of the levels below levels, from the lowest level up, i.e. from the largest
+// key down, which is the order all goroutines lock in.
+// Returns the highest level whose predecessor was locked and whether all predecessors are still in the map and
+// valid for their level, i.e. still adjacent to the node linked or unlinked there.
+func (m *Map[K, V]) lock(preds *[maxLevel]*node[K, V], levels int,
+	valid func(level int, pred *node[K, V]) bool) (highest int, ok bool) {
+	highest = -1
+	var prev *node[K, V]
+	for level := 0; level < levels; level++ {
+		pred := preds[level]
+		if pred != prev {
+			pred.mutex.Lock()
+			highest = level
+			prev = pred
+		}
+		if pred.marked.Load() || !valid(level, pred) {
+			return highest, false
+		}
+	}
+	return highest, true
+}
+
+// unlock unlocks the distinct predecessors of the levels up to the highest one.
+func unlock[K comparable, V any](preds *[maxLevel]*node[K, V], highest int) {
+	var prev *node[K, V]
+	for level := 0; level <= highest; level++ {
+		if pred := preds[level]; pred != prev {
+			pred.mutex.Unlock()
+			prev = pred
+		}
+	}
+}
+
+// first returns the first live node, or nil if there is none.
+func (m *Map[K, V]) first() *node[K, V] {
+	return m.head.successor()
+}
+
+// higher returns the first live node whose key is larger than or equal to the key, or nil if there is none.
+func (m *Map[K, V]) higher(key K) *node[K, V] {
+	pred := m.head
+	for level := maxLevel - 1; level >= 0; level-- {
+		curr := pred.next[level].Load()
+		for curr != nil && m.comparator(curr.key, key) < 0 {
+			pred = curr
+			curr = pred.next[level].Load()
+		}
+	}
+	// walk on from the predecessor, as smaller keys may have been linked after it meanwhile
+	curr := pred.next[0].Load()
+	for curr != nil && (m.comparator(curr.key, key) < 0 || !curr.live()) {
+		curr = curr.next[0].Load()
+	}
+	return curr
+}
+
+// lower returns the last live node whose key is smaller than the key, or smaller than or equal to it if inclusive,
+// or nil if there is none.
+func (m *Map[K, V]) lower(key K, inclusive bool) *node[K, V] {
+	for {
+		pred := m.head
+		for level := maxLevel - 1; level >= 0; level-- {
+			curr := pred.next[level].Load()
+			for curr != nil {
+				if c := m.comparator(curr.key, key); c > 0 || c == 0 && !inclusive {
+					break
+				}
+				pred = curr
+				curr = pred.next[level].Load()
+			}
+		}
+		if pred == m.head {
+			return nil
+		}
+		if pred.live() {
+			return pred
+		}
+		// the node is being linked or removed, look for the one before it
+		key, inclusive = pred.key, false
+	}
+}
+
+// last returns the last live node, or nil if there is none.
+func (m *Map[K, V]) last() *node[K, V] {
+	pred := m.head
+	for level := maxLevel - 1; level >= 0; level-- {
+		for curr := pred.next[level].Load(); curr != nil; curr = pred.next[level].Load() {
+			pred = curr
+		}
+	}
+	if pred == m.head {
+		return nil
+	}
+	if pred.live() {
+		return pred
+	}
+	return m.lower(pred.key, false)
+}
+
+// live returns true if the node is linked and not being removed.
+func (n *node[K, V]) live() bool {
+	return n.fullyLinked.Load() && !n.marked.Load()
+}
+
+// successor returns the first live node following the node on the lowest level, or nil if there is none.
+// Links of removed nodes are kept, so that the successor of a removed node is found as well.
+func (n *node[K, V]) successor() *node[K, V] {
+	curr := n.next[0].Load()
+	for curr != nil && !curr.live() {
+		curr = curr.next[0].Load()
+	}
+	return curr
+}
+
+// entryOf returns the key and the value of the node, or false if the node is nil.
+func entryOf[K comparable, V any](n *node[K, V]) (key K, value V, ok bool) {
+	if n == nil {
+		return key, value, false
+	}
+	return n.key, *n.value.Load(), true
+}
+
+// randomLevels returns the number of levels of a new node, where each level is half as likely as the one below.
+func randomLevels() int {
+	return bits.TrailingZeros64(rand.Uint64()|1<<(maxLevel-1)) + 1
+}
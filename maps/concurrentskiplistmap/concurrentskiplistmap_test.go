@@ -0,0 +1,351 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package concurrentskiplistmap
+
+import (
+	"encoding/json"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestMapPut(t *testing.T) {
+	m := New[int, string]()
+	m.Put(5, "e")
+	m.Put(6, "f")
+	m.Put(7, "g")
+	m.Put(3, "c")
+	m.Put(4, "d")
+	m.Put(1, "x")
+	m.Put(2, "b")
+	m.Put(1, "a") //overwrite
+
+	if actualValue := m.Size(); actualValue != 7 {
+		t.Errorf("Got %v expected %v", actualValue, 7)
+	}
+	if actualValue, expectedValue := m.Keys(), []int{1, 2, 3, 4, 5, 6, 7}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := m.Values(), []string{"a", "b", "c", "d", "e", "f", "g"}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// key,expectedValue,expectedFound
+	tests1 := [][]interface{}{
+		{1, "a", true},
+		{2, "b", true},
+		{3, "c", true},
+		{4, "d", true},
+		{5, "e", true},
+		{6, "f", true},
+		{7, "g", true},
+		{8, "", false},
+	}
+
+	for _, test := range tests1 {
+		// retrievals
+		actualValue, actualFound := m.Get(test[0].(int))
+		if actualValue != test[1] || actualFound != test[2] {
+			t.Errorf("Got %v expected %v", actualValue, test[1])
+		}
+	}
+}
+
+func TestMapRemove(t *testing.T) {
+	m := New[int, string]()
+	for i := 1; i <= 100; i++ {
+		m.Put(i, "x")
+	}
+	for i := 2; i <= 100; i += 2 {
+		m.Remove(i)
+	}
+	m.Remove(101) // not present
+
+	if actualValue := m.Size(); actualValue != 50 {
+		t.Errorf("Got %v expected %v", actualValue, 50)
+	}
+	for i := 1; i <= 100; i++ {
+		if _, actualValue := m.Get(i); actualValue != (i%2 == 1) {
+			t.Errorf("Got %v expected %v for %v", actualValue, i%2 == 1, i)
+		}
+	}
+
+	m.Clear()
+	if actualValue := m.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := m.Keys(); len(actualValue) != 0 {
+		t.Errorf("Got %v expected %v", actualValue, []int{})
+	}
+	if _, _, ok := m.Min(); ok {
+		t.Errorf("Got %v expected %v", ok, false)
+	}
+	if _, _, ok := m.Max(); ok {
+		t.Errorf("Got %v expected %v", ok, false)
+	}
+}
+
+func TestMapMinMax(t *testing.T) {
+	m := New[int, string]()
+	m.Put(5, "e")
+	m.Put(1, "a")
+	m.Put(9, "i")
+
+	if actualKey, actualValue, ok := m.Min(); actualKey != 1 || actualValue != "a" || !ok {
+		t.Errorf("Got %v, %v, %v expected %v, %v, %v", actualKey, actualValue, ok, 1, "a", true)
+	}
+	if actualKey, actualValue, ok := m.Max(); actualKey != 9 || actualValue != "i" || !ok {
+		t.Errorf("Got %v, %v, %v expected %v, %v, %v", actualKey, actualValue, ok, 9, "i", true)
+	}
+}
+
+func TestMapFloorCeiling(t *testing.T) {
+	m := New[int, string]()
+	m.Put(7, "g")
+	m.Put(3, "c")
+	m.Put(1, "a")
+
+	// key,expectedFloorKey,expectedFloorValue,expectedFloorFound,expectedCeilingKey,expectedCeilingValue,expectedCeilingFound
+	tests1 := [][]interface{}{
+		{-1, 0, "", false, 1, "a", true},
+		{1, 1, "a", true, 1, "a", true},
+		{2, 1, "a", true, 3, "c", true},
+		{3, 3, "c", true, 3, "c", true},
+		{4, 3, "c", true, 7, "g", true},
+		{7, 7, "g", true, 7, "g", true},
+		{8, 7, "g", true, 0, "", false},
+	}
+
+	for _, test := range tests1 {
+		actualKey, actualValue, actualOk := m.Floor(test[0].(int))
+		if actualKey != test[1] || actualValue != test[2] || actualOk != test[3] {
+			t.Errorf("Got %v, %v, %v, expected %v, %v, %v", actualKey, actualValue, actualOk, test[1], test[2], test[3])
+		}
+		actualKey, actualValue, actualOk = m.Ceiling(test[0].(int))
+		if actualKey != test[4] || actualValue != test[5] || actualOk != test[6] {
+			t.Errorf("Got %v, %v, %v, expected %v, %v, %v", actualKey, actualValue, actualOk, test[4], test[5], test[6])
+		}
+	}
+}
+
+func TestMapRange(t *testing.T) {
+	m := New[int, int]()
+	for i := 0; i < 10; i++ {
+		m.Put(i, i*i)
+	}
+
+	var keys []int
+	m.RangeBetween(3, 7, func(key int, value int) bool {
+		if value != key*key {
+			t.Errorf("Got %v expected %v", value, key*key)
+		}
+		keys = append(keys, key)
+		return true
+	})
+	if actualValue, expectedValue := keys, []int{3, 4, 5, 6}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	keys = nil
+	m.Range(func(key int, value int) bool {
+		keys = append(keys, key)
+		return key < 2
+	})
+	if actualValue, expectedValue := keys, []int{0, 1, 2}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMapIterator(t *testing.T) {
+	m := New[string, int]()
+	m.Put("c", 3)
+	m.Put("a", 1)
+	m.Put("b", 2)
+
+	it := m.Iterator()
+	if actualValue := it.Prev(); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	var keys []string
+	for it.Next() {
+		if it.Key() == "b" {
+			// removing the current element does not break the iteration
+			m.Remove("b")
+			m.Put("d", 4)
+		}
+		keys = append(keys, it.Key())
+	}
+	if actualValue, expectedValue := keys, []string{"a", "b", "c", "d"}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	keys = nil
+	for it.End(); it.Prev(); {
+		keys = append(keys, it.Key())
+	}
+	if actualValue, expectedValue := keys, []string{"d", "c", "a"}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	if actualValue := it.Last(); actualValue != true || it.Key() != "d" || it.Value() != 4 {
+		t.Errorf("Got %v, %v, %v expected %v, %v, %v", actualValue, it.Key(), it.Value(), true, "d", 4)
+	}
+	if actualValue := it.First(); actualValue != true || it.Key() != "a" || it.Value() != 1 {
+		t.Errorf("Got %v, %v, %v expected %v, %v, %v", actualValue, it.Key(), it.Value(), true, "a", 1)
+	}
+	if actualValue := it.NextTo(func(key string, value int) bool { return value > 3 }); actualValue != true || it.Key() != "d" {
+		t.Errorf("Got %v, %v expected %v, %v", actualValue, it.Key(), true, "d")
+	}
+	if actualValue := it.PrevTo(func(key string, value int) bool { return value < 3 }); actualValue != true || it.Key() != "a" {
+		t.Errorf("Got %v, %v expected %v, %v", actualValue, it.Key(), true, "a")
+	}
+	if actualValue := it.PrevTo(func(key string, value int) bool { return true }); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+}
+
+func TestMapConcurrent(t *testing.T) {
+	const goroutines, count = 8, 1000
+	m := New[int, int]()
+
+	var wg sync.WaitGroup
+	for g := 0; g < goroutines; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < count; i++ {
+				key := g*count + i
+				m.Put(key, key)
+				if i%2 == 1 {
+					m.Remove(key - 1)
+				}
+				m.Get(i)
+				m.Floor(key)
+				m.Ceiling(key)
+				if i%50 == 0 {
+					// the walk is ordered whatever is modified meanwhile
+					last := -1
+					m.Range(func(key int, value int) bool {
+						if key <= last {
+							t.Errorf("Got %v after %v", key, last)
+						}
+						last = key
+						return true
+					})
+					it := m.Iterator()
+					for it.End(); it.Prev() && it.Key() > key-10; {
+					}
+				}
+			}
+		}(g)
+	}
+	wg.Wait()
+
+	if actualValue, expectedValue := m.Size(), goroutines*count/2; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	keys := m.Keys()
+	if actualValue, expectedValue := len(keys), goroutines*count/2; actualValue != expectedValue {
+		t.Fatalf("Got %v expected %v", actualValue, expectedValue)
+	}
+	for i, key := range keys {
+		if expectedValue := 2*i + 1; key != expectedValue {
+			t.Fatalf("Got %v expected %v", key, expectedValue)
+		}
+	}
+}
+
+func TestMapCeilingConcurrentPut(t *testing.T) {
+	const goroutines, count = 4, 10000
+	m := New[int, int]()
+
+	// keys put in ascending order are linked right after the node Ceiling and RangeBetween start from
+	var wg sync.WaitGroup
+	for g := 0; g < goroutines; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := g; i < count; i += goroutines {
+				m.Put(i, i)
+			}
+		}(g)
+	}
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+	for running := true; running; {
+		select {
+		case <-done:
+			running = false
+		default:
+		}
+		if key, _, ok := m.Ceiling(count); ok {
+			t.Fatalf("Got %v expected %v", key, "none")
+		}
+		m.RangeBetween(count, 2*count, func(key int, value int) bool {
+			t.Fatalf("Got %v expected %v", key, "none")
+			return false
+		})
+		from := count / 2
+		m.RangeBetween(from, count, func(key int, value int) bool {
+			if key < from {
+				t.Fatalf("Got %v expected at least %v", key, from)
+			}
+			return true
+		})
+	}
+}
+
+func TestMapSerialization(t *testing.T) {
+	m := New[string, float64]()
+	m.Put("a", 1.0)
+	m.Put("b", 2.0)
+	m.Put("c", 3.0)
+
+	var err error
+	assert := func() {
+		if actualValue, expectedValue := m.Keys(), []string{"a", "b", "c"}; !slices.Equal(actualValue, expectedValue) {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		if actualValue, expectedValue := m.Values(), []float64{1.0, 2.0, 3.0}; !slices.Equal(actualValue, expectedValue) {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		if err != nil {
+			t.Errorf("Got error %v", err)
+		}
+	}
+
+	assert()
+
+	bytes, err := m.ToJSON()
+	assert()
+
+	deserialized := New[string, float64]()
+	err = deserialized.FromJSON(bytes)
+	m = deserialized
+	assert()
+
+	bytes, err = json.Marshal([]interface{}{"a", "b", "c", m})
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+
+	err = json.Unmarshal([]byte(`{"a":1,"b":2}`), &m)
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	assert()
+}
+
+func TestMapString(t *testing.T) {
+	c := New[string, int]()
+	c.Put("a", 1)
+	if !strings.HasPrefix(c.String(), "ConcurrentSkipListMap") {
+		t.Errorf("String should start with container name")
+	}
+}
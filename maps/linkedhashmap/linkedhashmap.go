@@ -15,12 +15,14 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/emirpasic/gods/v2/containers"
 	"github.com/emirpasic/gods/v2/lists/doublylinkedlist"
 	"github.com/emirpasic/gods/v2/maps"
 )
 
 // Assert Map implementation
 var _ maps.Map[string, int] = (*Map[string, int])(nil)
+var _ containers.FailFast = (*Map[string, int])(nil)
 
 // Map holds the elements in a regular hash table, and uses doubly-linked list to store key ordering.
 type Map[K comparable, V any] struct {
@@ -96,6 +98,12 @@ func (m *Map[K, V]) Clear() {
 	m.ordering.Clear()
 }
 
+// SetFailFast enables or disables the checks of iterators for modifications of the map done while iterating,
+// see containers.ConcurrentModificationError. Checks are enabled by default.
+func (m *Map[K, V]) SetFailFast(enabled bool) {
+	m.ordering.SetFailFast(enabled)
+}
+
 // String returns a string representation of container
 func (m *Map[K, V]) String() string {
 	str := "LinkedHashMap\nmap["
@@ -518,6 +518,32 @@ func TestMapIteratorPrevTo(t *testing.T) {
 	}
 }
 
+func TestMapIteratorFailFast(t *testing.T) {
+	m := New[int, string]()
+	m.Put(1, "x")
+	m.Put(2, "x")
+	it := m.Iterator()
+	it.Next()
+	m.Put(3, "x")
+	testutils.ConcurrentModificationPanic(t, func() { it.Next() })
+	testutils.ConcurrentModificationPanic(t, func() { it.Prev() })
+
+	// reset iterator is valid again
+	count := 0
+	for it.Begin(); it.Next(); {
+		count++
+	}
+	if actualValue, expectedValue := count, 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	m.SetFailFast(false)
+	it.Begin()
+	it.Next()
+	m.Put(4, "x")
+	it.Next() // no panic
+}
+
 func TestMapSerialization(t *testing.T) {
 	for i := 0; i < 10; i++ {
 		original := New[string, string]()
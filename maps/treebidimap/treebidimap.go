@@ -23,6 +23,7 @@ import (
 
 	"strings"
 
+	"github.com/emirpasic/gods/v2/containers"
 	"github.com/emirpasic/gods/v2/maps"
 	"github.com/emirpasic/gods/v2/trees/redblacktree"
 	"github.com/emirpasic/gods/v2/utils"
@@ -30,6 +31,7 @@ import (
 
 // Assert Map implementation
 var _ maps.BidiMap[string, int] = (*Map[string, int])(nil)
+var _ containers.FailFast = (*Map[string, int])(nil)
 
 // Map holds the elements in two red-black trees.
 type Map[K, V comparable] struct {
@@ -111,6 +113,13 @@ func (m *Map[K, V]) Clear() {
 	m.inverseMap.Clear()
 }
 
+// SetFailFast enables or disables the checks of iterators for modifications of the map done while iterating,
+// see containers.ConcurrentModificationError. Checks are enabled by default.
+func (m *Map[K, V]) SetFailFast(enabled bool) {
+	m.forwardMap.SetFailFast(enabled)
+	m.inverseMap.SetFailFast(enabled)
+}
+
 // String returns a string representation of container
 func (m *Map[K, V]) String() string {
 	str := "TreeBidiMap\nmap["
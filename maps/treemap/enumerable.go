@@ -4,10 +4,7 @@
 
 package treemap
 
-import (
-	"github.com/emirpasic/gods/v2/containers"
-	rbt "github.com/emirpasic/gods/v2/trees/redblacktree"
-)
+import "github.com/emirpasic/gods/v2/containers"
 
 // Assert Enumerable implementation
 var _ containers.EnumerableWithKey[string, int] = (*Map[string, int])(nil)
@@ -23,7 +20,7 @@ func (m *Map[K, V]) Each(f func(key K, value V)) {
 // Map invokes the given function once for each element and returns a container
 // containing the values returned by the given function as key/value pairs.
 func (m *Map[K, V]) Map(f func(key1 K, value1 V) (K, V)) *Map[K, V] {
-	newMap := &Map[K, V]{tree: rbt.NewWith[K, V](m.tree.Comparator)}
+	newMap := &Map[K, V]{tree: m.tree.NewEmpty()}
 	iterator := m.Iterator()
 	for iterator.Next() {
 		key2, value2 := f(iterator.Key(), iterator.Value())
@@ -34,7 +31,7 @@ func (m *Map[K, V]) Map(f func(key1 K, value1 V) (K, V)) *Map[K, V] {
 
 // Select returns a new container containing all elements for which the given function returns a true value.
 func (m *Map[K, V]) Select(f func(key K, value V) bool) *Map[K, V] {
-	newMap := &Map[K, V]{tree: rbt.NewWith[K, V](m.tree.Comparator)}
+	newMap := &Map[K, V]{tree: m.tree.NewEmpty()}
 	iterator := m.Iterator()
 	for iterator.Next() {
 		if f(iterator.Key(), iterator.Value()) {
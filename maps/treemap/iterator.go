@@ -4,22 +4,19 @@
 
 package treemap
 
-import (
-	"github.com/emirpasic/gods/v2/containers"
-	rbt "github.com/emirpasic/gods/v2/trees/redblacktree"
-)
+import "github.com/emirpasic/gods/v2/containers"
 
 // Assert Iterator implementation
 var _ containers.ReverseIteratorWithKey[string, int] = (*Iterator[string, int])(nil)
 
 // Iterator holding the iterator's state
 type Iterator[K comparable, V any] struct {
-	iterator *rbt.Iterator[K, V]
+	iterator containers.ReverseIteratorWithKey[K, V]
 }
 
 // Iterator returns a stateful iterator whose elements are key/value pairs.
 func (m *Map[K, V]) Iterator() *Iterator[K, V] {
-	return &Iterator[K, V]{iterator: m.tree.Iterator()}
+	return &Iterator[K, V]{iterator: m.tree.IteratorWithKey()}
 }
 
 // Next moves the iterator to the next element and returns true if there was a next element in the container.
@@ -6,6 +6,9 @@
 //
 // Elements are ordered by key in the map.
 //
+// Any other ordered tree (AVL tree, B-tree, treap, splay tree, ...) can be used as the backing tree instead,
+// see NewWithTree.
+//
 // Structure is not thread safe.
 //
 // Reference: http://en.wikipedia.org/wiki/Associative_array
@@ -16,17 +19,20 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/emirpasic/gods/v2/containers"
 	"github.com/emirpasic/gods/v2/maps"
+	"github.com/emirpasic/gods/v2/trees"
 	rbt "github.com/emirpasic/gods/v2/trees/redblacktree"
 	"github.com/emirpasic/gods/v2/utils"
 )
 
 // Assert Map implementation
 var _ maps.Map[string, int] = (*Map[string, int])(nil)
+var _ containers.FailFast = (*Map[string, int])(nil)
 
-// Map holds the elements in a red-black tree
+// Map holds the elements in an ordered tree (red-black tree by default)
 type Map[K comparable, V any] struct {
-	tree *rbt.Tree[K, V]
+	tree trees.OrderedTree[K, V]
 }
 
 // New instantiates a tree map with the built-in comparator for K
@@ -39,6 +45,12 @@ func NewWith[K comparable, V any](comparator utils.Comparator[K]) *Map[K, V] {
 	return &Map[K, V]{tree: rbt.NewWith[K, V](comparator)}
 }
 
+// NewWithTree instantiates a tree map backed by the given ordered tree, e.g. avltree.New[K, V]() or btree.New[K, V](32).
+// The tree should be empty, it is used as is, i.e. its keys are ordered by its comparator.
+func NewWithTree[K comparable, V any](tree trees.OrderedTree[K, V]) *Map[K, V] {
+	return &Map[K, V]{tree: tree}
+}
+
 // Put inserts key-value pair into the map.
 // Key should adhere to the comparator's type assertion, otherwise method panics.
 func (m *Map[K, V]) Put(key K, value V) {
@@ -86,19 +98,13 @@ func (m *Map[K, V]) Clear() {
 // Min returns the minimum key and its value from the tree map.
 // Returns 0-value, 0-value, false if map is empty.
 func (m *Map[K, V]) Min() (key K, value V, ok bool) {
-	if node := m.tree.Left(); node != nil {
-		return node.Key, node.Value, true
-	}
-	return key, value, false
+	return m.tree.LeftEntry()
 }
 
 // Max returns the maximum key and its value from the tree map.
 // Returns 0-value, 0-value, false if map is empty.
 func (m *Map[K, V]) Max() (key K, value V, ok bool) {
-	if node := m.tree.Right(); node != nil {
-		return node.Key, node.Value, true
-	}
-	return key, value, false
+	return m.tree.RightEntry()
 }
 
 // Floor finds the floor key-value pair for the input key.
@@ -111,11 +117,7 @@ func (m *Map[K, V]) Max() (key K, value V, ok bool) {
 //
 // Key should adhere to the comparator's type assertion, otherwise method panics.
 func (m *Map[K, V]) Floor(key K) (foundKey K, foundValue V, ok bool) {
-	node, found := m.tree.Floor(key)
-	if found {
-		return node.Key, node.Value, true
-	}
-	return foundKey, foundValue, false
+	return m.tree.FloorEntry(key)
 }
 
 // Ceiling finds the ceiling key-value pair for the input key.
@@ -128,11 +130,13 @@ func (m *Map[K, V]) Floor(key K) (foundKey K, foundValue V, ok bool) {
 //
 // Key should adhere to the comparator's type assertion, otherwise method panics.
 func (m *Map[K, V]) Ceiling(key K) (foundKey K, foundValue V, ok bool) {
-	node, found := m.tree.Ceiling(key)
-	if found {
-		return node.Key, node.Value, true
-	}
-	return foundKey, foundValue, false
+	return m.tree.CeilingEntry(key)
+}
+
+// SetFailFast enables or disables the checks of iterators for modifications of the map done while iterating,
+// see containers.ConcurrentModificationError. Checks are enabled by default.
+func (m *Map[K, V]) SetFailFast(enabled bool) {
+	m.tree.SetFailFast(enabled)
 }
 
 // String returns a string representation of container
@@ -6,10 +6,18 @@ package treemap
 
 import (
 	"encoding/json"
+	"slices"
 	"strings"
 	"testing"
 
 	"github.com/emirpasic/gods/v2/testutils"
+	"github.com/emirpasic/gods/v2/trees"
+	"github.com/emirpasic/gods/v2/trees/avltree"
+	"github.com/emirpasic/gods/v2/trees/btree"
+	"github.com/emirpasic/gods/v2/trees/redblacktree"
+	"github.com/emirpasic/gods/v2/trees/splaytree"
+	"github.com/emirpasic/gods/v2/trees/treap"
+	"github.com/emirpasic/gods/v2/utils"
 )
 
 func TestMapPut(t *testing.T) {
@@ -645,6 +653,54 @@ func TestMapIteratorPrevTo(t *testing.T) {
 	}
 }
 
+func TestMapIteratorFailFast(t *testing.T) {
+	m := New[int, string]()
+	m.Put(1, "x")
+	m.Put(2, "x")
+	it := m.Iterator()
+	it.Next()
+	m.Put(3, "x")
+	testutils.ConcurrentModificationPanic(t, func() { it.Next() })
+	testutils.ConcurrentModificationPanic(t, func() { it.Prev() })
+
+	// reset iterator is valid again
+	count := 0
+	for it.Begin(); it.Next(); {
+		count++
+	}
+	if actualValue, expectedValue := count, 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	m.SetFailFast(false)
+	it.Begin()
+	it.Next()
+	m.Put(4, "x")
+	it.Next() // no panic
+}
+
+func TestMapComparatorCombinators(t *testing.T) {
+	m := NewWith[string, int](utils.CaseInsensitiveStringComparator)
+	m.Put("b", 1)
+	m.Put("A", 2)
+	m.Put("a", 3) // replaces "A"
+	m.Put("C", 4)
+	if actualValue, expectedValue := m.Keys(), []string{"a", "b", "C"}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, found := m.Get("B"); actualValue != 1 || !found {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+
+	versions := NewWith[string, bool](utils.Reverse(utils.NaturalStringComparator))
+	versions.Put("v1.2", true)
+	versions.Put("v1.10", true)
+	versions.Put("v1.9", true)
+	if actualValue, expectedValue := versions.Keys(), []string{"v1.10", "v1.9", "v1.2"}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
 func TestMapSerialization(t *testing.T) {
 	for i := 0; i < 10; i++ {
 		original := New[string, string]()
@@ -694,6 +750,90 @@ func TestMapString(t *testing.T) {
 	}
 }
 
+func TestMapWithTree(t *testing.T) {
+	backends := map[string]func() trees.OrderedTree[int, string]{
+		"redblacktree": func() trees.OrderedTree[int, string] { return redblacktree.New[int, string]() },
+		"avltree":      func() trees.OrderedTree[int, string] { return avltree.New[int, string]() },
+		"btree":        func() trees.OrderedTree[int, string] { return btree.New[int, string](3) },
+		"treap":        func() trees.OrderedTree[int, string] { return treap.New[int, string]() },
+		"splaytree":    func() trees.OrderedTree[int, string] { return splaytree.New[int, string]() },
+	}
+	for name, backend := range backends {
+		m := NewWithTree(backend())
+		if actualValue := m.Empty(); actualValue != true {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, true)
+		}
+		if _, _, ok := m.Min(); ok {
+			t.Errorf("[%s] Got %v expected %v", name, ok, false)
+		}
+		for _, key := range []int{50, 20, 80, 10, 30, 70, 90, 60, 40} {
+			m.Put(key, string(rune('a'+key/10)))
+		}
+		m.Put(30, "x")
+		m.Remove(60)
+		m.Remove(100)
+
+		if actualValue, expectedValue := m.Keys(), []int{10, 20, 30, 40, 50, 70, 80, 90}; !slices.Equal(actualValue, expectedValue) {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, expectedValue)
+		}
+		if actualValue, expectedValue := m.Values(), []string{"b", "c", "x", "e", "f", "h", "i", "j"}; !slices.Equal(actualValue, expectedValue) {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, expectedValue)
+		}
+		if value, found := m.Get(30); value != "x" || !found {
+			t.Errorf("[%s] Got %v %v expected %v %v", name, value, found, "x", true)
+		}
+		if key, _, ok := m.Min(); key != 10 || !ok {
+			t.Errorf("[%s] Got %v %v expected %v %v", name, key, ok, 10, true)
+		}
+		if key, _, ok := m.Max(); key != 90 || !ok {
+			t.Errorf("[%s] Got %v %v expected %v %v", name, key, ok, 90, true)
+		}
+		if key, value, ok := m.Floor(65); key != 50 || value != "f" || !ok {
+			t.Errorf("[%s] Got %v %v %v expected %v %v %v", name, key, value, ok, 50, "f", true)
+		}
+		if key, value, ok := m.Ceiling(65); key != 70 || value != "h" || !ok {
+			t.Errorf("[%s] Got %v %v %v expected %v %v %v", name, key, value, ok, 70, "h", true)
+		}
+		if _, _, ok := m.Floor(5); ok {
+			t.Errorf("[%s] Got %v expected %v", name, ok, false)
+		}
+		if _, _, ok := m.Ceiling(95); ok {
+			t.Errorf("[%s] Got %v expected %v", name, ok, false)
+		}
+
+		var reversed []int
+		it := m.Iterator()
+		for it.End(); it.Prev(); {
+			reversed = append(reversed, it.Key())
+		}
+		if actualValue, expectedValue := reversed, []int{90, 80, 70, 50, 40, 30, 20, 10}; !slices.Equal(actualValue, expectedValue) {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, expectedValue)
+		}
+
+		selected := m.Select(func(key int, value string) bool { return key > 40 })
+		if actualValue, expectedValue := selected.Keys(), []int{50, 70, 80, 90}; !slices.Equal(actualValue, expectedValue) {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, expectedValue)
+		}
+
+		serialized, err := m.ToJSON()
+		if err != nil {
+			t.Errorf("[%s] Got error %v", name, err)
+		}
+		deserialized := NewWithTree(backend())
+		if err := deserialized.FromJSON(serialized); err != nil {
+			t.Errorf("[%s] Got error %v", name, err)
+		}
+		if actualValue, expectedValue := deserialized.Keys(), m.Keys(); !slices.Equal(actualValue, expectedValue) {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, expectedValue)
+		}
+
+		m.Clear()
+		if actualValue := m.Size(); actualValue != 0 {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, 0)
+		}
+	}
+}
+
 // noinspection GoBoolExpressions
 func assertSerialization(m *Map[string, string], txt string, t *testing.T) {
 	if actualValue := m.Keys(); false ||
@@ -13,16 +13,20 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/emirpasic/gods/v2/containers"
 	"github.com/emirpasic/gods/v2/lists/arraylist"
 	"github.com/emirpasic/gods/v2/queues"
 )
 
 // Assert Queue implementation
 var _ queues.Queue[int] = (*Queue[int])(nil)
+var _ containers.FailFast = (*Queue[int])(nil)
 
 // Queue holds elements in an array-list
 type Queue[T comparable] struct {
-	list *arraylist.List[T]
+	list             *arraylist.List[T]
+	modCount         int  // number of structural modifications, checked by iterators
+	failFastDisabled bool // iterators do not check for modifications
 }
 
 // New instantiates a new empty queue
@@ -30,9 +34,16 @@ func New[T comparable]() *Queue[T] {
 	return &Queue[T]{list: arraylist.New[T]()}
 }
 
+// NewWithCapacity instantiates a new empty queue that can hold the given number of elements without allocating.
+// The queue does not shrink below that capacity, see EnsureCapacity.
+func NewWithCapacity[T comparable](capacity int) *Queue[T] {
+	return &Queue[T]{list: arraylist.NewWithCapacity[T](capacity)}
+}
+
 // Enqueue adds a value to the end of the queue
 func (queue *Queue[T]) Enqueue(value T) {
 	queue.list.Add(value)
+	queue.modCount++
 }
 
 // Dequeue removes first element of the queue and returns it, or nil if queue is empty.
@@ -41,6 +52,7 @@ func (queue *Queue[T]) Dequeue() (value T, ok bool) {
 	value, ok = queue.list.Get(0)
 	if ok {
 		queue.list.Remove(0)
+		queue.modCount++
 	}
 	return
 }
@@ -64,6 +76,7 @@ func (queue *Queue[T]) Size() int {
 // Clear removes all elements from the queue.
 func (queue *Queue[T]) Clear() {
 	queue.list.Clear()
+	queue.modCount++
 }
 
 // Values returns all elements in the queue (FIFO order).
@@ -71,6 +84,48 @@ func (queue *Queue[T]) Values() []T {
 	return queue.list.Values()
 }
 
+// AppendValuesTo appends all elements in the queue (FIFO order) to dst and returns the extended slice, like append.
+// Unlike Values, it does not allocate if dst has enough capacity.
+func (queue *Queue[T]) AppendValuesTo(dst []T) []T {
+	return queue.list.AppendValuesTo(dst)
+}
+
+// Elements returns the elements in the queue (FIFO order) without copying them.
+// The returned slice shares the queue's storage: it must not be modified, and it is only valid until the queue is
+// modified.
+func (queue *Queue[T]) Elements() []T {
+	return queue.list.Elements()
+}
+
+// Capacity returns the number of elements the queue can hold without allocating.
+func (queue *Queue[T]) Capacity() int {
+	return queue.list.Capacity()
+}
+
+// EnsureCapacity increases the capacity of the queue, if necessary, so that it can hold at least the given number of
+// elements without allocating. The queue does not shrink below that capacity until TrimToSize is called.
+func (queue *Queue[T]) EnsureCapacity(capacity int) {
+	queue.list.EnsureCapacity(capacity)
+}
+
+// TrimToSize reduces the capacity of the queue to its size and drops the capacity requested by NewWithCapacity or
+// EnsureCapacity.
+func (queue *Queue[T]) TrimToSize() {
+	queue.list.TrimToSize()
+}
+
+// SetResizePolicy sets how the capacity of the queue changes as elements are enqueued and dequeued,
+// see arraylist.ResizePolicy.
+func (queue *Queue[T]) SetResizePolicy(policy arraylist.ResizePolicy) {
+	queue.list.SetResizePolicy(policy)
+}
+
+// SetFailFast enables or disables the checks of iterators for modifications of the queue done while iterating,
+// see containers.ConcurrentModificationError. Checks are enabled by default.
+func (queue *Queue[T]) SetFailFast(enabled bool) {
+	queue.failFastDisabled = !enabled
+}
+
 // String returns a string representation of container
 func (queue *Queue[T]) String() string {
 	str := "ArrayQueue\n"
@@ -6,9 +6,11 @@ package arrayqueue
 
 import (
 	"encoding/json"
+	"slices"
 	"strings"
 	"testing"
 
+	"github.com/emirpasic/gods/v2/lists/arraylist"
 	"github.com/emirpasic/gods/v2/testutils"
 )
 
@@ -347,6 +349,68 @@ func TestQueueIteratorPrevTo(t *testing.T) {
 	}
 }
 
+func TestQueueIteratorFailFast(t *testing.T) {
+	queue := New[int]()
+	queue.Enqueue(1)
+	queue.Enqueue(2)
+	it := queue.Iterator()
+	it.Next()
+	queue.Enqueue(3)
+	testutils.ConcurrentModificationPanic(t, func() { it.Next() })
+	testutils.ConcurrentModificationPanic(t, func() { it.Prev() })
+
+	// reset iterator is valid again
+	count := 0
+	for it.Begin(); it.Next(); {
+		count++
+	}
+	if actualValue, expectedValue := count, 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	queue.SetFailFast(false)
+	it.Begin()
+	it.Next()
+	queue.Enqueue(4)
+	it.Next() // no panic
+}
+
+func TestQueueCapacity(t *testing.T) {
+	queue := NewWithCapacity[int](10)
+	if actualValue := queue.Capacity(); actualValue != 10 {
+		t.Errorf("Got %v expected %v", actualValue, 10)
+	}
+	allocs := testing.AllocsPerRun(10, func() {
+		for i := 0; i < 10; i++ {
+			queue.Enqueue(i)
+		}
+		queue.Clear()
+	})
+	if allocs != 0 {
+		t.Errorf("Got %v allocations expected %v", allocs, 0)
+	}
+	queue.SetResizePolicy(arraylist.ResizePolicy{GrowthFactor: 3, ShrinkFactor: 0})
+	queue.EnsureCapacity(20)
+	queue.Enqueue(1)
+	queue.Enqueue(2)
+	queue.Enqueue(3)
+	if actualValue := queue.Capacity(); actualValue != 20 {
+		t.Errorf("Got %v expected %v", actualValue, 20)
+	}
+	queue.TrimToSize()
+	if actualValue := queue.Capacity(); actualValue != 3 {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+
+	dst := make([]int, 0, 3)
+	if actualValue, expectedValue := queue.AppendValuesTo(dst), []int{1, 2, 3}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := queue.Elements(), []int{1, 2, 3}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
 func TestQueueSerialization(t *testing.T) {
 	queue := New[string]()
 	queue.Enqueue("a")
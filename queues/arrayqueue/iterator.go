@@ -11,13 +11,14 @@ var _ containers.ReverseIteratorWithIndex[int] = (*Iterator[int])(nil)
 
 // Iterator returns a stateful iterator whose values can be fetched by an index.
 type Iterator[T comparable] struct {
-	queue *Queue[T]
-	index int
+	queue    *Queue[T]
+	index    int
+	modCount int // queue's modification count the iterator is in sync with
 }
 
 // Iterator returns a stateful iterator whose values can be fetched by an index.
 func (queue *Queue[T]) Iterator() *Iterator[T] {
-	return &Iterator[T]{queue: queue, index: -1}
+	return &Iterator[T]{queue: queue, index: -1, modCount: queue.modCount}
 }
 
 // Next moves the iterator to the next element and returns true if there was a next element in the container.
@@ -25,6 +26,7 @@ func (queue *Queue[T]) Iterator() *Iterator[T] {
 // If Next() was called for the first time, then it will point the iterator to the first element if it exists.
 // Modifies the state of the iterator.
 func (iterator *Iterator[T]) Next() bool {
+	iterator.checkModification()
 	if iterator.index < iterator.queue.Size() {
 		iterator.index++
 	}
@@ -35,6 +37,7 @@ func (iterator *Iterator[T]) Next() bool {
 // If Prev() returns true, then previous element's index and value can be retrieved by Index() and Value().
 // Modifies the state of the iterator.
 func (iterator *Iterator[T]) Prev() bool {
+	iterator.checkModification()
 	if iterator.index >= 0 {
 		iterator.index--
 	}
@@ -58,12 +61,14 @@ func (iterator *Iterator[T]) Index() int {
 // Call Next() to fetch the first element if any.
 func (iterator *Iterator[T]) Begin() {
 	iterator.index = -1
+	iterator.modCount = iterator.queue.modCount
 }
 
 // End moves the iterator past the last element (one-past-the-end).
 // Call Prev() to fetch the last element if any.
 func (iterator *Iterator[T]) End() {
 	iterator.index = iterator.queue.Size()
+	iterator.modCount = iterator.queue.modCount
 }
 
 // First moves the iterator to the first element and returns true if there was a first element in the container.
@@ -109,3 +114,16 @@ func (iterator *Iterator[T]) PrevTo(f func(index int, value T) bool) bool {
 	}
 	return false
 }
+
+// checkModification panics if the queue was modified since the iterator moved to its current element,
+// unless fail-fast iteration is disabled for the queue.
+func (iterator *Iterator[T]) checkModification() {
+	if iterator.index == -1 {
+		// not on an element yet, nothing to invalidate
+		iterator.modCount = iterator.queue.modCount
+		return
+	}
+	if iterator.modCount != iterator.queue.modCount && !iterator.queue.failFastDisabled {
+		panic(&containers.ConcurrentModificationError{Container: "ArrayQueue"})
+	}
+}
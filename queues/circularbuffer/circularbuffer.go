@@ -6,86 +6,210 @@
 //
 // In computer science, a circular buffer, circular queue, cyclic buffer or ring buffer is a data structure that uses a single, fixed-size buffer as if it were connected end-to-end. This structure lends itself easily to buffering data streams.
 //
-// Structure is not thread safe.
+// When the buffer is full, a new element overwrites the oldest one by default, see OverflowPolicy for alternatives.
+// The buffer can be resized while preserving the order of its elements.
+//
+// Operations of the queue are thread safe, so that a producer blocked by the Block policy can be released by a
+// consumer in another goroutine. Every step of an iterator locks the queue as well, but iterating alongside writers
+// is not: a concurrent modification makes the iterator panic with a containers.ConcurrentModificationError, unless
+// fail-fast checks are disabled, in which case it may skip or repeat elements. Values returns a consistent copy.
 //
 // Reference: https://en.wikipedia.org/wiki/Circular_buffer
 package circularbuffer
 
 import (
+	"errors"
 	"fmt"
 	"strings"
+	"sync"
 
+	"github.com/emirpasic/gods/v2/containers"
 	"github.com/emirpasic/gods/v2/queues"
 )
 
 // Assert Queue implementation
 var _ queues.Queue[int] = (*Queue[int])(nil)
+var _ containers.FailFast = (*Queue[int])(nil)
+
+// ErrFull is returned when an element is added to a full queue with the Reject policy.
+var ErrFull = errors.New("circularbuffer: queue is full")
+
+// OverflowPolicy determines what happens when an element is added to a full queue.
+type OverflowPolicy int
+
+const (
+	// Overwrite evicts the element at the opposite end to make room, i.e. the oldest element on Enqueue and the newest
+	// element on PushFront.
+	Overwrite OverflowPolicy = iota
+
+	// Reject discards the new element, Offer and PushFront return ErrFull.
+	Reject
+
+	// Block waits until another goroutine makes room, e.g. by dequeuing an element.
+	Block
+)
 
 // Queue holds values in a slice.
 type Queue[T comparable] struct {
-	values  []T
-	start   int
-	end     int
-	full    bool
-	maxSize int
-	size    int
+	values           []T
+	start            int
+	maxSize          int
+	size             int
+	policy           OverflowPolicy
+	onEvict          func(value T)
+	mutex            sync.Mutex
+	notFull          sync.Cond // signalled when room is made, waited on by blocked producers
+	modCount         int       // number of structural modifications, checked by iterators
+	failFastDisabled bool      // iterators do not check for modifications
 }
 
 // New instantiates a new empty queue with the specified size of maximum number of elements that it can hold.
-// This max size of the buffer cannot be changed.
+// Adding an element to a full queue overwrites the oldest element. The max size of the buffer can be changed by Resize.
 func New[T comparable](maxSize int) *Queue[T] {
+	return NewWith[T](maxSize, Overwrite)
+}
+
+// NewWith instantiates a new empty queue with the specified size of maximum number of elements that it can hold and
+// the policy applied when an element is added to a full queue.
+func NewWith[T comparable](maxSize int, policy OverflowPolicy) *Queue[T] {
 	if maxSize < 1 {
 		panic("Invalid maxSize, should be at least 1")
 	}
-	queue := &Queue[T]{maxSize: maxSize}
+	queue := &Queue[T]{maxSize: maxSize, policy: policy}
+	queue.notFull.L = &queue.mutex
 	queue.Clear()
 	return queue
 }
 
-// Enqueue adds a value to the end of the queue
+// Enqueue adds a value to the end of the queue, applying the overflow policy if the queue is full.
+// With the Reject policy the value is discarded, use Offer to find out.
 func (queue *Queue[T]) Enqueue(value T) {
-	if queue.Full() {
-		queue.Dequeue()
-	}
-	queue.values[queue.end] = value
-	queue.end = queue.end + 1
-	if queue.end >= queue.maxSize {
-		queue.end = 0
-	}
-	if queue.end == queue.start {
-		queue.full = true
-	}
+	_ = queue.Offer(value)
+}
+
+// Offer adds a value to the end of the queue, applying the overflow policy if the queue is full.
+// Returns ErrFull if the value was rejected.
+func (queue *Queue[T]) Offer(value T) error {
+	return queue.push(value, false)
+}
 
-	queue.size = queue.calculateSize()
+// PushFront adds a value to the front of the queue, so that it is dequeued next, applying the overflow policy if the
+// queue is full. The Overwrite policy evicts the last element. Returns ErrFull if the value was rejected.
+func (queue *Queue[T]) PushFront(value T) error {
+	return queue.push(value, true)
 }
 
 // Dequeue removes first element of the queue and returns it, or the 0-value if queue is empty.
 // Second return parameter is true, unless the queue was empty and there was nothing to dequeue.
 func (queue *Queue[T]) Dequeue() (value T, ok bool) {
-	if queue.Empty() {
-		return value, false
-	}
-
-	value, ok = queue.values[queue.start], true
-	queue.start = queue.start + 1
-	if queue.start >= queue.maxSize {
-		queue.start = 0
-	}
-	queue.full = false
-	queue.size = queue.size - 1
+	queue.mutex.Lock()
+	defer queue.mutex.Unlock()
+	return queue.popFront()
+}
 
-	return
+// PopBack removes last element of the queue and returns it, or the 0-value if queue is empty.
+// Second return parameter is true, unless the queue was empty and there was nothing to pop.
+func (queue *Queue[T]) PopBack() (value T, ok bool) {
+	queue.mutex.Lock()
+	defer queue.mutex.Unlock()
+	return queue.popBack()
 }
 
 // Peek returns first element of the queue without removing it, or nil if queue is empty.
 // Second return parameter is true, unless the queue was empty and there was nothing to peek.
 func (queue *Queue[T]) Peek() (value T, ok bool) {
-	if queue.Empty() {
+	queue.mutex.Lock()
+	defer queue.mutex.Unlock()
+	if queue.size == 0 {
 		return value, false
 	}
 	return queue.values[queue.start], true
 }
 
+// Get returns the element at index, counted from the first element of the queue.
+// Second return parameter is true if index is within bounds of the queue.
+func (queue *Queue[T]) Get(index int) (value T, ok bool) {
+	queue.mutex.Lock()
+	defer queue.mutex.Unlock()
+	if !queue.withinRange(index) {
+		return value, false
+	}
+	return queue.values[queue.index(index)], true
+}
+
+// Last returns the last n elements of the queue (FIFO order), or all elements if the queue holds fewer.
+func (queue *Queue[T]) Last(n int) []T {
+	queue.mutex.Lock()
+	defer queue.mutex.Unlock()
+	n = max(0, min(n, queue.size))
+	values := make([]T, n)
+	for i := range values {
+		values[i] = queue.values[queue.index(queue.size-n+i)]
+	}
+	return values
+}
+
+// Resize changes the maximum number of elements the queue can hold, preserving the order of the elements.
+// If the queue holds more elements than fit, the oldest elements are evicted.
+func (queue *Queue[T]) Resize(maxSize int) {
+	if maxSize < 1 {
+		panic("Invalid maxSize, should be at least 1")
+	}
+	queue.mutex.Lock()
+	var evicted []T
+	for queue.size > maxSize {
+		value, _ := queue.popFront()
+		evicted = append(evicted, value)
+	}
+	values := make([]T, maxSize, maxSize)
+	for i := 0; i < queue.size; i++ {
+		values[i] = queue.values[queue.index(i)]
+	}
+	queue.values = values
+	queue.start = 0
+	queue.maxSize = maxSize
+	queue.modCount++
+	queue.notFull.Broadcast()
+	onEvict := queue.onEvict
+	queue.mutex.Unlock()
+	if onEvict != nil {
+		for _, value := range evicted {
+			onEvict(value)
+		}
+	}
+}
+
+// MaxSize returns the maximum number of elements the queue can hold.
+func (queue *Queue[T]) MaxSize() int {
+	queue.mutex.Lock()
+	defer queue.mutex.Unlock()
+	return queue.maxSize
+}
+
+// SetOverflowPolicy changes the policy applied when an element is added to a full queue.
+func (queue *Queue[T]) SetOverflowPolicy(policy OverflowPolicy) {
+	queue.mutex.Lock()
+	defer queue.mutex.Unlock()
+	queue.policy = policy
+	// blocked producers apply the new policy
+	queue.notFull.Broadcast()
+}
+
+// OverflowPolicy returns the policy applied when an element is added to a full queue.
+func (queue *Queue[T]) OverflowPolicy() OverflowPolicy {
+	queue.mutex.Lock()
+	defer queue.mutex.Unlock()
+	return queue.policy
+}
+
+// SetEvictionCallback sets a function that is called with every element evicted by the Overwrite policy or by Resize,
+// or removes it if nil. The function is called after the queue is unlocked, so it may use the queue.
+func (queue *Queue[T]) SetEvictionCallback(f func(value T)) {
+	queue.mutex.Lock()
+	defer queue.mutex.Unlock()
+	queue.onEvict = f
+}
+
 // Empty returns true if queue does not contain any elements.
 func (queue *Queue[T]) Empty() bool {
 	return queue.Size() == 0
@@ -93,32 +217,48 @@ func (queue *Queue[T]) Empty() bool {
 
 // Full returns true if the queue is full, i.e. has reached the maximum number of elements that it can hold.
 func (queue *Queue[T]) Full() bool {
-	return queue.Size() == queue.maxSize
+	queue.mutex.Lock()
+	defer queue.mutex.Unlock()
+	return queue.size == queue.maxSize
 }
 
 // Size returns number of elements within the queue.
 func (queue *Queue[T]) Size() int {
+	queue.mutex.Lock()
+	defer queue.mutex.Unlock()
 	return queue.size
 }
 
 // Clear removes all elements from the queue.
 func (queue *Queue[T]) Clear() {
+	queue.mutex.Lock()
+	defer queue.mutex.Unlock()
 	queue.values = make([]T, queue.maxSize, queue.maxSize)
 	queue.start = 0
-	queue.end = 0
-	queue.full = false
 	queue.size = 0
+	queue.modCount++
+	queue.notFull.Broadcast()
 }
 
 // Values returns all elements in the queue (FIFO order).
 func (queue *Queue[T]) Values() []T {
-	values := make([]T, queue.Size(), queue.Size())
-	for i := 0; i < queue.Size(); i++ {
-		values[i] = queue.values[(queue.start+i)%queue.maxSize]
+	queue.mutex.Lock()
+	defer queue.mutex.Unlock()
+	values := make([]T, queue.size, queue.size)
+	for i := 0; i < queue.size; i++ {
+		values[i] = queue.values[queue.index(i)]
 	}
 	return values
 }
 
+// SetFailFast enables or disables the checks of iterators for modifications of the queue done while iterating,
+// see containers.ConcurrentModificationError. Checks are enabled by default.
+func (queue *Queue[T]) SetFailFast(enabled bool) {
+	queue.mutex.Lock()
+	defer queue.mutex.Unlock()
+	queue.failFastDisabled = !enabled
+}
+
 // String returns a string representation of container
 func (queue *Queue[T]) String() string {
 	str := "CircularBuffer\n"
@@ -130,19 +270,99 @@ func (queue *Queue[T]) String() string {
 	return str
 }
 
-// Check that the index is within bounds of the list
-func (queue *Queue[T]) withinRange(index int) bool {
-	return index >= 0 && index < queue.size
+// fill adds the values to the end of the queue under a single lock, evicting the oldest elements to make room
+// like the Overwrite policy, whatever the policy of the queue.
+func (queue *Queue[T]) fill(values []T) {
+	queue.mutex.Lock()
+	var evicted []T
+	for _, value := range values {
+		if queue.size == queue.maxSize {
+			oldest, _ := queue.popFront()
+			evicted = append(evicted, oldest)
+		}
+		queue.values[queue.index(queue.size)] = value
+		queue.size++
+	}
+	queue.modCount++
+	onEvict := queue.onEvict
+	queue.mutex.Unlock()
+	if onEvict != nil {
+		for _, value := range evicted {
+			onEvict(value)
+		}
+	}
 }
 
-func (queue *Queue[T]) calculateSize() int {
-	if queue.end < queue.start {
-		return queue.maxSize - queue.start + queue.end
-	} else if queue.end == queue.start {
-		if queue.full {
-			return queue.maxSize
+// push adds the value to the front or the end of the queue, applying the overflow policy if the queue is full.
+func (queue *Queue[T]) push(value T, front bool) error {
+	queue.mutex.Lock()
+	var evicted T
+	var ok bool
+	for queue.size == queue.maxSize {
+		switch queue.policy {
+		case Reject:
+			queue.mutex.Unlock()
+			return ErrFull
+		case Block:
+			queue.notFull.Wait()
+			continue
 		}
-		return 0
+		if front {
+			evicted, ok = queue.popBack()
+		} else {
+			evicted, ok = queue.popFront()
+		}
+	}
+	if front {
+		queue.start = queue.index(queue.maxSize - 1)
+		queue.values[queue.start] = value
+	} else {
+		queue.values[queue.index(queue.size)] = value
+	}
+	queue.size++
+	queue.modCount++
+	onEvict := queue.onEvict
+	queue.mutex.Unlock()
+	if ok && onEvict != nil {
+		onEvict(evicted)
+	}
+	return nil
+}
+
+// popFront removes and returns the first element.
+func (queue *Queue[T]) popFront() (value T, ok bool) {
+	if queue.size == 0 {
+		return value, false
+	}
+	var zero T
+	value, queue.values[queue.start] = queue.values[queue.start], zero
+	queue.start = queue.index(1)
+	queue.size--
+	queue.modCount++
+	queue.notFull.Signal()
+	return value, true
+}
+
+// popBack removes and returns the last element.
+func (queue *Queue[T]) popBack() (value T, ok bool) {
+	if queue.size == 0 {
+		return value, false
 	}
-	return queue.end - queue.start
+	var zero T
+	last := queue.index(queue.size - 1)
+	value, queue.values[last] = queue.values[last], zero
+	queue.size--
+	queue.modCount++
+	queue.notFull.Signal()
+	return value, true
+}
+
+// index returns the position in the slice of the element at the index counted from the first element.
+func (queue *Queue[T]) index(index int) int {
+	return (queue.start + index) % queue.maxSize
+}
+
+// Check that the index is within bounds of the list
+func (queue *Queue[T]) withinRange(index int) bool {
+	return index >= 0 && index < queue.size
 }
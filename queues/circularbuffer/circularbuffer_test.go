@@ -6,8 +6,11 @@ package circularbuffer
 
 import (
 	"encoding/json"
+	"slices"
 	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/emirpasic/gods/v2/testutils"
 )
@@ -151,6 +154,238 @@ func TestQueueDequeueFull(t *testing.T) {
 	assert(len(queue.Values()), 0)
 }
 
+func TestQueuePushFrontPopBack(t *testing.T) {
+	queue := New[int](3)
+	if actualValue, ok := queue.PopBack(); actualValue != 0 || ok {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+
+	queue.Enqueue(2)
+	queue.PushFront(1)
+	queue.Enqueue(3)
+	if actualValue, expectedValue := queue.Values(), []int{1, 2, 3}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// overwrites the last element
+	if err := queue.PushFront(0); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := queue.Values(), []int{0, 1, 2}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, ok := queue.PopBack(); actualValue != 2 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 2)
+	}
+	if actualValue, ok := queue.Dequeue(); actualValue != 0 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+	if actualValue, ok := queue.PopBack(); actualValue != 1 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+	if actualValue := queue.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+
+	// iterates from the front
+	queue.PushFront(5)
+	queue.PushFront(4)
+	var values []int
+	for it := queue.Iterator(); it.Next(); {
+		values = append(values, it.Value())
+	}
+	if actualValue, expectedValue := values, []int{4, 5}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestQueueGetLast(t *testing.T) {
+	queue := New[int](4)
+	for i := 1; i <= 6; i++ {
+		queue.Enqueue(i)
+	}
+
+	tests := []struct {
+		index int
+		value int
+		ok    bool
+	}{
+		{-1, 0, false},
+		{0, 3, true},
+		{3, 6, true},
+		{4, 0, false},
+	}
+	for _, test := range tests {
+		if actualValue, ok := queue.Get(test.index); actualValue != test.value || ok != test.ok {
+			t.Errorf("Got %v %v expected %v %v", actualValue, ok, test.value, test.ok)
+		}
+	}
+
+	if actualValue, expectedValue := queue.Last(2), []int{5, 6}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := queue.Last(10), []int{3, 4, 5, 6}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue := queue.Last(0); len(actualValue) != 0 {
+		t.Errorf("Got %v expected %v", actualValue, "[]")
+	}
+	if actualValue := queue.Last(-1); len(actualValue) != 0 {
+		t.Errorf("Got %v expected %v", actualValue, "[]")
+	}
+}
+
+func TestQueueResize(t *testing.T) {
+	queue := New[int](3)
+	var evicted []int
+	queue.SetEvictionCallback(func(value int) { evicted = append(evicted, value) })
+	for i := 1; i <= 5; i++ {
+		queue.Enqueue(i)
+	}
+
+	// grows a wrapped buffer
+	queue.Resize(5)
+	if actualValue := queue.MaxSize(); actualValue != 5 {
+		t.Errorf("Got %v expected %v", actualValue, 5)
+	}
+	queue.Enqueue(6)
+	queue.Enqueue(7)
+	if actualValue, expectedValue := queue.Values(), []int{3, 4, 5, 6, 7}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue := queue.Full(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+
+	// shrinks by evicting the oldest elements
+	queue.Resize(2)
+	if actualValue, expectedValue := queue.Values(), []int{6, 7}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := evicted, []int{1, 2, 3, 4, 5}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	queue.Enqueue(8)
+	if actualValue, expectedValue := queue.Values(), []int{7, 8}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	it := queue.Iterator()
+	it.Next()
+	queue.Resize(3)
+	testutils.ConcurrentModificationPanic(t, func() { it.Next() })
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("Got no panic expected a panic for an invalid size")
+		}
+	}()
+	queue.Resize(0)
+}
+
+func TestQueueEvictionCallback(t *testing.T) {
+	queue := New[int](2)
+	var evicted []int
+	queue.SetEvictionCallback(func(value int) {
+		evicted = append(evicted, value)
+		queue.Size() // the queue is not locked
+	})
+	queue.Enqueue(1)
+	queue.Enqueue(2)
+	queue.Enqueue(3)
+	queue.PushFront(0)
+	queue.Dequeue()
+	queue.Clear()
+
+	if actualValue, expectedValue := evicted, []int{1, 3}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	queue.SetEvictionCallback(nil)
+	queue.Enqueue(1)
+	queue.Enqueue(2)
+	queue.Enqueue(3)
+	if actualValue := len(evicted); actualValue != 2 {
+		t.Errorf("Got %v expected %v", actualValue, 2)
+	}
+}
+
+func TestQueueOverflowReject(t *testing.T) {
+	queue := NewWith[int](2, Reject)
+	if actualValue := queue.OverflowPolicy(); actualValue != Reject {
+		t.Errorf("Got %v expected %v", actualValue, Reject)
+	}
+	if err := queue.Offer(1); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	queue.Enqueue(2)
+	if err := queue.Offer(3); err != ErrFull {
+		t.Errorf("Got %v expected %v", err, ErrFull)
+	}
+	if err := queue.PushFront(0); err != ErrFull {
+		t.Errorf("Got %v expected %v", err, ErrFull)
+	}
+	queue.Enqueue(4) // discarded
+	if actualValue, expectedValue := queue.Values(), []int{1, 2}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	queue.SetOverflowPolicy(Overwrite)
+	if err := queue.Offer(3); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := queue.Values(), []int{2, 3}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestQueueOverflowBlock(t *testing.T) {
+	queue := NewWith[int](2, Block)
+	queue.Enqueue(1)
+	queue.Enqueue(2)
+
+	done := make(chan struct{})
+	go func() {
+		for i := 3; i <= 5; i++ {
+			queue.Enqueue(i)
+		}
+		close(done)
+	}()
+
+	var values []int
+	for len(values) < 5 {
+		if value, ok := queue.Dequeue(); ok {
+			values = append(values, value)
+		} else {
+			time.Sleep(time.Millisecond)
+		}
+	}
+	<-done
+	if actualValue, expectedValue := values, []int{1, 2, 3, 4, 5}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// blocked producers are released by a growing buffer and by a change of the policy
+	queue.Enqueue(1)
+	queue.Enqueue(2)
+	var wg sync.WaitGroup
+	wg.Add(2)
+	for i := 3; i <= 4; i++ {
+		go func(i int) {
+			defer wg.Done()
+			queue.PushFront(i)
+		}(i)
+	}
+	time.Sleep(10 * time.Millisecond)
+	queue.Resize(3)
+	time.Sleep(10 * time.Millisecond)
+	queue.SetOverflowPolicy(Overwrite)
+	wg.Wait()
+	if actualValue := queue.Size(); actualValue != 3 {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+}
+
 func TestQueueIteratorOnEmpty(t *testing.T) {
 	queue := New[int](3)
 	it := queue.Iterator()
@@ -482,6 +717,32 @@ func TestQueueIterator(t *testing.T) {
 	}
 }
 
+func TestQueueIteratorFailFast(t *testing.T) {
+	queue := New[int](5)
+	queue.Enqueue(1)
+	queue.Enqueue(2)
+	it := queue.Iterator()
+	it.Next()
+	queue.Enqueue(3)
+	testutils.ConcurrentModificationPanic(t, func() { it.Next() })
+	testutils.ConcurrentModificationPanic(t, func() { it.Prev() })
+
+	// reset iterator is valid again
+	count := 0
+	for it.Begin(); it.Next(); {
+		count++
+	}
+	if actualValue, expectedValue := count, 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	queue.SetFailFast(false)
+	it.Begin()
+	it.Next()
+	queue.Enqueue(4)
+	it.Next() // no panic
+}
+
 func TestQueueSerialization(t *testing.T) {
 	queue := New[string](3)
 	queue.Enqueue("a")
@@ -517,6 +778,60 @@ func TestQueueSerialization(t *testing.T) {
 		t.Errorf("Got error %v", err)
 	}
 	assert()
+
+	// elements are serialized in FIFO order
+	queue.Enqueue("d")
+	bytes, err = queue.ToJSON()
+	if actualValue, expectedValue := string(bytes), `["b","c","d"]`; actualValue != expectedValue || err != nil {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestQueueSerializationOverflow(t *testing.T) {
+	for _, policy := range []OverflowPolicy{Overwrite, Reject, Block} {
+		queue := NewWith[string](3, policy)
+		var evicted []string
+		queue.SetEvictionCallback(func(value string) { evicted = append(evicted, value) })
+		queue.Enqueue("a")
+		queue.Enqueue("b")
+
+		// does not block or reject, but evicts the oldest elements
+		err := queue.FromJSON([]byte(`["c","d","e"]`))
+		if actualValue, expectedValue := queue.Values(), []string{"c", "d", "e"}; !slices.Equal(actualValue, expectedValue) || err != nil {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		if actualValue, expectedValue := evicted, []string{"a", "b"}; !slices.Equal(actualValue, expectedValue) {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		if actualValue := queue.OverflowPolicy(); actualValue != policy {
+			t.Errorf("Got %v expected %v", actualValue, policy)
+		}
+	}
+}
+
+func TestQueueIteratorConcurrent(t *testing.T) {
+	queue := New[int](10)
+	queue.SetFailFast(false)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 1000; i++ {
+			queue.Enqueue(i)
+			if i%100 == 0 {
+				queue.Resize(5 + i%10)
+			}
+		}
+	}()
+	for {
+		select {
+		case <-done:
+			return
+		default:
+		}
+		for it := queue.Iterator(); it.Next(); {
+			_ = it.Value()
+		}
+	}
 }
 
 func TestQueueString(t *testing.T) {
@@ -11,13 +11,18 @@ var _ containers.ReverseIteratorWithIndex[int] = (*Iterator[int])(nil)
 
 // Iterator returns a stateful iterator whose values can be fetched by an index.
 type Iterator[T comparable] struct {
-	queue *Queue[T]
-	index int
+	queue    *Queue[T]
+	index    int
+	modCount int // queue's modification count the iterator is in sync with
 }
 
 // Iterator returns a stateful iterator whose values can be fetched by an index.
+// Every step of the iterator locks the queue, but the iterator fails fast if the queue is modified while iterating,
+// see the package documentation.
 func (queue *Queue[T]) Iterator() *Iterator[T] {
-	return &Iterator[T]{queue: queue, index: -1}
+	queue.mutex.Lock()
+	defer queue.mutex.Unlock()
+	return &Iterator[T]{queue: queue, index: -1, modCount: queue.modCount}
 }
 
 // Next moves the iterator to the next element and returns true if there was a next element in the container.
@@ -25,6 +30,9 @@ func (queue *Queue[T]) Iterator() *Iterator[T] {
 // If Next() was called for the first time, then it will point the iterator to the first element if it exists.
 // Modifies the state of the iterator.
 func (iterator *Iterator[T]) Next() bool {
+	iterator.queue.mutex.Lock()
+	defer iterator.queue.mutex.Unlock()
+	iterator.checkModification()
 	if iterator.index < iterator.queue.size {
 		iterator.index++
 	}
@@ -35,6 +43,9 @@ func (iterator *Iterator[T]) Next() bool {
 // If Prev() returns true, then previous element's index and value can be retrieved by Index() and Value().
 // Modifies the state of the iterator.
 func (iterator *Iterator[T]) Prev() bool {
+	iterator.queue.mutex.Lock()
+	defer iterator.queue.mutex.Unlock()
+	iterator.checkModification()
 	if iterator.index >= 0 {
 		iterator.index--
 	}
@@ -44,9 +55,9 @@ func (iterator *Iterator[T]) Prev() bool {
 // Value returns the current element's value.
 // Does not modify the state of the iterator.
 func (iterator *Iterator[T]) Value() T {
-	index := (iterator.index + iterator.queue.start) % iterator.queue.maxSize
-	value := iterator.queue.values[index]
-	return value
+	iterator.queue.mutex.Lock()
+	defer iterator.queue.mutex.Unlock()
+	return iterator.queue.values[iterator.queue.index(iterator.index)]
 }
 
 // Index returns the current element's index.
@@ -58,13 +69,19 @@ func (iterator *Iterator[T]) Index() int {
 // Begin resets the iterator to its initial state (one-before-first)
 // Call Next() to fetch the first element if any.
 func (iterator *Iterator[T]) Begin() {
+	iterator.queue.mutex.Lock()
+	defer iterator.queue.mutex.Unlock()
 	iterator.index = -1
+	iterator.modCount = iterator.queue.modCount
 }
 
 // End moves the iterator past the last element (one-past-the-end).
 // Call Prev() to fetch the last element if any.
 func (iterator *Iterator[T]) End() {
+	iterator.queue.mutex.Lock()
+	defer iterator.queue.mutex.Unlock()
 	iterator.index = iterator.queue.size
+	iterator.modCount = iterator.queue.modCount
 }
 
 // First moves the iterator to the first element and returns true if there was a first element in the container.
@@ -110,3 +127,16 @@ func (iterator *Iterator[T]) PrevTo(f func(index int, value T) bool) bool {
 	}
 	return false
 }
+
+// checkModification panics if the queue was modified since the iterator moved to its current element,
+// unless fail-fast iteration is disabled for the queue.
+func (iterator *Iterator[T]) checkModification() {
+	if iterator.index == -1 {
+		// not on an element yet, nothing to invalidate
+		iterator.modCount = iterator.queue.modCount
+		return
+	}
+	if iterator.modCount != iterator.queue.modCount && !iterator.queue.failFastDisabled {
+		panic(&containers.ConcurrentModificationError{Container: "CircularBuffer"})
+	}
+}
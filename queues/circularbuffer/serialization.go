@@ -16,17 +16,17 @@ var _ containers.JSONDeserializer = (*Queue[int])(nil)
 
 // ToJSON outputs the JSON representation of queue's elements.
 func (queue *Queue[T]) ToJSON() ([]byte, error) {
-	return json.Marshal(queue.values[:queue.maxSize])
+	return json.Marshal(queue.Values())
 }
 
 // FromJSON populates list's elements from the input JSON representation.
+// The elements are added to the end of the queue like with the Overwrite policy, whatever the policy of the queue,
+// i.e. the oldest elements are evicted if they do not fit, instead of blocking or rejecting them.
 func (queue *Queue[T]) FromJSON(data []byte) error {
 	var values []T
 	err := json.Unmarshal(data, &values)
 	if err == nil {
-		for _, value := range values {
-			queue.Enqueue(value)
-		}
+		queue.fill(values)
 	}
 	return err
 }
@@ -0,0 +1,140 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package delayqueue
+
+import (
+	"sync"
+	"time"
+)
+
+// Clock provides the current time and timers to delay queues and timer wheels.
+// SystemClock is used by default, a ManualClock makes tests deterministic.
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+
+	// NewTimer returns a timer that sends the current time on its channel once the duration has elapsed.
+	NewTimer(d time.Duration) Timer
+}
+
+// Timer is a single event created by a Clock, like time.Timer.
+type Timer interface {
+	// C returns the channel on which the time is delivered.
+	C() <-chan time.Time
+
+	// Stop prevents the timer from firing. Returns false if the timer already fired or was stopped.
+	Stop() bool
+}
+
+// SystemClock is the clock of the system, based on time.Now and time.NewTimer.
+var SystemClock Clock = systemClock{}
+
+type systemClock struct{}
+
+type systemTimer struct {
+	timer *time.Timer
+}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+func (systemClock) NewTimer(d time.Duration) Timer {
+	return systemTimer{timer: time.NewTimer(d)}
+}
+
+func (timer systemTimer) C() <-chan time.Time {
+	return timer.timer.C
+}
+
+func (timer systemTimer) Stop() bool {
+	return timer.timer.Stop()
+}
+
+// ManualClock is a clock whose time only changes when it is set or advanced, e.g. in tests.
+// Its timers fire when the time is moved to or past their deadline.
+// It is safe for concurrent use.
+type ManualClock struct {
+	mutex  sync.Mutex
+	now    time.Time
+	timers map[*manualTimer]struct{}
+}
+
+type manualTimer struct {
+	clock    *ManualClock
+	deadline time.Time
+	c        chan time.Time
+}
+
+// NewManualClock instantiates a new manual clock set to the time.
+func NewManualClock(now time.Time) *ManualClock {
+	return &ManualClock{now: now, timers: map[*manualTimer]struct{}{}}
+}
+
+// Now returns the current time of the clock.
+func (clock *ManualClock) Now() time.Time {
+	clock.mutex.Lock()
+	defer clock.mutex.Unlock()
+	return clock.now
+}
+
+// NewTimer returns a timer that fires when the clock is advanced by the duration, or immediately if the duration is
+// not positive.
+func (clock *ManualClock) NewTimer(d time.Duration) Timer {
+	clock.mutex.Lock()
+	defer clock.mutex.Unlock()
+	timer := &manualTimer{clock: clock, deadline: clock.now.Add(d), c: make(chan time.Time, 1)}
+	if d <= 0 {
+		timer.c <- clock.now
+	} else {
+		clock.timers[timer] = struct{}{}
+	}
+	return timer
+}
+
+// Advance moves the time of the clock forward by the duration and fires the timers that are due.
+func (clock *ManualClock) Advance(d time.Duration) {
+	clock.mutex.Lock()
+	defer clock.mutex.Unlock()
+	clock.set(clock.now.Add(d))
+}
+
+// Set moves the time of the clock to the time and fires the timers that are due.
+// The time may also be moved backwards.
+func (clock *ManualClock) Set(now time.Time) {
+	clock.mutex.Lock()
+	defer clock.mutex.Unlock()
+	clock.set(now)
+}
+
+// Timers returns the number of timers that did not fire yet and were not stopped, e.g. to wait in tests until a
+// goroutine is blocked on a timer.
+func (clock *ManualClock) Timers() int {
+	clock.mutex.Lock()
+	defer clock.mutex.Unlock()
+	return len(clock.timers)
+}
+
+func (clock *ManualClock) set(now time.Time) {
+	clock.now = now
+	for timer := range clock.timers {
+		if !timer.deadline.After(now) {
+			timer.c <- now
+			delete(clock.timers, timer)
+		}
+	}
+}
+
+func (timer *manualTimer) C() <-chan time.Time {
+	return timer.c
+}
+
+func (timer *manualTimer) Stop() bool {
+	timer.clock.mutex.Lock()
+	defer timer.clock.mutex.Unlock()
+	_, pending := timer.clock.timers[timer]
+	delete(timer.clock.timers, timer)
+	return pending
+}
@@ -0,0 +1,265 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package delayqueue implements a delay queue and a hierarchical timer wheel, queues whose elements become available
+// only once their deadline has passed.
+//
+// Elements are scheduled with a deadline and are taken in deadline order, elements with the same deadline in the order
+// they were scheduled. Poll returns an available element without waiting, Take blocks until an element is available
+// or the context is done. Scheduled elements can be cancelled through their handles.
+//
+// Queue keeps the elements in a pairing heap, so scheduling and cancelling cost O(log n) amortized time and elements
+// become available exactly at their deadlines. TimerWheel keeps the elements in a hierarchical timer wheel, so
+// scheduling and cancelling cost O(1) and elements become available at the granularity of its tick, which suits
+// millions of short timers.
+//
+// Time is taken from a Clock, which can be replaced, e.g. by a ManualClock in tests.
+//
+// Structures are thread safe.
+//
+// References: https://en.wikipedia.org/wiki/Timing_wheel
+package delayqueue
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/emirpasic/gods/v2/containers"
+	"github.com/emirpasic/gods/v2/trees/pairingheap"
+)
+
+// Assert Container implementation
+var _ containers.Container[int] = (*Queue[int])(nil)
+
+// Queue holds elements in a heap ordered by their deadlines
+type Queue[T comparable] struct {
+	mutex   sync.Mutex
+	heap    *pairingheap.Heap[*Handle[T]]
+	seq     uint64 // sequence number of the last scheduled element
+	changed signal // notifies waiting Take calls of a new earliest element
+	clock   Clock
+}
+
+// Handle identifies a scheduled element of a Queue or TimerWheel, see Cancel.
+type Handle[T comparable] struct {
+	value    T
+	deadline time.Time
+	seq      uint64
+
+	node *pairingheap.Node[*Handle[T]] // node in the heap of a queue
+
+	wheel      *TimerWheel[T] // timer wheel the element was scheduled on
+	expiry     uint64         // tick at which the element becomes available in a timer wheel
+	slot       *slot[T]       // list the element is in within a timer wheel, nil once taken or cancelled
+	prev, next *Handle[T]     // neighbours in the list of a timer wheel
+}
+
+// New instantiates a new empty delay queue using the system clock.
+func New[T comparable]() *Queue[T] {
+	return NewWithClock[T](SystemClock)
+}
+
+// NewWithClock instantiates a new empty delay queue using the clock.
+func NewWithClock[T comparable](clock Clock) *Queue[T] {
+	return &Queue[T]{heap: pairingheap.NewWith(compareHandles[T]), clock: clock}
+}
+
+// Value returns the scheduled element.
+func (handle *Handle[T]) Value() T {
+	return handle.value
+}
+
+// Deadline returns the time from which on the element is available.
+func (handle *Handle[T]) Deadline() time.Time {
+	return handle.deadline
+}
+
+// Schedule adds the value to the queue, available from the deadline on, and returns its handle.
+func (queue *Queue[T]) Schedule(value T, deadline time.Time) *Handle[T] {
+	queue.mutex.Lock()
+	defer queue.mutex.Unlock()
+	queue.seq++
+	handle := &Handle[T]{value: value, deadline: deadline, seq: queue.seq}
+	handle.node = queue.heap.Insert(handle)
+	if queue.heap.PeekNode() == handle.node {
+		queue.changed.notify()
+	}
+	return handle
+}
+
+// ScheduleAfter adds the value to the queue, available once the delay has elapsed, and returns its handle.
+func (queue *Queue[T]) ScheduleAfter(value T, delay time.Duration) *Handle[T] {
+	return queue.Schedule(value, queue.clock.Now().Add(delay))
+}
+
+// Cancel removes the element of the handle from the queue.
+// Returns false if the element is not in the queue, e.g. because it was taken already.
+func (queue *Queue[T]) Cancel(handle *Handle[T]) bool {
+	queue.mutex.Lock()
+	defer queue.mutex.Unlock()
+	return handle != nil && queue.heap.Delete(handle.node)
+}
+
+// Poll removes the element with the earliest deadline from the queue and returns it, if its deadline has passed.
+// Second return parameter is true, unless no element was available.
+func (queue *Queue[T]) Poll() (value T, ok bool) {
+	queue.mutex.Lock()
+	defer queue.mutex.Unlock()
+	handle, _ := queue.poll(queue.clock.Now())
+	if handle == nil {
+		return value, false
+	}
+	return handle.value, true
+}
+
+// Take removes the element with the earliest deadline from the queue and returns it, waiting until its deadline has
+// passed. Returns the error of the context if it is done before an element becomes available.
+func (queue *Queue[T]) Take(ctx context.Context) (value T, err error) {
+	queue.mutex.Lock()
+	for {
+		now := queue.clock.Now()
+		handle, delay := queue.poll(now)
+		if handle != nil {
+			queue.mutex.Unlock()
+			return handle.value, nil
+		}
+		if err := queue.changed.wait(ctx, &queue.mutex, queue.clock, now, delay); err != nil {
+			return value, err
+		}
+	}
+}
+
+// Peek returns the element with the earliest deadline and its deadline without removing it, whether the deadline
+// has passed or not. Third return parameter is true, unless the queue was empty and there was nothing to peek.
+func (queue *Queue[T]) Peek() (value T, deadline time.Time, ok bool) {
+	queue.mutex.Lock()
+	defer queue.mutex.Unlock()
+	handle, ok := queue.heap.Peek()
+	if !ok {
+		return value, deadline, false
+	}
+	return handle.value, handle.deadline, true
+}
+
+// Empty returns true if queue does not contain any elements.
+func (queue *Queue[T]) Empty() bool {
+	return queue.Size() == 0
+}
+
+// Size returns number of elements within the queue, whether their deadlines have passed or not.
+func (queue *Queue[T]) Size() int {
+	queue.mutex.Lock()
+	defer queue.mutex.Unlock()
+	return queue.heap.Size()
+}
+
+// Clear removes all elements from the queue.
+func (queue *Queue[T]) Clear() {
+	queue.mutex.Lock()
+	defer queue.mutex.Unlock()
+	queue.heap.Clear()
+}
+
+// Values returns all elements in the queue in deadline order, whether their deadlines have passed or not.
+func (queue *Queue[T]) Values() []T {
+	queue.mutex.Lock()
+	defer queue.mutex.Unlock()
+	return sortedValues(queue.heap.Values())
+}
+
+// String returns a string representation of container
+func (queue *Queue[T]) String() string {
+	str := "DelayQueue\n"
+	values := []string{}
+	for _, value := range queue.Values() {
+		values = append(values, fmt.Sprintf("%v", value))
+	}
+	str += strings.Join(values, ", ")
+	return str
+}
+
+// poll removes and returns the element with the earliest deadline if it has passed, or returns how long to wait for
+// it otherwise, a negative duration if the queue is empty.
+func (queue *Queue[T]) poll(now time.Time) (*Handle[T], time.Duration) {
+	handle, ok := queue.heap.Peek()
+	switch {
+	case !ok:
+		return nil, -1
+	case handle.deadline.After(now):
+		return nil, handle.deadline.Sub(now)
+	}
+	queue.heap.Pop()
+	return handle, 0
+}
+
+// signal wakes up goroutines waiting for a change of a queue.
+type signal struct {
+	c chan struct{} // closed on a change, nil if no one waits
+}
+
+// wait releases the lock and waits for the duration since now, or only for a change if the duration is negative, and
+// reacquires the lock unless the context is done.
+func (signal *signal) wait(ctx context.Context, mutex *sync.Mutex, clock Clock, now time.Time, d time.Duration) error {
+	if signal.c == nil {
+		signal.c = make(chan struct{})
+	}
+	changed := signal.c
+	var timer Timer
+	var expired <-chan time.Time
+	if d >= 0 {
+		timer = clock.NewTimer(d)
+		if clock.Now().Sub(now) >= d {
+			// the clock moved on before the timer was created
+			timer.Stop()
+			return nil
+		}
+		expired = timer.C()
+	}
+	mutex.Unlock()
+	var err error
+	select {
+	case <-ctx.Done():
+		err = ctx.Err()
+	case <-expired:
+	case <-changed:
+	}
+	if timer != nil {
+		timer.Stop()
+	}
+	if err == nil {
+		mutex.Lock()
+	}
+	return err
+}
+
+// notify wakes up the waiting goroutines.
+func (signal *signal) notify() {
+	if signal.c != nil {
+		close(signal.c)
+		signal.c = nil
+	}
+}
+
+// compareHandles orders handles by deadline and then by scheduling order.
+func compareHandles[T comparable](a, b *Handle[T]) int {
+	if result := a.deadline.Compare(b.deadline); result != 0 {
+		return result
+	}
+	return cmp.Compare(a.seq, b.seq)
+}
+
+// sortedValues returns the values of the handles in deadline order.
+func sortedValues[T comparable](handles []*Handle[T]) []T {
+	slices.SortFunc(handles, compareHandles[T])
+	values := make([]T, len(handles))
+	for i, handle := range handles {
+		values[i] = handle.value
+	}
+	return values
+}
@@ -0,0 +1,429 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package delayqueue
+
+import (
+	"context"
+	"math/rand"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+var epoch = time.Date(2015, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// delayQueue is the API common to Queue and TimerWheel
+type delayQueue interface {
+	Schedule(value int, deadline time.Time) *Handle[int]
+	ScheduleAfter(value int, delay time.Duration) *Handle[int]
+	Cancel(handle *Handle[int]) bool
+	Poll() (int, bool)
+	Take(ctx context.Context) (int, error)
+	Empty() bool
+	Size() int
+	Clear()
+	Values() []int
+	String() string
+}
+
+func forEachQueue(t *testing.T, f func(t *testing.T, queue delayQueue, clock *ManualClock)) {
+	t.Run("Queue", func(t *testing.T) {
+		clock := NewManualClock(epoch)
+		f(t, NewWithClock[int](clock), clock)
+	})
+	t.Run("TimerWheel", func(t *testing.T) {
+		clock := NewManualClock(epoch)
+		f(t, NewTimerWheelWithClock[int](time.Millisecond, clock), clock)
+	})
+}
+
+// waitForTimers waits until the number of pending timers of the clock is n, i.e. a Take call is blocked.
+func waitForTimers(t *testing.T, clock *ManualClock, n int) {
+	for i := 0; clock.Timers() != n; i++ {
+		if i == 1000 {
+			t.Fatalf("Got %v timers expected %v", clock.Timers(), n)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestDelayQueuePoll(t *testing.T) {
+	forEachQueue(t, func(t *testing.T, queue delayQueue, clock *ManualClock) {
+		if actualValue, ok := queue.Poll(); actualValue != 0 || ok {
+			t.Errorf("Got %v expected %v", actualValue, nil)
+		}
+
+		queue.ScheduleAfter(3, 3*time.Millisecond)
+		queue.ScheduleAfter(1, time.Millisecond)
+		queue.ScheduleAfter(2, 2*time.Millisecond)
+		queue.ScheduleAfter(0, 0)
+
+		if actualValue := queue.Size(); actualValue != 4 {
+			t.Errorf("Got %v expected %v", actualValue, 4)
+		}
+		if actualValue, expectedValue := queue.Values(), []int{0, 1, 2, 3}; !slices.Equal(actualValue, expectedValue) {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		if actualValue, ok := queue.Poll(); actualValue != 0 || !ok {
+			t.Errorf("Got %v expected %v", actualValue, 0)
+		}
+		if actualValue, ok := queue.Poll(); actualValue != 0 || ok {
+			t.Errorf("Got %v expected %v", actualValue, nil)
+		}
+
+		clock.Advance(2 * time.Millisecond)
+		for _, expectedValue := range []int{1, 2} {
+			if actualValue, ok := queue.Poll(); actualValue != expectedValue || !ok {
+				t.Errorf("Got %v expected %v", actualValue, expectedValue)
+			}
+		}
+		if actualValue, ok := queue.Poll(); actualValue != 0 || ok {
+			t.Errorf("Got %v expected %v", actualValue, nil)
+		}
+
+		clock.Advance(time.Hour)
+		if actualValue, ok := queue.Poll(); actualValue != 3 || !ok {
+			t.Errorf("Got %v expected %v", actualValue, 3)
+		}
+		if actualValue := queue.Empty(); actualValue != true {
+			t.Errorf("Got %v expected %v", actualValue, true)
+		}
+	})
+}
+
+func TestDelayQueueOrder(t *testing.T) {
+	forEachQueue(t, func(t *testing.T, queue delayQueue, clock *ManualClock) {
+		deadline := epoch.Add(5 * time.Millisecond)
+		for i := 1; i <= 5; i++ {
+			queue.Schedule(i, deadline)
+		}
+		queue.Schedule(0, deadline.Add(-time.Microsecond))
+
+		clock.Set(deadline)
+		for _, expectedValue := range []int{0, 1, 2, 3, 4, 5} {
+			if actualValue, ok := queue.Poll(); actualValue != expectedValue || !ok {
+				t.Errorf("Got %v expected %v", actualValue, expectedValue)
+			}
+		}
+	})
+}
+
+func TestDelayQueueCancel(t *testing.T) {
+	forEachQueue(t, func(t *testing.T, queue delayQueue, clock *ManualClock) {
+		a := queue.ScheduleAfter(1, time.Millisecond)
+		b := queue.ScheduleAfter(2, time.Second)
+		c := queue.ScheduleAfter(3, time.Millisecond)
+
+		if actualValue := queue.Cancel(b); actualValue != true {
+			t.Errorf("Got %v expected %v", actualValue, true)
+		}
+		if actualValue := queue.Cancel(b); actualValue != false {
+			t.Errorf("Got %v expected %v", actualValue, false)
+		}
+		if actualValue := queue.Cancel(nil); actualValue != false {
+			t.Errorf("Got %v expected %v", actualValue, false)
+		}
+		if actualValue := queue.Size(); actualValue != 2 {
+			t.Errorf("Got %v expected %v", actualValue, 2)
+		}
+
+		clock.Advance(time.Millisecond)
+		if actualValue, ok := queue.Poll(); actualValue != 1 || !ok {
+			t.Errorf("Got %v expected %v", actualValue, 1)
+		}
+		if actualValue := queue.Cancel(a); actualValue != false {
+			t.Errorf("Got %v expected %v", actualValue, false)
+		}
+		// cancel an available element
+		if actualValue := queue.Cancel(c); actualValue != true {
+			t.Errorf("Got %v expected %v", actualValue, true)
+		}
+		if actualValue := queue.Empty(); actualValue != true {
+			t.Errorf("Got %v expected %v", actualValue, true)
+		}
+
+		clock.Advance(time.Hour)
+		if actualValue, ok := queue.Poll(); actualValue != 0 || ok {
+			t.Errorf("Got %v expected %v", actualValue, nil)
+		}
+	})
+}
+
+func TestDelayQueueCancelOther(t *testing.T) {
+	queue := New[int]()
+	wheel := NewTimerWheel[int](time.Millisecond)
+	handle := queue.ScheduleAfter(1, time.Hour)
+	other := wheel.ScheduleAfter(1, time.Hour)
+
+	if actualValue := wheel.Cancel(handle); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if actualValue := queue.Cancel(other); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if actualValue := NewTimerWheel[int](time.Millisecond).Cancel(other); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if actualValue := New[int]().Cancel(handle); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if actualValue, expectedValue := queue.Size()+wheel.Size(), 2; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestDelayQueueTake(t *testing.T) {
+	forEachQueue(t, func(t *testing.T, queue delayQueue, clock *ManualClock) {
+		queue.ScheduleAfter(1, 0)
+		if actualValue, err := queue.Take(context.Background()); actualValue != 1 || err != nil {
+			t.Errorf("Got %v expected %v", actualValue, 1)
+		}
+
+		taken := make(chan int)
+		go func() {
+			value, err := queue.Take(context.Background())
+			if err != nil {
+				t.Errorf("Got error %v", err)
+			}
+			taken <- value
+		}()
+
+		// waits for an element
+		time.Sleep(time.Millisecond)
+		queue.ScheduleAfter(2, 10*time.Millisecond)
+		// waits for the deadline
+		waitForTimers(t, clock, 1)
+		// an earlier element wakes the waiting goroutine up
+		queue.ScheduleAfter(3, 5*time.Millisecond)
+
+		clock.Advance(4 * time.Millisecond)
+		select {
+		case value := <-taken:
+			t.Errorf("Got %v expected nothing", value)
+		case <-time.After(10 * time.Millisecond):
+		}
+
+		clock.Advance(time.Millisecond)
+		if actualValue := <-taken; actualValue != 3 {
+			t.Errorf("Got %v expected %v", actualValue, 3)
+		}
+		if actualValue := queue.Values(); !slices.Equal(actualValue, []int{2}) {
+			t.Errorf("Got %v expected %v", actualValue, []int{2})
+		}
+	})
+}
+
+func TestDelayQueueTakeContext(t *testing.T) {
+	forEachQueue(t, func(t *testing.T, queue delayQueue, clock *ManualClock) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		if actualValue, err := queue.Take(ctx); actualValue != 0 || err != context.Canceled {
+			t.Errorf("Got %v expected %v", err, context.Canceled)
+		}
+
+		queue.ScheduleAfter(1, time.Hour)
+		ctx, cancel = context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		if actualValue, err := queue.Take(ctx); actualValue != 0 || err != context.DeadlineExceeded {
+			t.Errorf("Got %v expected %v", err, context.DeadlineExceeded)
+		}
+		if actualValue := clock.Timers(); actualValue != 0 {
+			t.Errorf("Got %v expected %v", actualValue, 0)
+		}
+
+		// the queue is usable after a cancelled Take
+		clock.Advance(time.Hour)
+		if actualValue, err := queue.Take(context.Background()); actualValue != 1 || err != nil {
+			t.Errorf("Got %v expected %v", actualValue, 1)
+		}
+	})
+}
+
+func TestDelayQueueClear(t *testing.T) {
+	forEachQueue(t, func(t *testing.T, queue delayQueue, clock *ManualClock) {
+		handle := queue.ScheduleAfter(1, time.Millisecond)
+		queue.ScheduleAfter(2, time.Hour)
+		queue.Clear()
+
+		if actualValue := queue.Empty(); actualValue != true {
+			t.Errorf("Got %v expected %v", actualValue, true)
+		}
+		if actualValue := queue.Cancel(handle); actualValue != false {
+			t.Errorf("Got %v expected %v", actualValue, false)
+		}
+
+		clock.Advance(time.Hour)
+		if actualValue, ok := queue.Poll(); actualValue != 0 || ok {
+			t.Errorf("Got %v expected %v", actualValue, nil)
+		}
+	})
+}
+
+func TestDelayQueuePeek(t *testing.T) {
+	clock := NewManualClock(epoch)
+	queue := NewWithClock[string](clock)
+
+	if actualValue, _, ok := queue.Peek(); actualValue != "" || ok {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+
+	deadline := epoch.Add(time.Minute)
+	handle := queue.Schedule("a", deadline)
+	if actualValue, actualDeadline, ok := queue.Peek(); actualValue != "a" || !actualDeadline.Equal(deadline) || !ok {
+		t.Errorf("Got %v %v expected %v %v", actualValue, actualDeadline, "a", deadline)
+	}
+	if actualValue := handle.Value(); actualValue != "a" {
+		t.Errorf("Got %v expected %v", actualValue, "a")
+	}
+	if actualValue := handle.Deadline(); !actualValue.Equal(deadline) {
+		t.Errorf("Got %v expected %v", actualValue, deadline)
+	}
+}
+
+func TestTimerWheelTick(t *testing.T) {
+	clock := NewManualClock(epoch)
+	wheel := NewTimerWheelWithClock[int](10*time.Millisecond, clock)
+
+	if actualValue := wheel.Tick(); actualValue != 10*time.Millisecond {
+		t.Errorf("Got %v expected %v", actualValue, 10*time.Millisecond)
+	}
+
+	// available at the first tick at or after the deadline
+	wheel.ScheduleAfter(1, 15*time.Millisecond)
+	clock.Advance(15 * time.Millisecond)
+	if actualValue, ok := wheel.Poll(); actualValue != 0 || ok {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+	clock.Advance(5 * time.Millisecond)
+	if actualValue, ok := wheel.Poll(); actualValue != 1 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("Got no panic expected a panic for a non-positive tick")
+		}
+	}()
+	NewTimerWheel[int](0)
+}
+
+func TestTimerWheelCascade(t *testing.T) {
+	clock := NewManualClock(epoch)
+	wheel := NewTimerWheelWithClock[int](time.Millisecond, clock)
+
+	delays := []time.Duration{
+		1, 63, 64, 65, 4095, 4096, 4097, 262143, 262144, 300000, 1 << 30, 1 << 40,
+	}
+	for i, delay := range delays {
+		wheel.ScheduleAfter(i, delay*time.Millisecond)
+	}
+
+	var elapsed time.Duration
+	for i, delay := range delays {
+		// not available a tick early
+		clock.Advance((delay-1)*time.Millisecond - elapsed)
+		if actualValue, ok := wheel.Poll(); ok {
+			t.Errorf("Got %v expected %v", actualValue, nil)
+		}
+		clock.Advance(time.Millisecond)
+		elapsed = delay * time.Millisecond
+		if actualValue, ok := wheel.Poll(); actualValue != i || !ok {
+			t.Errorf("Got %v expected %v", actualValue, i)
+		}
+	}
+	if actualValue := wheel.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+}
+
+func TestTimerWheelRandom(t *testing.T) {
+	clock := NewManualClock(epoch)
+	wheel := NewTimerWheelWithClock[int](time.Millisecond, clock)
+	deadlines := map[*Handle[int]]time.Time{}
+
+	rand.Seed(3)
+	for i := 0; i < 10000; i++ {
+		switch r := rand.Intn(10); {
+		case r < 5:
+			delay := time.Duration(rand.Intn(1 << (rand.Intn(5) * 4)))
+			handle := wheel.ScheduleAfter(i, delay*time.Millisecond)
+			deadlines[handle] = handle.Deadline()
+		case r < 6:
+			for handle := range deadlines {
+				if actualValue := wheel.Cancel(handle); actualValue != true {
+					t.Fatalf("Got %v expected %v", actualValue, true)
+				}
+				delete(deadlines, handle)
+				break
+			}
+		default:
+			clock.Advance(time.Duration(rand.Intn(100)) * time.Millisecond)
+			now := clock.Now()
+			for {
+				value, ok := wheel.Poll()
+				if !ok {
+					break
+				}
+				var found bool
+				for handle, deadline := range deadlines {
+					if handle.Value() == value {
+						if deadline.After(now) {
+							t.Fatalf("Got %v before its deadline %v at %v", value, deadline, now)
+						}
+						delete(deadlines, handle)
+						found = true
+					}
+				}
+				if !found {
+					t.Fatalf("Got %v which was not scheduled", value)
+				}
+			}
+			for handle, deadline := range deadlines {
+				if !deadline.After(now) {
+					t.Fatalf("Got nothing expected %v with deadline %v at %v", handle.Value(), deadline, now)
+				}
+			}
+		}
+		if actualValue, expectedValue := wheel.Size(), len(deadlines); actualValue != expectedValue {
+			t.Fatalf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+}
+
+func TestDelayQueueString(t *testing.T) {
+	queue := New[int]()
+	queue.ScheduleAfter(1, 0)
+	if !strings.HasPrefix(queue.String(), "DelayQueue") {
+		t.Errorf("String should start with container name")
+	}
+	wheel := NewTimerWheel[int](time.Millisecond)
+	wheel.ScheduleAfter(1, 0)
+	if !strings.HasPrefix(wheel.String(), "TimerWheel") {
+		t.Errorf("String should start with container name")
+	}
+}
+
+func benchmarkScheduleTake(b *testing.B, queue delayQueue, clock *ManualClock, size int) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			queue.ScheduleAfter(n, time.Duration(n%1000)*time.Millisecond)
+		}
+		clock.Advance(time.Second)
+		for n := 0; n < size; n++ {
+			queue.Take(context.Background())
+		}
+	}
+}
+
+func BenchmarkDelayQueueScheduleTake100000(b *testing.B) {
+	clock := NewManualClock(epoch)
+	benchmarkScheduleTake(b, NewWithClock[int](clock), clock, 100000)
+}
+
+func BenchmarkTimerWheelScheduleTake100000(b *testing.B) {
+	clock := NewManualClock(epoch)
+	benchmarkScheduleTake(b, NewTimerWheelWithClock[int](time.Millisecond, clock), clock, 100000)
+}
@@ -0,0 +1,364 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package delayqueue
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/emirpasic/gods/v2/containers"
+)
+
+// Assert Container implementation
+var _ containers.Container[int] = (*TimerWheel[int])(nil)
+
+const (
+	wheelBits   = 6
+	wheelSize   = 1 << wheelBits // number of slots per level
+	wheelMask   = wheelSize - 1
+	wheelLevels = (64 + wheelBits - 1) / wheelBits // levels to cover any number of ticks
+)
+
+// TimerWheel holds elements in a hierarchical timer wheel
+type TimerWheel[T comparable] struct {
+	mutex   sync.Mutex
+	tick    time.Duration
+	origin  time.Time                       // start of tick zero
+	next    uint64                          // next tick to process, all earlier ticks were processed
+	levels  [wheelLevels][wheelSize]slot[T] // slots of level i span wheelSize^i ticks each
+	ready   slot[T]                         // elements whose deadlines have passed, in deadline order
+	size    int                             // number of elements in the slots and the ready list
+	waiting int                             // number of elements in the slots
+	seq     uint64                          // sequence number of the last scheduled element
+	changed signal                          // notifies waiting Take calls of a scheduled element
+	clock   Clock
+}
+
+// slot is a doubly-linked list of handles
+type slot[T comparable] struct {
+	head, tail *Handle[T]
+}
+
+// NewTimerWheel instantiates a new empty timer wheel with the tick using the system clock.
+// Elements become available at the first tick at or after their deadlines. Panics if the tick is not positive.
+func NewTimerWheel[T comparable](tick time.Duration) *TimerWheel[T] {
+	return NewTimerWheelWithClock[T](tick, SystemClock)
+}
+
+// NewTimerWheelWithClock instantiates a new empty timer wheel with the tick using the clock, see NewTimerWheel.
+func NewTimerWheelWithClock[T comparable](tick time.Duration, clock Clock) *TimerWheel[T] {
+	if tick <= 0 {
+		panic(fmt.Sprintf("delayqueue: tick %v is not positive", tick))
+	}
+	return &TimerWheel[T]{tick: tick, origin: clock.Now(), next: 1, clock: clock}
+}
+
+// Schedule adds the value to the wheel, available from the first tick at or after the deadline on, and returns its
+// handle.
+func (wheel *TimerWheel[T]) Schedule(value T, deadline time.Time) *Handle[T] {
+	wheel.mutex.Lock()
+	defer wheel.mutex.Unlock()
+	wheel.seq++
+	handle := &Handle[T]{value: value, deadline: deadline, seq: wheel.seq, wheel: wheel, expiry: wheel.tickOf(deadline)}
+	wheel.add(handle)
+	wheel.size++
+	wheel.changed.notify()
+	return handle
+}
+
+// ScheduleAfter adds the value to the wheel, available once the delay has elapsed, and returns its handle.
+func (wheel *TimerWheel[T]) ScheduleAfter(value T, delay time.Duration) *Handle[T] {
+	return wheel.Schedule(value, wheel.clock.Now().Add(delay))
+}
+
+// Cancel removes the element of the handle from the wheel in O(1).
+// Returns false if the element is not in the wheel, e.g. because it was taken already.
+func (wheel *TimerWheel[T]) Cancel(handle *Handle[T]) bool {
+	wheel.mutex.Lock()
+	defer wheel.mutex.Unlock()
+	if handle == nil || handle.wheel != wheel || handle.slot == nil {
+		return false
+	}
+	if handle.slot != &wheel.ready {
+		wheel.waiting--
+	}
+	handle.slot.remove(handle)
+	wheel.size--
+	return true
+}
+
+// Poll removes an element whose deadline has passed from the wheel and returns it, the element with the earliest
+// deadline of the elements that became available at the same tick. Second return parameter is true, unless no element
+// was available.
+func (wheel *TimerWheel[T]) Poll() (value T, ok bool) {
+	wheel.mutex.Lock()
+	defer wheel.mutex.Unlock()
+	handle, _ := wheel.poll(wheel.clock.Now())
+	if handle == nil {
+		return value, false
+	}
+	return handle.value, true
+}
+
+// Take removes an element whose deadline has passed from the wheel and returns it, see Poll, waiting until an element
+// becomes available. Returns the error of the context if it is done before an element becomes available.
+func (wheel *TimerWheel[T]) Take(ctx context.Context) (value T, err error) {
+	wheel.mutex.Lock()
+	for {
+		now := wheel.clock.Now()
+		handle, delay := wheel.poll(now)
+		if handle != nil {
+			wheel.mutex.Unlock()
+			return handle.value, nil
+		}
+		if err := wheel.changed.wait(ctx, &wheel.mutex, wheel.clock, now, delay); err != nil {
+			return value, err
+		}
+	}
+}
+
+// Tick returns the granularity of the wheel.
+func (wheel *TimerWheel[T]) Tick() time.Duration {
+	return wheel.tick
+}
+
+// Empty returns true if wheel does not contain any elements.
+func (wheel *TimerWheel[T]) Empty() bool {
+	return wheel.Size() == 0
+}
+
+// Size returns number of elements within the wheel, whether their deadlines have passed or not.
+func (wheel *TimerWheel[T]) Size() int {
+	wheel.mutex.Lock()
+	defer wheel.mutex.Unlock()
+	return wheel.size
+}
+
+// Clear removes all elements from the wheel.
+func (wheel *TimerWheel[T]) Clear() {
+	wheel.mutex.Lock()
+	defer wheel.mutex.Unlock()
+	wheel.each(func(handle *Handle[T]) {
+		handle.slot, handle.prev, handle.next = nil, nil, nil
+	})
+	wheel.levels = [wheelLevels][wheelSize]slot[T]{}
+	wheel.ready = slot[T]{}
+	wheel.size = 0
+	wheel.waiting = 0
+}
+
+// Values returns all elements in the wheel in deadline order, whether their deadlines have passed or not.
+func (wheel *TimerWheel[T]) Values() []T {
+	wheel.mutex.Lock()
+	defer wheel.mutex.Unlock()
+	handles := make([]*Handle[T], 0, wheel.size)
+	wheel.each(func(handle *Handle[T]) {
+		handles = append(handles, handle)
+	})
+	return sortedValues(handles)
+}
+
+// String returns a string representation of container
+func (wheel *TimerWheel[T]) String() string {
+	str := "TimerWheel\n"
+	values := []string{}
+	for _, value := range wheel.Values() {
+		values = append(values, fmt.Sprintf("%v", value))
+	}
+	str += strings.Join(values, ", ")
+	return str
+}
+
+// tickOf returns the first tick at or after the time.
+func (wheel *TimerWheel[T]) tickOf(t time.Time) uint64 {
+	d := t.Sub(wheel.origin)
+	if d <= 0 {
+		return 0
+	}
+	return uint64((d + wheel.tick - 1) / wheel.tick)
+}
+
+// currentTick returns the last tick at or before the time.
+func (wheel *TimerWheel[T]) currentTick(t time.Time) uint64 {
+	d := t.Sub(wheel.origin)
+	if d <= 0 {
+		return 0
+	}
+	return uint64(d / wheel.tick)
+}
+
+// timeOf returns the start of the tick.
+func (wheel *TimerWheel[T]) timeOf(tick uint64) time.Time {
+	if tick > math.MaxInt64/uint64(wheel.tick) {
+		return wheel.origin.Add(math.MaxInt64)
+	}
+	return wheel.origin.Add(time.Duration(tick) * wheel.tick)
+}
+
+// poll processes the ticks up to now and removes and returns the first available element, or returns how long to wait
+// until the next tick that may make an element available otherwise, a negative duration if the wheel is empty.
+func (wheel *TimerWheel[T]) poll(now time.Time) (*Handle[T], time.Duration) {
+	wheel.advance(now)
+	if handle := wheel.ready.head; handle != nil {
+		wheel.ready.remove(handle)
+		wheel.size--
+		return handle, 0
+	}
+	if wheel.size == 0 {
+		return nil, -1
+	}
+	tick, _ := wheel.nextTick()
+	return nil, wheel.timeOf(tick).Sub(now)
+}
+
+// advance processes all ticks up to now, moving the elements that became available to the ready list.
+func (wheel *TimerWheel[T]) advance(now time.Time) {
+	current := wheel.currentTick(now)
+	for ; wheel.next <= current; wheel.next++ {
+		// skip the ticks at which nothing happens
+		tick, ok := wheel.nextTick()
+		if !ok || tick > current {
+			wheel.next = current + 1
+			return
+		}
+		wheel.next = tick
+		for level := 1; level < wheelLevels && wheel.next>>(wheelBits*(level-1))&wheelMask == 0; level++ {
+			wheel.cascade(level, wheel.next>>(wheelBits*level)&wheelMask)
+		}
+		s := &wheel.levels[0][wheel.next&wheelMask]
+		for handle := s.head; handle != nil; {
+			next := handle.next
+			s.remove(handle)
+			wheel.waiting--
+			wheel.ready.insertSorted(handle)
+			handle = next
+		}
+	}
+}
+
+// nextTick returns the first tick from the next tick to process on at which a slot with elements is processed.
+// Second return parameter is false if all slots are empty.
+func (wheel *TimerWheel[T]) nextTick() (tick uint64, ok bool) {
+	if wheel.waiting == 0 {
+		return 0, false
+	}
+	for t := wheel.next; t < wheel.next+wheelSize; t++ {
+		if wheel.levels[0][t&wheelMask].head != nil {
+			tick, ok = t, true
+			break
+		}
+	}
+	for level := 1; level < wheelLevels; level++ {
+		shift := uint(wheelBits * level)
+		// slots of the level are cascaded at the first tick of their span
+		first := (wheel.next-1)>>shift + 1
+		for block := first; block < first+wheelSize && block <= math.MaxUint64>>shift; block++ {
+			if wheel.levels[level][block&wheelMask].head != nil {
+				if t := block << shift; !ok || t < tick {
+					tick, ok = t, true
+				}
+				break
+			}
+		}
+	}
+	return tick, ok
+}
+
+// cascade moves the elements of the slot to lower levels.
+func (wheel *TimerWheel[T]) cascade(level int, index uint64) {
+	s := &wheel.levels[level][index]
+	for handle := s.head; handle != nil; {
+		next := handle.next
+		s.remove(handle)
+		wheel.waiting--
+		wheel.add(handle)
+		handle = next
+	}
+}
+
+// add puts the handle into the slot for its expiry, relative to the next tick to process.
+func (wheel *TimerWheel[T]) add(handle *Handle[T]) {
+	if handle.expiry < wheel.next {
+		wheel.ready.insertSorted(handle)
+		return
+	}
+	delta := handle.expiry - wheel.next
+	level := 0
+	for level < wheelLevels-1 && delta >= 1<<(wheelBits*(level+1)) {
+		level++
+	}
+	wheel.levels[level][handle.expiry>>(wheelBits*level)&wheelMask].append(handle)
+	wheel.waiting++
+}
+
+// each calls the function for every handle in the wheel.
+func (wheel *TimerWheel[T]) each(f func(handle *Handle[T])) {
+	for handle := wheel.ready.head; handle != nil; handle = handle.next {
+		f(handle)
+	}
+	for level := range wheel.levels {
+		for index := range wheel.levels[level] {
+			for handle := wheel.levels[level][index].head; handle != nil; handle = handle.next {
+				f(handle)
+			}
+		}
+	}
+}
+
+// append adds the handle to the end of the list.
+func (s *slot[T]) append(handle *Handle[T]) {
+	handle.slot, handle.prev, handle.next = s, s.tail, nil
+	if s.tail == nil {
+		s.head = handle
+	} else {
+		s.tail.next = handle
+	}
+	s.tail = handle
+}
+
+// insertSorted adds the handle to the list, behind the handles that do not come after it in deadline order.
+// Searches from the end of the list, where the handle usually belongs.
+func (s *slot[T]) insertSorted(handle *Handle[T]) {
+	prev := s.tail
+	for prev != nil && compareHandles(prev, handle) > 0 {
+		prev = prev.prev
+	}
+	if prev == nil {
+		handle.slot, handle.prev, handle.next = s, nil, s.head
+		if s.head != nil {
+			s.head.prev = handle
+		} else {
+			s.tail = handle
+		}
+		s.head = handle
+		return
+	}
+	handle.slot, handle.prev, handle.next = s, prev, prev.next
+	if prev.next != nil {
+		prev.next.prev = handle
+	} else {
+		s.tail = handle
+	}
+	prev.next = handle
+}
+
+// remove removes the handle from the list.
+func (s *slot[T]) remove(handle *Handle[T]) {
+	if handle.prev == nil {
+		s.head = handle.next
+	} else {
+		handle.prev.next = handle.next
+	}
+	if handle.next == nil {
+		s.tail = handle.prev
+	} else {
+		handle.next.prev = handle.prev
+	}
+	handle.slot, handle.prev, handle.next = nil, nil, nil
+}
@@ -0,0 +1,133 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package doublepriorityqueue implements a double-ended priority queue backed by a min-max heap.
+//
+// An unbounded priority queue from which both the least and the greatest element can be retrieved in O(1) and
+// removed in O(log n). The elements of the queue are ordered by a comparator provided at queue construction time.
+//
+// Dequeue and Peek operate on the least/smallest element with respect to the specified ordering, so the queue can be
+// used in place of a priority queue. If multiple elements are tied for least (greatest) value, one of those elements
+// is returned arbitrarily.
+//
+// Structure is not thread safe.
+//
+// References: https://en.wikipedia.org/wiki/Double-ended_priority_queue
+package doublepriorityqueue
+
+import (
+	"cmp"
+	"fmt"
+	"strings"
+
+	"github.com/emirpasic/gods/v2/containers"
+	"github.com/emirpasic/gods/v2/queues"
+	"github.com/emirpasic/gods/v2/trees/minmaxheap"
+	"github.com/emirpasic/gods/v2/utils"
+)
+
+// Assert Queue implementation
+var _ queues.Queue[int] = (*Queue[int])(nil)
+var _ containers.FailFast = (*Queue[int])(nil)
+
+// Queue holds elements in a min-max heap
+type Queue[T comparable] struct {
+	heap       *minmaxheap.Heap[T]
+	Comparator utils.Comparator[T]
+}
+
+// New instantiates a new empty queue with the built-in comparator for T
+func New[T cmp.Ordered]() *Queue[T] {
+	return NewWith[T](cmp.Compare[T])
+}
+
+// NewWith instantiates a new empty queue with the custom comparator.
+func NewWith[T comparable](comparator utils.Comparator[T]) *Queue[T] {
+	return &Queue[T]{heap: minmaxheap.NewWith(comparator), Comparator: comparator}
+}
+
+// NewFromSlice instantiates a new queue of the values with the custom comparator in O(n).
+// The values are copied, the slice is not modified.
+func NewFromSlice[T comparable](values []T, comparator utils.Comparator[T]) *Queue[T] {
+	return &Queue[T]{heap: minmaxheap.NewFromSlice(values, comparator), Comparator: comparator}
+}
+
+// Enqueue adds a value to the queue
+func (queue *Queue[T]) Enqueue(value T) {
+	queue.heap.Push(value)
+}
+
+// Dequeue removes the least element of the queue and returns it, same as PopMin.
+// Second return parameter is true, unless the queue was empty and there was nothing to dequeue.
+func (queue *Queue[T]) Dequeue() (value T, ok bool) {
+	return queue.heap.PopMin()
+}
+
+// Peek returns the least element of the queue without removing it, same as PeekMin.
+// Second return parameter is true, unless the queue was empty and there was nothing to peek.
+func (queue *Queue[T]) Peek() (value T, ok bool) {
+	return queue.heap.PeekMin()
+}
+
+// PeekMin returns the least element of the queue without removing it.
+// Second return parameter is true, unless the queue was empty and there was nothing to peek.
+func (queue *Queue[T]) PeekMin() (value T, ok bool) {
+	return queue.heap.PeekMin()
+}
+
+// PeekMax returns the greatest element of the queue without removing it.
+// Second return parameter is true, unless the queue was empty and there was nothing to peek.
+func (queue *Queue[T]) PeekMax() (value T, ok bool) {
+	return queue.heap.PeekMax()
+}
+
+// PopMin removes the least element of the queue and returns it.
+// Second return parameter is true, unless the queue was empty and there was nothing to pop.
+func (queue *Queue[T]) PopMin() (value T, ok bool) {
+	return queue.heap.PopMin()
+}
+
+// PopMax removes the greatest element of the queue and returns it.
+// Second return parameter is true, unless the queue was empty and there was nothing to pop.
+func (queue *Queue[T]) PopMax() (value T, ok bool) {
+	return queue.heap.PopMax()
+}
+
+// Empty returns true if queue does not contain any elements.
+func (queue *Queue[T]) Empty() bool {
+	return queue.heap.Empty()
+}
+
+// Size returns number of elements within the queue.
+func (queue *Queue[T]) Size() int {
+	return queue.heap.Size()
+}
+
+// Clear removes all elements from the queue.
+func (queue *Queue[T]) Clear() {
+	queue.heap.Clear()
+}
+
+// Values returns all elements in the queue level by level of the heap with the elements of each level sorted,
+// i.e. the least element comes first, but the elements are not sorted as a whole.
+func (queue *Queue[T]) Values() []T {
+	return queue.heap.Values()
+}
+
+// SetFailFast enables or disables the checks of iterators for modifications of the queue done while iterating,
+// see containers.ConcurrentModificationError. Checks are enabled by default.
+func (queue *Queue[T]) SetFailFast(enabled bool) {
+	queue.heap.SetFailFast(enabled)
+}
+
+// String returns a string representation of container
+func (queue *Queue[T]) String() string {
+	str := "DoublePriorityQueue\n"
+	values := make([]string, 0, queue.heap.Size())
+	for _, value := range queue.heap.Values() {
+		values = append(values, fmt.Sprintf("%v", value))
+	}
+	str += strings.Join(values, ", ")
+	return str
+}
@@ -0,0 +1,234 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package doublepriorityqueue
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/emirpasic/gods/v2/testutils"
+)
+
+type Element struct {
+	priority int
+	name     string
+}
+
+func (element Element) String() string {
+	return fmt.Sprintf("{%v %v}", element.priority, element.name)
+}
+
+// Comparator function (sort by priority value in ascending order)
+func byPriority(a, b Element) int {
+	return cmp.Compare(a.priority, b.priority)
+}
+
+func TestDoublePriorityQueueEnqueue(t *testing.T) {
+	queue := NewWith[Element](byPriority)
+
+	if actualValue := queue.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+
+	a := Element{name: "a", priority: 1}
+	c := Element{name: "c", priority: 3}
+	b := Element{name: "b", priority: 2}
+
+	queue.Enqueue(a)
+	queue.Enqueue(c)
+	queue.Enqueue(b)
+
+	if actualValue := queue.Size(); actualValue != 3 {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+	if actualValue, ok := queue.Peek(); actualValue != a || !ok {
+		t.Errorf("Got %v expected %v", actualValue, a)
+	}
+	if actualValue, ok := queue.PeekMin(); actualValue != a || !ok {
+		t.Errorf("Got %v expected %v", actualValue, a)
+	}
+	if actualValue, ok := queue.PeekMax(); actualValue != c || !ok {
+		t.Errorf("Got %v expected %v", actualValue, c)
+	}
+	if actualValue, ok := queue.PopMax(); actualValue != c || !ok {
+		t.Errorf("Got %v expected %v", actualValue, c)
+	}
+	if actualValue, ok := queue.Dequeue(); actualValue != a || !ok {
+		t.Errorf("Got %v expected %v", actualValue, a)
+	}
+	if actualValue, ok := queue.PopMin(); actualValue != b || !ok {
+		t.Errorf("Got %v expected %v", actualValue, b)
+	}
+	if actualValue, ok := queue.PopMin(); ok {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+	if actualValue, ok := queue.PopMax(); ok {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+	if actualValue := queue.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+}
+
+func TestDoublePriorityQueueNewFromSlice(t *testing.T) {
+	values := []int{15, 20, 3, 1, 2}
+	queue := NewFromSlice(values, cmp.Compare[int])
+
+	if actualValue, expectedValue := values, []int{15, 20, 3, 1, 2}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, ok := queue.PeekMin(); actualValue != 1 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+	if actualValue, ok := queue.PeekMax(); actualValue != 20 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 20)
+	}
+	queue.Clear()
+	if actualValue := queue.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+}
+
+func TestDoublePriorityQueueRandom(t *testing.T) {
+	queue := New[int]()
+
+	rand.Seed(3)
+	for i := 0; i < 10000; i++ {
+		queue.Enqueue(int(rand.Int31n(30)))
+	}
+
+	// alternately pop both ends, the remaining range narrows from both sides
+	low, _ := queue.PopMin()
+	high, _ := queue.PopMax()
+	for !queue.Empty() {
+		curr, _ := queue.PopMin()
+		if curr < low || curr > high {
+			t.Errorf("Heap property invalidated. low: %v high: %v current: %v", low, high, curr)
+		}
+		low = curr
+		if queue.Empty() {
+			break
+		}
+		curr, _ = queue.PopMax()
+		if curr < low || curr > high {
+			t.Errorf("Heap property invalidated. low: %v high: %v current: %v", low, high, curr)
+		}
+		high = curr
+	}
+}
+
+func TestDoublePriorityQueueIterator(t *testing.T) {
+	queue := New[int]()
+	it := queue.Iterator()
+	for it.Next() {
+		t.Errorf("Shouldn't iterate on empty queue")
+	}
+
+	queue.Enqueue(3)
+	queue.Enqueue(2)
+	queue.Enqueue(1)
+
+	var values []int
+	for it.Begin(); it.Next(); {
+		values = append(values, it.Value())
+	}
+	if actualValue, expectedValue := values, queue.Values(); !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := it.Last(), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := it.Index(), 2; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := it.PrevTo(func(index int, value int) bool { return value == 1 }), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := it.Index(), 0; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestDoublePriorityQueueIteratorFailFast(t *testing.T) {
+	queue := New[int]()
+	queue.Enqueue(1)
+	queue.Enqueue(2)
+	it := queue.Iterator()
+	it.Next()
+	queue.Enqueue(3)
+	testutils.ConcurrentModificationPanic(t, func() { it.Next() })
+	testutils.ConcurrentModificationPanic(t, func() { it.Prev() })
+
+	// reset iterator is valid again
+	count := 0
+	for it.Begin(); it.Next(); {
+		count++
+	}
+	if actualValue, expectedValue := count, 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	queue.SetFailFast(false)
+	it.Begin()
+	it.Next()
+	queue.Enqueue(4)
+	it.Next() // no panic
+}
+
+func TestDoublePriorityQueueSerialization(t *testing.T) {
+	queue := New[string]()
+
+	queue.Enqueue("c")
+	queue.Enqueue("b")
+	queue.Enqueue("a")
+
+	var err error
+	assert := func() {
+		if actualValue := queue.Size(); actualValue != 3 {
+			t.Errorf("Got %v expected %v", actualValue, 3)
+		}
+		if actualValue, ok := queue.PeekMin(); actualValue != "a" || !ok {
+			t.Errorf("Got %v expected %v", actualValue, "a")
+		}
+		if actualValue, ok := queue.PeekMax(); actualValue != "c" || !ok {
+			t.Errorf("Got %v expected %v", actualValue, "c")
+		}
+		if err != nil {
+			t.Errorf("Got error %v", err)
+		}
+	}
+
+	assert()
+
+	bytes, err := queue.ToJSON()
+	assert()
+
+	err = queue.FromJSON(bytes)
+	assert()
+
+	bytes, err = json.Marshal([]interface{}{"a", "b", "c", queue})
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+
+	err = json.Unmarshal([]byte(`["c","a","b"]`), &queue)
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	assert()
+}
+
+func TestDoublePriorityQueueString(t *testing.T) {
+	c := New[int]()
+	c.Enqueue(1)
+	if !strings.HasPrefix(c.String(), "DoublePriorityQueue") {
+		t.Errorf("String should start with container name")
+	}
+}
@@ -11,13 +11,14 @@ var _ containers.IteratorWithIndex[int] = (*Iterator[int])(nil)
 
 // Iterator returns a stateful iterator whose values can be fetched by an index.
 type Iterator[T comparable] struct {
-	queue *Queue[T]
-	index int
+	queue    *Queue[T]
+	index    int
+	modCount int // queue's modification count the iterator is in sync with
 }
 
 // Iterator returns a stateful iterator whose values can be fetched by an index.
 func (queue *Queue[T]) Iterator() *Iterator[T] {
-	return &Iterator[T]{queue: queue, index: -1}
+	return &Iterator[T]{queue: queue, index: -1, modCount: queue.modCount}
 }
 
 // Next moves the iterator to the next element and returns true if there was a next element in the container.
@@ -25,6 +26,7 @@ func (queue *Queue[T]) Iterator() *Iterator[T] {
 // If Next() was called for the first time, then it will point the iterator to the first element if it exists.
 // Modifies the state of the iterator.
 func (iterator *Iterator[T]) Next() bool {
+	iterator.checkModification()
 	if iterator.index < iterator.queue.Size() {
 		iterator.index++
 	}
@@ -48,6 +50,7 @@ func (iterator *Iterator[T]) Index() int {
 // Call Next() to fetch the first element if any.
 func (iterator *Iterator[T]) Begin() {
 	iterator.index = -1
+	iterator.modCount = iterator.queue.modCount
 }
 
 // First moves the iterator to the first element and returns true if there was a first element in the container.
@@ -71,3 +74,16 @@ func (iterator *Iterator[T]) NextTo(f func(index int, value T) bool) bool {
 	}
 	return false
 }
+
+// checkModification panics if the queue was modified since the iterator moved to its current element,
+// unless fail-fast iteration is disabled for the queue.
+func (iterator *Iterator[T]) checkModification() {
+	if iterator.index == -1 {
+		// not on an element yet, nothing to invalidate
+		iterator.modCount = iterator.queue.modCount
+		return
+	}
+	if iterator.modCount != iterator.queue.modCount && !iterator.queue.failFastDisabled {
+		panic(&containers.ConcurrentModificationError{Container: "LinkedListQueue"})
+	}
+}
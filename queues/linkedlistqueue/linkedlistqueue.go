@@ -13,16 +13,20 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/emirpasic/gods/v2/containers"
 	"github.com/emirpasic/gods/v2/lists/singlylinkedlist"
 	"github.com/emirpasic/gods/v2/queues"
 )
 
 // Assert Queue implementation
 var _ queues.Queue[int] = (*Queue[int])(nil)
+var _ containers.FailFast = (*Queue[int])(nil)
 
 // Queue holds elements in a singly-linked-list
 type Queue[T comparable] struct {
-	list *singlylinkedlist.List[T]
+	list             *singlylinkedlist.List[T]
+	modCount         int  // number of structural modifications, checked by iterators
+	failFastDisabled bool // iterators do not check for modifications
 }
 
 // New instantiates a new empty queue
@@ -33,6 +37,7 @@ func New[T comparable]() *Queue[T] {
 // Enqueue adds a value to the end of the queue
 func (queue *Queue[T]) Enqueue(value T) {
 	queue.list.Add(value)
+	queue.modCount++
 }
 
 // Dequeue removes first element of the queue and returns it, or nil if queue is empty.
@@ -41,6 +46,7 @@ func (queue *Queue[T]) Dequeue() (value T, ok bool) {
 	value, ok = queue.list.Get(0)
 	if ok {
 		queue.list.Remove(0)
+		queue.modCount++
 	}
 	return
 }
@@ -64,6 +70,7 @@ func (queue *Queue[T]) Size() int {
 // Clear removes all elements from the queue.
 func (queue *Queue[T]) Clear() {
 	queue.list.Clear()
+	queue.modCount++
 }
 
 // Values returns all elements in the queue (FIFO order).
@@ -71,6 +78,12 @@ func (queue *Queue[T]) Values() []T {
 	return queue.list.Values()
 }
 
+// SetFailFast enables or disables the checks of iterators for modifications of the queue done while iterating,
+// see containers.ConcurrentModificationError. Checks are enabled by default.
+func (queue *Queue[T]) SetFailFast(enabled bool) {
+	queue.failFastDisabled = !enabled
+}
+
 // String returns a string representation of container
 func (queue *Queue[T]) String() string {
 	str := "LinkedListQueue\n"
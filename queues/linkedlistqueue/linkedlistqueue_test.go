@@ -210,6 +210,31 @@ func TestQueueIteratorNextTo(t *testing.T) {
 	}
 }
 
+func TestQueueIteratorFailFast(t *testing.T) {
+	queue := New[int]()
+	queue.Enqueue(1)
+	queue.Enqueue(2)
+	it := queue.Iterator()
+	it.Next()
+	queue.Enqueue(3)
+	testutils.ConcurrentModificationPanic(t, func() { it.Next() })
+
+	// reset iterator is valid again
+	count := 0
+	for it.Begin(); it.Next(); {
+		count++
+	}
+	if actualValue, expectedValue := count, 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	queue.SetFailFast(false)
+	it.Begin()
+	it.Next()
+	queue.Enqueue(4)
+	it.Next() // no panic
+}
+
 func TestQueueSerialization(t *testing.T) {
 	queue := New[string]()
 	queue.Enqueue("a")
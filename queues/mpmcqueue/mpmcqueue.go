@@ -0,0 +1,241 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package mpmcqueue implements a lock-free bounded multi-producer multi-consumer queue.
+//
+// The queue is a ring of slots, each with a sequence number that tells producers and consumers whether the slot is
+// free for the current turn of the ring or holds an element. Producers and consumers claim positions by atomically
+// advancing the tail and the head, so that they only contend on those counters and never block each other (Dmitry
+// Vyukov's bounded MPMC queue).
+//
+// Enqueue and Dequeue are lock-free. Peek and Values read slots by locking them for a moment, during which a
+// producer or consumer of the same slot spins.
+//
+// Structure is thread safe.
+//
+// References: https://www.1024cores.net/home/lock-free-algorithms/queues/bounded-mpmc-queue
+package mpmcqueue
+
+import (
+	"fmt"
+	"math"
+	"math/bits"
+	"runtime"
+	"strings"
+	"sync/atomic"
+
+	"github.com/emirpasic/gods/v2/queues"
+)
+
+// Assert Queue implementation
+var _ queues.Queue[int] = (*Queue[int])(nil)
+
+// locked is the sequence number of a slot that is being read.
+const locked = math.MaxUint64
+
+// cacheLine is the assumed size of a cache line, by which the counters are padded to avoid false sharing.
+const cacheLine = 64
+
+// Queue holds elements in a ring of slots
+type Queue[T comparable] struct {
+	_     [cacheLine]byte
+	tail  atomic.Uint64 // position of the next element to enqueue
+	_     [cacheLine - 8]byte
+	head  atomic.Uint64 // position of the next element to dequeue
+	_     [cacheLine - 8]byte
+	slots []slot[T]
+	mask  uint64
+}
+
+// slot holds the element at the positions of the ring that map to it.
+// For a position p mapping to the slot, the sequence number is p while the slot is free for the element at p, and
+// p+1 while it holds that element. Once the element is dequeued, it is p+capacity, freeing it for the next turn.
+type slot[T comparable] struct {
+	seq   atomic.Uint64
+	value T
+}
+
+// New instantiates a new empty queue that holds up to the capacity number of elements, rounded up to a power of two
+// of at least 2. Panics if the capacity is not positive.
+func New[T comparable](capacity int) *Queue[T] {
+	if capacity < 1 {
+		panic(fmt.Sprintf("mpmcqueue: capacity %v is not positive", capacity))
+	}
+	capacity = 1 << bits.Len(uint(max(capacity, 2)-1))
+	queue := &Queue[T]{slots: make([]slot[T], capacity), mask: uint64(capacity - 1)}
+	for i := range queue.slots {
+		queue.slots[i].seq.Store(uint64(i))
+	}
+	return queue
+}
+
+// Enqueue adds a value to the end of the queue, spinning while the queue is full.
+func (queue *Queue[T]) Enqueue(value T) {
+	for !queue.TryEnqueue(value) {
+		runtime.Gosched()
+	}
+}
+
+// TryEnqueue adds a value to the end of the queue without waiting.
+// Returns false if the queue was full and the value was not added.
+func (queue *Queue[T]) TryEnqueue(value T) bool {
+	pos := queue.tail.Load()
+	for {
+		slot := &queue.slots[pos&queue.mask]
+		seq := slot.seq.Load()
+		switch dif := int64(seq - pos); {
+		case dif == 0:
+			// the slot is free for this position, claim it
+			if queue.tail.CompareAndSwap(pos, pos+1) {
+				slot.value = value
+				slot.seq.Store(pos + 1)
+				return true
+			}
+			pos = queue.tail.Load()
+		case dif < 0 || seq == locked:
+			// the slot still holds the element of the previous turn
+			return false
+		default:
+			// another producer claimed the position
+			pos = queue.tail.Load()
+		}
+	}
+}
+
+// Dequeue removes first element of the queue and returns it, or the 0-value if queue is empty.
+// Second return parameter is true, unless the queue was empty and there was nothing to dequeue.
+// Dequeue does not wait for elements, it is the same as TryDequeue.
+func (queue *Queue[T]) Dequeue() (value T, ok bool) {
+	return queue.TryDequeue()
+}
+
+// TryDequeue removes first element of the queue and returns it without waiting, or the 0-value if queue is empty.
+// Second return parameter is true, unless the queue was empty and there was nothing to dequeue.
+func (queue *Queue[T]) TryDequeue() (value T, ok bool) {
+	pos := queue.head.Load()
+	for {
+		slot := &queue.slots[pos&queue.mask]
+		seq := slot.seq.Load()
+		switch dif := int64(seq - (pos + 1)); {
+		case seq == locked:
+			// the element is being read
+			runtime.Gosched()
+			pos = queue.head.Load()
+		case dif == 0:
+			// the slot holds the element of this position, claim it
+			if queue.head.CompareAndSwap(pos, pos+1) {
+				slot.lock(pos + 1)
+				var zero T
+				value, slot.value = slot.value, zero
+				slot.seq.Store(pos + queue.mask + 1)
+				return value, true
+			}
+			pos = queue.head.Load()
+		case dif < 0:
+			// the slot does not hold an element yet
+			return value, false
+		default:
+			// another consumer claimed the position
+			pos = queue.head.Load()
+		}
+	}
+}
+
+// Peek returns first element of the queue without removing it, or the 0-value if queue is empty.
+// Second return parameter is true, unless the queue was empty and there was nothing to peek.
+func (queue *Queue[T]) Peek() (value T, ok bool) {
+	for {
+		pos := queue.head.Load()
+		slot := &queue.slots[pos&queue.mask]
+		seq := slot.seq.Load()
+		switch {
+		case seq == pos+1:
+			if !slot.seq.CompareAndSwap(seq, locked) {
+				continue
+			}
+			// the element is first unless a consumer claimed it before the slot was locked
+			first := queue.head.Load() == pos
+			if first {
+				value = slot.value
+			}
+			slot.seq.Store(seq)
+			if first {
+				return value, true
+			}
+		case seq != locked && int64(seq-(pos+1)) < 0:
+			return value, false
+		default:
+			runtime.Gosched()
+		}
+	}
+}
+
+// Empty returns true if queue does not contain any elements.
+func (queue *Queue[T]) Empty() bool {
+	return queue.Size() == 0
+}
+
+// Full returns true if the queue holds its maximum number of elements.
+func (queue *Queue[T]) Full() bool {
+	return queue.Size() == queue.Capacity()
+}
+
+// Size returns number of elements within the queue, including elements that are being enqueued or dequeued.
+func (queue *Queue[T]) Size() int {
+	head := queue.head.Load()
+	tail := queue.tail.Load()
+	if tail < head {
+		// the head moved on after it was loaded
+		return 0
+	}
+	return int(min(tail-head, queue.mask+1))
+}
+
+// Capacity returns the maximum number of elements the queue holds.
+func (queue *Queue[T]) Capacity() int {
+	return len(queue.slots)
+}
+
+// Clear removes all elements from the queue.
+// Elements enqueued concurrently may remain in the queue.
+func (queue *Queue[T]) Clear() {
+	for _, ok := queue.TryDequeue(); ok; _, ok = queue.TryDequeue() {
+	}
+}
+
+// Values returns all elements in the queue (FIFO order).
+// Under concurrent modification the elements are not a consistent snapshot of the queue.
+func (queue *Queue[T]) Values() []T {
+	head := queue.head.Load()
+	tail := queue.tail.Load()
+	values := make([]T, 0, min(max(tail, head)-head, queue.mask+1))
+	for pos := head; pos < tail; pos++ {
+		slot := &queue.slots[pos&queue.mask]
+		if !slot.seq.CompareAndSwap(pos+1, locked) {
+			// the element was not enqueued yet or was dequeued already
+			continue
+		}
+		values = append(values, slot.value)
+		slot.seq.Store(pos + 1)
+	}
+	return values
+}
+
+// String returns a string representation of container
+func (queue *Queue[T]) String() string {
+	str := "MPMCQueue\n"
+	values := []string{}
+	for _, value := range queue.Values() {
+		values = append(values, fmt.Sprintf("%v", value))
+	}
+	str += strings.Join(values, ", ")
+	return str
+}
+
+// lock locks the slot holding the element with the sequence number, spinning while it is being read.
+func (slot *slot[T]) lock(seq uint64) {
+	for !slot.seq.CompareAndSwap(seq, locked) {
+		runtime.Gosched()
+	}
+}
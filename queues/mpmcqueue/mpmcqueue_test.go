@@ -0,0 +1,291 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mpmcqueue
+
+import (
+	"runtime"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestQueueEnqueue(t *testing.T) {
+	queue := New[int](4)
+	if actualValue := queue.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	queue.Enqueue(1)
+	queue.Enqueue(2)
+	queue.Enqueue(3)
+
+	if actualValue, expectedValue := queue.Values(), []int{1, 2, 3}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue := queue.Empty(); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if actualValue := queue.Size(); actualValue != 3 {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+	if actualValue, ok := queue.Peek(); actualValue != 1 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+}
+
+func TestQueueDequeue(t *testing.T) {
+	queue := New[int](4)
+	if actualValue, ok := queue.Dequeue(); actualValue != 0 || ok {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+	if actualValue, ok := queue.Peek(); actualValue != 0 || ok {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+
+	// several turns of the ring
+	for i := 0; i < 10; i++ {
+		queue.Enqueue(2 * i)
+		queue.Enqueue(2*i + 1)
+		for _, expectedValue := range []int{2 * i, 2*i + 1} {
+			if actualValue, ok := queue.Dequeue(); actualValue != expectedValue || !ok {
+				t.Errorf("Got %v expected %v", actualValue, expectedValue)
+			}
+		}
+	}
+	if actualValue, ok := queue.TryDequeue(); actualValue != 0 || ok {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+	if actualValue := queue.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+}
+
+func TestQueueTryEnqueue(t *testing.T) {
+	queue := New[int](3)
+	if actualValue := queue.Capacity(); actualValue != 4 {
+		t.Errorf("Got %v expected %v", actualValue, 4)
+	}
+	for i := 1; i <= 4; i++ {
+		if actualValue := queue.TryEnqueue(i); actualValue != true {
+			t.Errorf("Got %v expected %v", actualValue, true)
+		}
+	}
+	if actualValue := queue.TryEnqueue(5); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if actualValue := queue.Full(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	queue.Dequeue()
+	if actualValue := queue.TryEnqueue(5); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue, expectedValue := queue.Values(), []int{2, 3, 4, 5}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	queue.Clear()
+	if actualValue := queue.Size(); actualValue != 0 {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+}
+
+func TestQueueCapacity(t *testing.T) {
+	tests := [][2]int{{1, 2}, {2, 2}, {5, 8}, {8, 8}, {1000, 1024}}
+	for _, test := range tests {
+		if actualValue := New[int](test[0]).Capacity(); actualValue != test[1] {
+			t.Errorf("Got %v expected %v", actualValue, test[1])
+		}
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("Got no panic expected a panic for an invalid capacity")
+		}
+	}()
+	New[int](0)
+}
+
+func TestQueueConcurrent(t *testing.T) {
+	const producers, consumers, count = 4, 4, 2000
+	queue := New[int](64)
+
+	var wg sync.WaitGroup
+	for p := 0; p < producers; p++ {
+		wg.Add(1)
+		go func(p int) {
+			defer wg.Done()
+			for i := 0; i < count; i++ {
+				queue.Enqueue(p*count + i)
+			}
+		}(p)
+	}
+
+	received := make([][]int, consumers)
+	var taken sync.WaitGroup
+	// one token per element to dequeue
+	remaining := make(chan struct{}, producers*count)
+	for i := 0; i < producers*count; i++ {
+		remaining <- struct{}{}
+	}
+	close(remaining)
+	for c := 0; c < consumers; c++ {
+		taken.Add(1)
+		go func(c int) {
+			defer taken.Done()
+			for range remaining {
+				for {
+					if value, ok := queue.Dequeue(); ok {
+						received[c] = append(received[c], value)
+						break
+					}
+					runtime.Gosched()
+				}
+			}
+		}(c)
+	}
+
+	// readers lock slots concurrently
+	done := make(chan struct{})
+	go func() {
+		for {
+			select {
+			case <-done:
+				return
+			default:
+				queue.Peek()
+				queue.Values()
+			}
+		}
+	}()
+
+	wg.Wait()
+	taken.Wait()
+	close(done)
+
+	seen := make([]bool, producers*count)
+	for _, values := range received {
+		// elements of each producer are dequeued in order
+		last := make([]int, producers)
+		for p := range last {
+			last[p] = -1
+		}
+		for _, value := range values {
+			if seen[value] {
+				t.Fatalf("Got %v twice", value)
+			}
+			seen[value] = true
+			p, i := value/count, value%count
+			if i <= last[p] {
+				t.Fatalf("Got %v after %v of producer %v", i, last[p], p)
+			}
+			last[p] = i
+		}
+	}
+	for value, ok := range seen {
+		if !ok {
+			t.Fatalf("Got no %v", value)
+		}
+	}
+	if actualValue := queue.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+}
+
+func TestQueueString(t *testing.T) {
+	c := New[int](2)
+	c.Enqueue(1)
+	if !strings.HasPrefix(c.String(), "MPMCQueue") {
+		t.Errorf("String should start with container name")
+	}
+}
+
+func benchmarkEnqueue(b *testing.B, queue *Queue[int], size int) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			queue.Enqueue(n)
+		}
+		b.StopTimer()
+		queue.Clear()
+		b.StartTimer()
+	}
+}
+
+func benchmarkDequeue(b *testing.B, queue *Queue[int], size int) {
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		for n := 0; n < size; n++ {
+			queue.Enqueue(n)
+		}
+		b.StartTimer()
+		for n := 0; n < size; n++ {
+			queue.Dequeue()
+		}
+	}
+}
+
+func BenchmarkMPMCQueueDequeue100(b *testing.B) {
+	b.StopTimer()
+	size := 100
+	queue := New[int](size)
+	b.StartTimer()
+	benchmarkDequeue(b, queue, size)
+}
+
+func BenchmarkMPMCQueueDequeue1000(b *testing.B) {
+	b.StopTimer()
+	size := 1000
+	queue := New[int](size)
+	b.StartTimer()
+	benchmarkDequeue(b, queue, size)
+}
+
+func BenchmarkMPMCQueueDequeue10000(b *testing.B) {
+	b.StopTimer()
+	size := 10000
+	queue := New[int](size)
+	b.StartTimer()
+	benchmarkDequeue(b, queue, size)
+}
+
+func BenchmarkMPMCQueueDequeue100000(b *testing.B) {
+	b.StopTimer()
+	size := 100000
+	queue := New[int](size)
+	b.StartTimer()
+	benchmarkDequeue(b, queue, size)
+}
+
+func BenchmarkMPMCQueueEnqueue100(b *testing.B) {
+	b.StopTimer()
+	size := 100
+	queue := New[int](size)
+	b.StartTimer()
+	benchmarkEnqueue(b, queue, size)
+}
+
+func BenchmarkMPMCQueueEnqueue1000(b *testing.B) {
+	b.StopTimer()
+	size := 1000
+	queue := New[int](size)
+	b.StartTimer()
+	benchmarkEnqueue(b, queue, size)
+}
+
+func BenchmarkMPMCQueueEnqueue10000(b *testing.B) {
+	b.StopTimer()
+	size := 10000
+	queue := New[int](size)
+	b.StartTimer()
+	benchmarkEnqueue(b, queue, size)
+}
+
+func BenchmarkMPMCQueueEnqueue100000(b *testing.B) {
+	b.StopTimer()
+	size := 100000
+	queue := New[int](size)
+	b.StartTimer()
+	benchmarkEnqueue(b, queue, size)
+}
@@ -4,22 +4,24 @@
 
 package priorityqueue
 
-import (
-	"github.com/emirpasic/gods/v2/containers"
-	"github.com/emirpasic/gods/v2/trees/binaryheap"
-)
+import "github.com/emirpasic/gods/v2/containers"
 
 // Assert Iterator implementation
 var _ containers.ReverseIteratorWithIndex[int] = (*Iterator[int])(nil)
 
 // Iterator returns a stateful iterator whose values can be fetched by an index.
 type Iterator[T comparable] struct {
-	iterator *binaryheap.Iterator[T]
+	queue    *Queue[T]
+	values   []T // values of the queue in iteration order
+	index    int
+	modCount int // queue's modification count the values are in sync with
 }
 
 // Iterator returns a stateful iterator whose values can be fetched by an index.
+// The iterator takes the values of the queue when it first moves, see Values, so the iterator of a stable queue
+// iterates over the elements in dequeue order, which sorts them in O(n log n).
 func (queue *Queue[T]) Iterator() *Iterator[T] {
-	return &Iterator[T]{iterator: queue.heap.Iterator()}
+	return &Iterator[T]{queue: queue, index: -1, modCount: -1}
 }
 
 // Next moves the iterator to the next element and returns true if there was a next element in the container.
@@ -27,52 +29,64 @@ func (queue *Queue[T]) Iterator() *Iterator[T] {
 // If Next() was called for the first time, then it will point the iterator to the first element if it exists.
 // Modifies the state of the iterator.
 func (iterator *Iterator[T]) Next() bool {
-	return iterator.iterator.Next()
+	iterator.checkModification()
+	if iterator.index < len(iterator.values) {
+		iterator.index++
+	}
+	return iterator.withinRange()
 }
 
 // Prev moves the iterator to the previous element and returns true if there was a previous element in the container.
 // If Prev() returns true, then previous element's index and value can be retrieved by Index() and Value().
 // Modifies the state of the iterator.
 func (iterator *Iterator[T]) Prev() bool {
-	return iterator.iterator.Prev()
+	iterator.checkModification()
+	if iterator.index >= 0 {
+		iterator.index--
+	}
+	return iterator.withinRange()
 }
 
 // Value returns the current element's value.
 // Does not modify the state of the iterator.
 func (iterator *Iterator[T]) Value() T {
-	return iterator.iterator.Value()
+	iterator.sync()
+	return iterator.values[iterator.index]
 }
 
 // Index returns the current element's index.
 // Does not modify the state of the iterator.
 func (iterator *Iterator[T]) Index() int {
-	return iterator.iterator.Index()
+	return iterator.index
 }
 
 // Begin resets the iterator to its initial state (one-before-first)
 // Call Next() to fetch the first element if any.
 func (iterator *Iterator[T]) Begin() {
-	iterator.iterator.Begin()
+	iterator.index = -1
 }
 
 // End moves the iterator past the last element (one-past-the-end).
 // Call Prev() to fetch the last element if any.
 func (iterator *Iterator[T]) End() {
-	iterator.iterator.End()
+	iterator.sync()
+	iterator.index = len(iterator.values)
 }
 
 // First moves the iterator to the first element and returns true if there was a first element in the container.
 // If First() returns true, then first element's index and value can be retrieved by Index() and Value().
 // Modifies the state of the iterator.
 func (iterator *Iterator[T]) First() bool {
-	return iterator.iterator.First()
+	iterator.Begin()
+	return iterator.Next()
 }
 
 // Last moves the iterator to the last element and returns true if there was a last element in the container.
 // If Last() returns true, then last element's index and value can be retrieved by Index() and Value().
 // Modifies the state of the iterator.
 func (iterator *Iterator[T]) Last() bool {
-	return iterator.iterator.Last()
+	iterator.End()
+	return iterator.Prev()
 }
 
 // NextTo moves the iterator to the next element from current position that satisfies the condition given by the
@@ -80,7 +94,13 @@ func (iterator *Iterator[T]) Last() bool {
 // If NextTo() returns true, then next element's index and value can be retrieved by Index() and Value().
 // Modifies the state of the iterator.
 func (iterator *Iterator[T]) NextTo(f func(index int, value T) bool) bool {
-	return iterator.iterator.NextTo(f)
+	for iterator.Next() {
+		index, value := iterator.Index(), iterator.Value()
+		if f(index, value) {
+			return true
+		}
+	}
+	return false
 }
 
 // PrevTo moves the iterator to the previous element from current position that satisfies the condition given by the
@@ -88,5 +108,33 @@ func (iterator *Iterator[T]) NextTo(f func(index int, value T) bool) bool {
 // If PrevTo() returns true, then next element's index and value can be retrieved by Index() and Value().
 // Modifies the state of the iterator.
 func (iterator *Iterator[T]) PrevTo(f func(index int, value T) bool) bool {
-	return iterator.iterator.PrevTo(f)
+	for iterator.Prev() {
+		index, value := iterator.Index(), iterator.Value()
+		if f(index, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// withinRange checks that the iterator is on an element
+func (iterator *Iterator[T]) withinRange() bool {
+	return iterator.index >= 0 && iterator.index < len(iterator.values)
+}
+
+// sync takes the values of the queue again if it was modified since they were taken.
+func (iterator *Iterator[T]) sync() {
+	if iterator.modCount != iterator.queue.modCount {
+		iterator.values = iterator.queue.Values()
+		iterator.modCount = iterator.queue.modCount
+	}
+}
+
+// checkModification panics if the queue was modified since the iterator moved to its current element,
+// unless fail-fast iteration is disabled for the queue. Otherwise it brings the iterator in sync with the queue.
+func (iterator *Iterator[T]) checkModification() {
+	if iterator.index != -1 && iterator.modCount != iterator.queue.modCount && !iterator.queue.failFastDisabled {
+		panic(&containers.ConcurrentModificationError{Container: "PriorityQueue"})
+	}
+	iterator.sync()
 }
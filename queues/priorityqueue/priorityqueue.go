@@ -2,13 +2,14 @@
 // Use of this source code is governed by a BSD-style
 // license that can be found in the LICENSE file.
 
-// Package priorityqueue implements a priority queue backed by binary queue.
+// Package priorityqueue implements a priority queue backed by a binary heap, or any other heap, see NewWithHeap.
 //
 // An unbounded priority queue based on a priority queue.
 // The elements of the priority queue are ordered by a comparator provided at queue construction time.
 //
 // The heap of this queue is the least/smallest element with respect to the specified ordering.
-// If multiple elements are tied for least value, the heap is one of those elements arbitrarily.
+// If multiple elements are tied for least value, the heap is one of those elements arbitrarily,
+// unless the queue is stable, see NewStable.
 //
 // Structure is not thread safe.
 //
@@ -20,18 +21,45 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/emirpasic/gods/v2/containers"
 	"github.com/emirpasic/gods/v2/queues"
+	"github.com/emirpasic/gods/v2/trees"
 	"github.com/emirpasic/gods/v2/trees/binaryheap"
 	"github.com/emirpasic/gods/v2/utils"
 )
 
 // Assert Queue implementation
 var _ queues.Queue[int] = (*Queue[int])(nil)
+var _ containers.FailFast = (*Queue[int])(nil)
 
-// Queue holds elements in an array-list
+// Queue holds elements in a heap (binary heap by default)
 type Queue[T comparable] struct {
-	heap       *binaryheap.Heap[T]
-	Comparator utils.Comparator[T]
+	heap             backingHeap[T]
+	Comparator       utils.Comparator[T] // nil if the queue was created with NewWithHeap
+	bound            int                 // maximum number of elements in top-k mode, if the heap has no top-k mode
+	modCount         int                 // number of modifications, checked by iterators
+	failFastDisabled bool                // iterators do not check for modifications
+}
+
+// backingHeap is the part of a heap the queue needs, implemented by all heaps and the heap of a stable queue.
+type backingHeap[T comparable] interface {
+	Push(values ...T)
+	Pop() (value T, ok bool)
+	Peek() (value T, ok bool)
+	Empty() bool
+	Size() int
+	Clear()
+	Values() []T
+}
+
+// arrayHeap is implemented by heaps that keep their elements in an array, i.e. by binaryheap.Heap and the heap of
+// a stable queue. For other heaps, the queue does the same with Push and Pop, see NewWithHeap.
+type arrayHeap[T comparable] interface {
+	PushPop(value T) T
+	Replace(value T) (top T, ok bool)
+	Fix(index int)
+	IndexOf(value T) int
+	TopK(k int)
 }
 
 func New[T cmp.Ordered]() *Queue[T] {
@@ -43,15 +71,127 @@ func NewWith[T comparable](comparator utils.Comparator[T]) *Queue[T] {
 	return &Queue[T]{heap: binaryheap.NewWith(comparator), Comparator: comparator}
 }
 
+// NewStable instantiates a new empty stable queue with the built-in comparator for T.
+// A stable queue dequeues elements of equal priority in the order they were enqueued (FIFO). Its iterator and Values
+// return the elements in dequeue order and its JSON representation preserves that order.
+//
+// The elements of a stable queue are kept with their insertion sequence numbers, and returning them in dequeue order
+// sorts them, i.e. Values, ToJSON and iterating take O(n log n).
+func NewStable[T cmp.Ordered]() *Queue[T] {
+	return NewStableWith[T](cmp.Compare[T])
+}
+
+// NewStableWith instantiates a new empty stable queue with the custom comparator, see NewStable.
+func NewStableWith[T comparable](comparator utils.Comparator[T]) *Queue[T] {
+	return &Queue[T]{heap: newStableHeap(comparator), Comparator: comparator}
+}
+
+// NewWithHeap instantiates a new queue backed by the given heap, e.g. pairingheap.NewWith(comparator) or
+// fibonacciheap.NewWith(comparator). The heap should be empty, it is used as is, i.e. its elements are ordered by its
+// comparator, and the queue's Comparator is nil.
+//
+// Fix and IndexOf need the positions of the elements of a binary heap: with other heaps, IndexOf returns -1 and Fix
+// does nothing. EnqueueDequeue, DequeueEnqueue and top-k mode are done by pushing and popping.
+func NewWithHeap[T comparable](heap trees.Heap[T]) *Queue[T] {
+	return &Queue[T]{heap: heap}
+}
+
+// NewFromSlice instantiates a new queue of the values with the custom comparator in O(n).
+// The values are copied, the slice is not modified.
+func NewFromSlice[T comparable](values []T, comparator utils.Comparator[T]) *Queue[T] {
+	return &Queue[T]{heap: binaryheap.NewFromSlice(values, comparator), Comparator: comparator}
+}
+
 // Enqueue adds a value to the end of the queue
 func (queue *Queue[T]) Enqueue(value T) {
-	queue.heap.Push(value)
+	queue.push(value)
+	queue.modCount++
+}
+
+// Merge moves all elements of the other queue into this queue, leaving the other queue empty.
+// The elements are ordered by the comparator of this queue. If this queue is stable, the elements of the other queue
+// count as enqueued after the elements of this queue, in the order they were enqueued into the other queue if that
+// is stable as well.
+func (queue *Queue[T]) Merge(other *Queue[T]) {
+	if other == queue || other.Empty() {
+		return
+	}
+	queue.push(other.elements()...)
+	other.Clear()
+	queue.modCount++
 }
 
 // Dequeue removes first element of the queue and returns it, or nil if queue is empty.
 // Second return parameter is true, unless the queue was empty and there was nothing to dequeue.
 func (queue *Queue[T]) Dequeue() (value T, ok bool) {
-	return queue.heap.Pop()
+	if value, ok = queue.heap.Pop(); ok {
+		queue.modCount++
+	}
+	return value, ok
+}
+
+// EnqueueDequeue adds the value to the queue and then removes the first element and returns it, see
+// binaryheap.Heap.PushPop. The value itself is returned if the queue is empty or the value would be first,
+// the queue is unchanged then.
+func (queue *Queue[T]) EnqueueDequeue(value T) T {
+	var top T
+	if heap, ok := queue.heap.(arrayHeap[T]); ok {
+		top = heap.PushPop(value)
+	} else {
+		queue.heap.Push(value)
+		top, _ = queue.heap.Pop()
+	}
+	if top != value {
+		queue.modCount++
+	}
+	return top
+}
+
+// DequeueEnqueue removes the first element and then adds the value to the queue, see binaryheap.Heap.Replace.
+// Returns the removed element. Second return parameter is true, unless the queue was empty and there was nothing to
+// dequeue, the value is added anyway.
+func (queue *Queue[T]) DequeueEnqueue(value T) (T, bool) {
+	queue.modCount++
+	if heap, ok := queue.heap.(arrayHeap[T]); ok {
+		return heap.Replace(value)
+	}
+	top, ok := queue.heap.Pop()
+	queue.heap.Push(value)
+	return top, ok
+}
+
+// Fix restores the order of the queue after the element at the index changed its priority, e.g. a pointer element
+// was modified in place. The index is the position in the queue's underlying heap, see IndexOf.
+// Does not do anything unless the queue is backed by a binary heap, see NewWithHeap.
+func (queue *Queue[T]) Fix(index int) {
+	if heap, ok := queue.heap.(arrayHeap[T]); ok {
+		heap.Fix(index)
+		queue.modCount++
+	}
+}
+
+// IndexOf returns the position of the value in the queue's underlying heap, or -1 if the value is not in the queue.
+// Positions are not related to the indexes of the iterator and change with every modification of the queue.
+// Returns -1 unless the queue is backed by a binary heap, see NewWithHeap.
+func (queue *Queue[T]) IndexOf(value T) int {
+	if heap, ok := queue.heap.(arrayHeap[T]); ok {
+		return heap.IndexOf(value)
+	}
+	return -1
+}
+
+// TopK switches the queue to top-k mode, in which it keeps only the k greatest elements with respect to the
+// comparator, see binaryheap.Heap.TopK. When the queue is full, the first element is dropped in favor of a greater
+// value and a value that is not greater is discarded. Zero or a negative k switches top-k mode off.
+// In a stable queue, of equal elements the ones enqueued first are dropped first.
+func (queue *Queue[T]) TopK(k int) {
+	queue.modCount++
+	if heap, ok := queue.heap.(arrayHeap[T]); ok {
+		heap.TopK(k)
+		return
+	}
+	queue.bound = max(k, 0)
+	queue.push()
 }
 
 // Peek returns top element on the queue without removing it, or nil if queue is empty.
@@ -73,20 +213,50 @@ func (queue *Queue[T]) Size() int {
 // Clear removes all elements from the queue.
 func (queue *Queue[T]) Clear() {
 	queue.heap.Clear()
+	queue.modCount++
 }
 
 // Values returns all elements in the queue.
+// Values of a stable queue are returned in dequeue order, which sorts them in O(n log n).
 func (queue *Queue[T]) Values() []T {
 	return queue.heap.Values()
 }
 
+// Stable returns true if the queue dequeues elements of equal priority in the order they were enqueued.
+func (queue *Queue[T]) Stable() bool {
+	_, stable := queue.heap.(*stableHeap[T])
+	return stable
+}
+
+// SetFailFast enables or disables the checks of iterators for modifications of the queue done while iterating,
+// see containers.ConcurrentModificationError. Checks are enabled by default.
+func (queue *Queue[T]) SetFailFast(enabled bool) {
+	queue.failFastDisabled = !enabled
+}
+
 // String returns a string representation of container
 func (queue *Queue[T]) String() string {
 	str := "PriorityQueue\n"
 	values := make([]string, queue.heap.Size(), queue.heap.Size())
-	for index, value := range queue.heap.Values() {
+	for index, value := range queue.Values() {
 		values[index] = fmt.Sprintf("%v", value)
 	}
 	str += strings.Join(values, ", ")
 	return str
 }
+
+// push adds the values to the heap and, in top-k mode of a heap without one, pops the elements beyond the bound.
+func (queue *Queue[T]) push(values ...T) {
+	queue.heap.Push(values...)
+	for queue.bound > 0 && queue.heap.Size() > queue.bound {
+		queue.heap.Pop()
+	}
+}
+
+// elements returns all elements in the queue, in dequeue order if the queue is stable and in heap order otherwise.
+func (queue *Queue[T]) elements() []T {
+	if heap, ok := queue.heap.(*binaryheap.Heap[T]); ok {
+		return heap.Elements()
+	}
+	return queue.heap.Values()
+}
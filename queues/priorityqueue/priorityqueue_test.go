@@ -9,8 +9,17 @@ import (
 	"encoding/json"
 	"fmt"
 	"math/rand"
+	"slices"
+	"strconv"
 	"strings"
 	"testing"
+
+	"github.com/emirpasic/gods/v2/testutils"
+	"github.com/emirpasic/gods/v2/trees"
+	"github.com/emirpasic/gods/v2/trees/binaryheap"
+	"github.com/emirpasic/gods/v2/trees/fibonacciheap"
+	"github.com/emirpasic/gods/v2/trees/pairingheap"
+	"github.com/emirpasic/gods/v2/utils"
 )
 
 type Element struct {
@@ -153,6 +162,254 @@ func TestBinaryQueueRandom(t *testing.T) {
 	}
 }
 
+func TestBinaryQueueNewFromSliceAndMerge(t *testing.T) {
+	queue := NewFromSlice([]Element{{1, "a"}, {3, "c"}}, byPriority)
+	other := NewFromSlice([]Element{{2, "b"}, {4, "d"}}, byPriority)
+	queue.Merge(other)
+
+	if actualValue := other.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	for _, expectedValue := range []Element{{4, "d"}, {3, "c"}, {2, "b"}, {1, "a"}} {
+		if actualValue, ok := queue.Dequeue(); actualValue != expectedValue || !ok {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+}
+
+func TestBinaryQueueEnqueueDequeue(t *testing.T) {
+	queue := New[int]()
+	if actualValue := queue.EnqueueDequeue(2); actualValue != 2 {
+		t.Errorf("Got %v expected %v", actualValue, 2)
+	}
+	if actualValue, ok := queue.DequeueEnqueue(4); actualValue != 0 || ok {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+	queue.Enqueue(6)
+	if actualValue := queue.EnqueueDequeue(5); actualValue != 4 {
+		t.Errorf("Got %v expected %v", actualValue, 4)
+	}
+	if actualValue, ok := queue.DequeueEnqueue(1); actualValue != 5 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 5)
+	}
+	if actualValue, expectedValue := queue.Values(), []int{1, 6}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestBinaryQueueFix(t *testing.T) {
+	a, b, c := &Element{1, "a"}, &Element{2, "b"}, &Element{3, "c"}
+	queue := NewWith(func(x, y *Element) int { return byPriority(*x, *y) })
+	queue.Enqueue(a)
+	queue.Enqueue(b)
+	queue.Enqueue(c)
+
+	a.priority = 5
+	queue.Fix(queue.IndexOf(a))
+	for _, expectedValue := range []*Element{a, c, b} {
+		if actualValue, ok := queue.Dequeue(); actualValue != expectedValue || !ok {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+}
+
+func TestBinaryQueueTopK(t *testing.T) {
+	queue := New[int]()
+	queue.TopK(3)
+	for _, value := range []int{5, 1, 9, 3, 7, 2, 8} {
+		queue.Enqueue(value)
+	}
+	for _, expectedValue := range []int{7, 8, 9} {
+		if actualValue, ok := queue.Dequeue(); actualValue != expectedValue || !ok {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+}
+
+func TestQueueWithHeap(t *testing.T) {
+	for _, heap := range []trees.Heap[int]{pairingheap.New[int](), fibonacciheap.New[int]()} {
+		queue := NewWithHeap(heap)
+		for _, value := range []int{5, 1, 9, 3} {
+			queue.Enqueue(value)
+		}
+		queue.Merge(NewFromSlice([]int{7, 2}, cmp.Compare[int]))
+		if actualValue, ok := queue.Peek(); actualValue != 1 || !ok {
+			t.Errorf("Got %v expected %v", actualValue, 1)
+		}
+		if actualValue := queue.EnqueueDequeue(0); actualValue != 0 {
+			t.Errorf("Got %v expected %v", actualValue, 0)
+		}
+		if actualValue, ok := queue.DequeueEnqueue(8); actualValue != 1 || !ok {
+			t.Errorf("Got %v expected %v", actualValue, 1)
+		}
+		if actualValue := queue.IndexOf(8); actualValue != -1 {
+			t.Errorf("Got %v expected %v", actualValue, -1)
+		}
+		if actualValue, expectedValue := queue.Size(), 6; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		testutils.SameElements(t, queue.Values(), []int{2, 3, 5, 7, 8, 9})
+
+		queue.TopK(4)
+		queue.Enqueue(4)
+		queue.Enqueue(10)
+		for _, expectedValue := range []int{7, 8, 9, 10} {
+			if actualValue, ok := queue.Dequeue(); actualValue != expectedValue || !ok {
+				t.Errorf("Got %v expected %v", actualValue, expectedValue)
+			}
+		}
+		if actualValue := queue.Empty(); actualValue != true {
+			t.Errorf("Got %v expected %v", actualValue, true)
+		}
+	}
+}
+
+func TestStableQueue(t *testing.T) {
+	queue := NewStableWith(byPriority)
+	if actualValue := queue.Stable(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := NewWith(byPriority).Stable(); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	// elements of a queue that is not stable are not wrapped with sequence numbers
+	if _, ok := NewWith(byPriority).heap.(*binaryheap.Heap[Element]); !ok {
+		t.Errorf("Got %T expected %T", NewWith(byPriority).heap, &binaryheap.Heap[Element]{})
+	}
+
+	for i := 0; i < 20; i++ {
+		queue.Enqueue(Element{i % 3, strconv.Itoa(i)})
+	}
+	var expected []Element
+	for _, priority := range []int{2, 1, 0} {
+		for i := priority; i < 20; i += 3 {
+			expected = append(expected, Element{priority, strconv.Itoa(i)})
+		}
+	}
+
+	if actualValue := queue.Values(); !slices.Equal(actualValue, expected) {
+		t.Errorf("Got %v expected %v", actualValue, expected)
+	}
+	var values []Element
+	for it := queue.Iterator(); it.Next(); {
+		values = append(values, it.Value())
+	}
+	if actualValue := values; !slices.Equal(actualValue, expected) {
+		t.Errorf("Got %v expected %v", actualValue, expected)
+	}
+	values = values[:0]
+	for !queue.Empty() {
+		value, _ := queue.Dequeue()
+		values = append(values, value)
+	}
+	if actualValue := values; !slices.Equal(actualValue, expected) {
+		t.Errorf("Got %v expected %v", actualValue, expected)
+	}
+}
+
+func TestStableQueueOperations(t *testing.T) {
+	queue := NewStable[int]()
+	other := NewStable[int]()
+
+	queue.Enqueue(1)
+	queue.Enqueue(2)
+	other.Enqueue(1)
+	other.Enqueue(1)
+	queue.Merge(other)
+	queue.Enqueue(1)
+
+	if actualValue, expectedValue := queue.Values(), []int{1, 1, 1, 1, 2}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue := other.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+
+	// the value enqueued after the equal top element is not dequeued before it
+	if actualValue := queue.EnqueueDequeue(1); actualValue != 1 {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+	if actualValue, expectedValue := queue.Size(), 5; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestStableQueueFIFO(t *testing.T) {
+	queue := NewStableWith(byPriority)
+	other := NewStableWith(byPriority)
+	queue.Enqueue(Element{1, "a"})
+	other.Enqueue(Element{1, "b"})
+	other.Enqueue(Element{2, "c"})
+	other.Enqueue(Element{1, "d"})
+	queue.Enqueue(Element{1, "e"})
+	queue.Merge(other)
+	queue.Enqueue(Element{1, "f"})
+	queue.Merge(NewFromSlice([]Element{{1, "g"}}, byPriority))
+
+	if actualValue, expectedValue := queue.EnqueueDequeue(Element{2, "h"}), (Element{2, "c"}); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, ok := queue.DequeueEnqueue(Element{1, "i"}); actualValue != (Element{2, "h"}) || !ok {
+		t.Errorf("Got %v expected %v", actualValue, Element{2, "h"})
+	}
+	expected := []Element{{1, "a"}, {1, "e"}, {1, "b"}, {1, "d"}, {1, "f"}, {1, "g"}, {1, "i"}}
+	if actualValue := queue.Values(); !slices.Equal(actualValue, expected) {
+		t.Errorf("Got %v expected %v", actualValue, expected)
+	}
+
+	queue.TopK(3)
+	if actualValue := queue.Values(); !slices.Equal(actualValue, expected[4:]) {
+		t.Errorf("Got %v expected %v", actualValue, expected[4:])
+	}
+}
+
+func TestStableQueueSerialization(t *testing.T) {
+	queue := NewStable[string]()
+	queue.Enqueue("b")
+	queue.Enqueue("c")
+	queue.Enqueue("a")
+	bytes, err := queue.ToJSON()
+	if actualValue, expectedValue := string(bytes), `["a","b","c"]`; actualValue != expectedValue || err != nil {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// equal elements keep the order of the input
+	byLength := NewStableWith(func(a, b string) int { return cmp.Compare(len(a), len(b)) })
+	err = json.Unmarshal([]byte(`["ccc","b","a","dd","c"]`), &byLength)
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := byLength.Values(), []string{"b", "a", "c", "dd", "ccc"}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	bytes, err = byLength.ToJSON()
+	if actualValue, expectedValue := string(bytes), `["b","a","c","dd","ccc"]`; actualValue != expectedValue || err != nil {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestStableQueueIteratorFailFast(t *testing.T) {
+	queue := NewStable[int]()
+	queue.Enqueue(2)
+	queue.Enqueue(1)
+	it := queue.Iterator()
+	it.Next()
+	queue.Enqueue(3)
+	testutils.ConcurrentModificationPanic(t, func() { it.Next() })
+
+	queue.SetFailFast(false)
+	it.Begin()
+	it.Next()
+	queue.Dequeue()
+	if actualValue := it.Value(); actualValue != 2 {
+		t.Errorf("Got %v expected %v", actualValue, 2)
+	}
+	it.Next()
+	if actualValue := it.Value(); actualValue != 3 {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+}
+
 func TestBinaryQueueIteratorOnEmpty(t *testing.T) {
 	queue := New[int]()
 	it := queue.Iterator()
@@ -420,6 +677,61 @@ func TestBinaryQueueIteratorPrevTo(t *testing.T) {
 	}
 }
 
+func TestBinaryQueueIteratorFailFast(t *testing.T) {
+	queue := New[int]()
+	queue.Enqueue(1)
+	queue.Enqueue(2)
+	it := queue.Iterator()
+	it.Next()
+	queue.Enqueue(3)
+	testutils.ConcurrentModificationPanic(t, func() { it.Next() })
+	testutils.ConcurrentModificationPanic(t, func() { it.Prev() })
+
+	// reset iterator is valid again
+	count := 0
+	for it.Begin(); it.Next(); {
+		count++
+	}
+	if actualValue, expectedValue := count, 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// a value that is dequeued right away and dequeueing from an empty queue do not modify the queue
+	it.First()
+	queue.EnqueueDequeue(0)
+	queue.Merge(New[int]())
+	it.Next() // no panic
+	empty := New[int]()
+	emptyIt := empty.Iterator()
+	emptyIt.End()
+	empty.Dequeue()
+	emptyIt.Prev() // no panic
+
+	queue.SetFailFast(false)
+	it.Begin()
+	it.Next()
+	queue.Enqueue(4)
+	it.Next() // no panic
+}
+
+func TestBinaryQueueComparatorCombinators(t *testing.T) {
+	// highest priority first, then by name
+	queue := NewWith[Element](utils.ThenComparing(
+		utils.Reverse(utils.ComparingBy(func(e Element) int { return e.priority })),
+		utils.ComparingByFunc(func(e Element) string { return e.name }, utils.NaturalStringComparator),
+	))
+	queue.Enqueue(Element{1, "task10"})
+	queue.Enqueue(Element{2, "task10"})
+	queue.Enqueue(Element{1, "task2"})
+	queue.Enqueue(Element{2, "task9"})
+	expected := []Element{{2, "task9"}, {2, "task10"}, {1, "task2"}, {1, "task10"}}
+	for _, expectedValue := range expected {
+		if actualValue, ok := queue.Dequeue(); actualValue != expectedValue || !ok {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+}
+
 func TestBinaryQueueSerialization(t *testing.T) {
 	queue := New[string]()
 
@@ -5,6 +5,8 @@
 package priorityqueue
 
 import (
+	"encoding/json"
+
 	"github.com/emirpasic/gods/v2/containers"
 )
 
@@ -13,13 +15,22 @@ var _ containers.JSONSerializer = (*Queue[int])(nil)
 var _ containers.JSONDeserializer = (*Queue[int])(nil)
 
 // ToJSON outputs the JSON representation of the queue.
+// A stable queue outputs its elements in dequeue order.
 func (queue *Queue[T]) ToJSON() ([]byte, error) {
-	return queue.heap.ToJSON()
+	return json.Marshal(queue.elements())
 }
 
 // FromJSON populates the queue from the input JSON representation.
+// A stable queue counts the elements as enqueued in the order of the input, so that equal elements are dequeued in
+// that order.
 func (queue *Queue[T]) FromJSON(data []byte) error {
-	return queue.heap.FromJSON(data)
+	var values []T
+	err := json.Unmarshal(data, &values)
+	if err == nil {
+		queue.Clear()
+		queue.push(values...)
+	}
+	return err
 }
 
 // UnmarshalJSON @implements json.Unmarshaler
@@ -0,0 +1,117 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package priorityqueue
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/emirpasic/gods/v2/trees/binaryheap"
+	"github.com/emirpasic/gods/v2/utils"
+)
+
+// stableHeap is the heap of a stable queue.
+//
+// It keeps the elements with their insertion sequence numbers in a binary heap and orders equal elements by them,
+// so that equal elements are popped in the order they were pushed.
+type stableHeap[T comparable] struct {
+	heap *binaryheap.Heap[entry[T]]
+	seq  uint64 // insertion sequence number of the last pushed element
+}
+
+// entry is an element of a stable queue with its insertion sequence number.
+type entry[T comparable] struct {
+	value T
+	seq   uint64
+}
+
+func newStableHeap[T comparable](comparator utils.Comparator[T]) *stableHeap[T] {
+	return &stableHeap[T]{heap: binaryheap.NewWith(func(a, b entry[T]) int {
+		if result := comparator(a.value, b.value); result != 0 {
+			return result
+		}
+		return cmp.Compare(a.seq, b.seq)
+	})}
+}
+
+// Push adds values onto the heap, in order.
+func (heap *stableHeap[T]) Push(values ...T) {
+	entries := make([]entry[T], len(values))
+	for i, value := range values {
+		entries[i] = heap.entry(value)
+	}
+	heap.heap.Push(entries...)
+}
+
+// Pop removes the top element of the heap and returns it.
+func (heap *stableHeap[T]) Pop() (value T, ok bool) {
+	e, ok := heap.heap.Pop()
+	return e.value, ok
+}
+
+// Peek returns the top element of the heap without removing it.
+func (heap *stableHeap[T]) Peek() (value T, ok bool) {
+	e, ok := heap.heap.Peek()
+	return e.value, ok
+}
+
+// PushPop pushes the value onto the heap and then pops the top element and returns it, see binaryheap.Heap.PushPop.
+func (heap *stableHeap[T]) PushPop(value T) T {
+	return heap.heap.PushPop(heap.entry(value)).value
+}
+
+// Replace pops the top element and then pushes the value onto the heap, see binaryheap.Heap.Replace.
+func (heap *stableHeap[T]) Replace(value T) (top T, ok bool) {
+	e, ok := heap.heap.Replace(heap.entry(value))
+	return e.value, ok
+}
+
+// Fix restores the heap order after the element at the index changed its ordering, see binaryheap.Heap.Fix.
+func (heap *stableHeap[T]) Fix(index int) {
+	heap.heap.Fix(index)
+}
+
+// IndexOf returns the position of the value in the heap's underlying array, or -1 if the value is not in the heap.
+func (heap *stableHeap[T]) IndexOf(value T) int {
+	return slices.IndexFunc(heap.heap.Elements(), func(e entry[T]) bool { return e.value == value })
+}
+
+// TopK switches the heap to top-k mode, see binaryheap.Heap.TopK.
+func (heap *stableHeap[T]) TopK(k int) {
+	heap.heap.TopK(k)
+}
+
+// Empty returns true if heap does not contain any elements.
+func (heap *stableHeap[T]) Empty() bool {
+	return heap.heap.Empty()
+}
+
+// Size returns number of elements within the heap.
+func (heap *stableHeap[T]) Size() int {
+	return heap.heap.Size()
+}
+
+// Clear removes all elements from the heap and restarts the sequence numbers.
+func (heap *stableHeap[T]) Clear() {
+	heap.heap.Clear()
+	heap.seq = 0
+}
+
+// Values returns all elements in the order they are popped, which sorts them in O(n log n).
+func (heap *stableHeap[T]) Values() []T {
+	entries := slices.Clone(heap.heap.Elements())
+	slices.SortFunc(entries, heap.heap.Comparator)
+	values := make([]T, len(entries))
+	for i, e := range entries {
+		values[i] = e.value
+	}
+	return values
+}
+
+// entry returns the value as the next entry of the heap.
+func (heap *stableHeap[T]) entry(value T) entry[T] {
+	heap.seq++
+	return entry[T]{value: value, seq: heap.seq}
+}
@@ -0,0 +1,110 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package slidingwindow
+
+import (
+	"math"
+
+	"github.com/emirpasic/gods/v2/maps/treemap"
+)
+
+// sketch counts values in logarithmically sized buckets to approximate their quantiles with a bounded relative error.
+// Unlike most quantile sketches it supports removing values, which a sliding window needs.
+type sketch struct {
+	gamma    float64                // ratio of the bounds of a bucket
+	logGamma float64                // logarithm of gamma
+	positive *treemap.Map[int, int] // counts of positive values by bucket index
+	negative *treemap.Map[int, int] // counts of negative values by bucket index of their absolute value
+	zero     int                    // count of zero values
+	count    int                    // count of all values
+}
+
+func newSketch(accuracy float64) *sketch {
+	gamma := (1 + accuracy) / (1 - accuracy)
+	return &sketch{
+		gamma:    gamma,
+		logGamma: math.Log(gamma),
+		positive: treemap.New[int, int](),
+		negative: treemap.New[int, int](),
+	}
+}
+
+// add counts the value.
+func (sketch *sketch) add(value float64) {
+	switch {
+	case value > 0:
+		increment(sketch.positive, sketch.index(value), 1)
+	case value < 0:
+		increment(sketch.negative, sketch.index(-value), 1)
+	default:
+		sketch.zero++
+	}
+	sketch.count++
+}
+
+// remove uncounts the value, which must have been added before.
+func (sketch *sketch) remove(value float64) {
+	switch {
+	case value > 0:
+		increment(sketch.positive, sketch.index(value), -1)
+	case value < 0:
+		increment(sketch.negative, sketch.index(-value), -1)
+	default:
+		sketch.zero--
+	}
+	sketch.count--
+}
+
+// quantile returns the approximate value at the quantile q in [0, 1] of the counted values, at least one.
+func (sketch *sketch) quantile(q float64) float64 {
+	rank := int(q * float64(sketch.count-1))
+	seen := 0
+	// negative values from the greatest absolute value on
+	it := sketch.negative.Iterator()
+	for it.End(); it.Prev(); {
+		if seen += it.Value(); seen > rank {
+			return -sketch.value(it.Key())
+		}
+	}
+	if seen += sketch.zero; seen > rank {
+		return 0
+	}
+	it = sketch.positive.Iterator()
+	for it.Next() {
+		if seen += it.Value(); seen > rank {
+			return sketch.value(it.Key())
+		}
+	}
+	return 0
+}
+
+// clear removes all counts.
+func (sketch *sketch) clear() {
+	sketch.positive.Clear()
+	sketch.negative.Clear()
+	sketch.zero = 0
+	sketch.count = 0
+}
+
+// index returns the bucket of the positive value, the bucket i holds values in (gamma^(i-1), gamma^i].
+func (sketch *sketch) index(value float64) int {
+	return int(math.Ceil(math.Log(value) / sketch.logGamma))
+}
+
+// value returns the value representing the bucket, whose relative error to any value in the bucket is bounded by
+// the accuracy.
+func (sketch *sketch) value(index int) float64 {
+	return 2 * math.Exp(float64(index)*sketch.logGamma) / (sketch.gamma + 1)
+}
+
+// increment adds the delta to the count of the bucket, removing buckets that become empty.
+func increment(counts *treemap.Map[int, int], index int, delta int) {
+	count, _ := counts.Get(index)
+	if count += delta; count == 0 {
+		counts.Remove(index)
+	} else {
+		counts.Put(index, count)
+	}
+}
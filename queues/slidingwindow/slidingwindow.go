@@ -0,0 +1,264 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package slidingwindow implements aggregations over sliding windows of numeric samples.
+//
+// A Window holds the last n samples added to it, a TimeWindow holds the samples added within the last duration.
+// Both keep the samples in circular buffers and maintain their aggregates as samples enter and leave the window:
+// Sum and Mean in O(1), Min and Max in O(1) amortized through monotonic deques, and approximate quantiles through a
+// sketch of logarithmically sized buckets whose relative error is bounded by a configurable accuracy.
+//
+// Structures are not thread safe.
+//
+// References: https://en.wikipedia.org/wiki/Moving_average, https://arxiv.org/abs/1908.10693
+package slidingwindow
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/emirpasic/gods/v2/containers"
+	"github.com/emirpasic/gods/v2/queues/circularbuffer"
+)
+
+// Assert Container implementation
+var _ containers.Container[int] = (*Window[int])(nil)
+
+// DefaultAccuracy is the relative error of quantiles of windows created without an explicit accuracy.
+const DefaultAccuracy = 0.01
+
+// Number is the constraint of the samples of a window.
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
+
+// Window holds the last samples added to it, up to a maximum number
+type Window[T Number] struct {
+	aggregate[T]
+}
+
+// New instantiates a new empty window holding up to the size number of last samples.
+func New[T Number](size int) *Window[T] {
+	return NewWith[T](size, DefaultAccuracy)
+}
+
+// NewWith instantiates a new empty window holding up to the size number of last samples, whose quantiles have a
+// relative error of at most the accuracy, e.g. 0.01 for 1%. Panics if the accuracy is not between 0 and 1.
+func NewWith[T Number](size int, accuracy float64) *Window[T] {
+	return &Window[T]{aggregate: newAggregate[T](size, accuracy)}
+}
+
+// Add adds the sample to the window, evicting the oldest sample if the window is full.
+func (window *Window[T]) Add(value T) {
+	if window.samples.Full() {
+		window.remove()
+	}
+	window.add(value)
+}
+
+// Sum returns the sum of the samples in the window, or 0 if the window is empty.
+func (window *Window[T]) Sum() T {
+	return window.sum
+}
+
+// Mean returns the arithmetic mean of the samples in the window.
+// Second return parameter is true, unless the window is empty.
+func (window *Window[T]) Mean() (float64, bool) {
+	return window.mean()
+}
+
+// Min returns the least sample in the window.
+// Second return parameter is true, unless the window is empty.
+func (window *Window[T]) Min() (T, bool) {
+	return window.min()
+}
+
+// Max returns the greatest sample in the window.
+// Second return parameter is true, unless the window is empty.
+func (window *Window[T]) Max() (T, bool) {
+	return window.max()
+}
+
+// Quantile returns an approximation of the sample at the quantile q of the window, e.g. the median for 0.5 or the
+// 99th percentile for 0.99, whose relative error is at most the accuracy of the window. Quantiles 0 and 1 are exact.
+// Second return parameter is true, unless the window is empty or q is not between 0 and 1.
+func (window *Window[T]) Quantile(q float64) (float64, bool) {
+	return window.quantile(q)
+}
+
+// Full returns true if the window holds its maximum number of samples.
+func (window *Window[T]) Full() bool {
+	return window.samples.Full()
+}
+
+// MaxSize returns the maximum number of samples the window holds.
+func (window *Window[T]) MaxSize() int {
+	return window.samples.MaxSize()
+}
+
+// Empty returns true if window does not contain any samples.
+func (window *Window[T]) Empty() bool {
+	return window.samples.Empty()
+}
+
+// Size returns number of samples within the window.
+func (window *Window[T]) Size() int {
+	return window.samples.Size()
+}
+
+// Clear removes all samples from the window.
+func (window *Window[T]) Clear() {
+	window.clear()
+}
+
+// Values returns all samples in the window, the oldest first.
+func (window *Window[T]) Values() []T {
+	return window.values()
+}
+
+// String returns a string representation of container
+func (window *Window[T]) String() string {
+	return window.string("Window")
+}
+
+// sample is a value in a window with the sequence number and the time it was added at.
+type sample[T Number] struct {
+	value T
+	seq   uint64
+	time  int64 // Unix time in nanoseconds, zero in count based windows
+}
+
+// aggregate maintains the samples of a window and their aggregates.
+type aggregate[T Number] struct {
+	samples   *circularbuffer.Queue[sample[T]]
+	minima    *circularbuffer.Queue[sample[T]] // samples that are the minimum of all later samples, increasing
+	maxima    *circularbuffer.Queue[sample[T]] // samples that are the maximum of all later samples, decreasing
+	sketch    *sketch
+	seq       uint64 // sequence number of the last added sample
+	sum       T
+	removed   int   // samples removed since the sum was computed from scratch
+	timestamp int64 // time of the sample to be added next
+}
+
+func newAggregate[T Number](size int, accuracy float64) aggregate[T] {
+	if !(accuracy > 0 && accuracy < 1) {
+		panic(fmt.Sprintf("slidingwindow: accuracy %v is not between 0 and 1", accuracy))
+	}
+	return aggregate[T]{
+		samples: circularbuffer.New[sample[T]](size),
+		minima:  circularbuffer.New[sample[T]](size),
+		maxima:  circularbuffer.New[sample[T]](size),
+		sketch:  newSketch(accuracy),
+	}
+}
+
+// add adds the sample, the buffers must not be full.
+func (aggregate *aggregate[T]) add(value T) {
+	aggregate.seq++
+	s := sample[T]{value: value, seq: aggregate.seq, time: aggregate.timestamp}
+	aggregate.samples.Enqueue(s)
+	aggregate.sum += value
+	for last, ok := back(aggregate.minima); ok && last.value > value; last, ok = back(aggregate.minima) {
+		aggregate.minima.PopBack()
+	}
+	aggregate.minima.Enqueue(s)
+	for last, ok := back(aggregate.maxima); ok && last.value < value; last, ok = back(aggregate.maxima) {
+		aggregate.maxima.PopBack()
+	}
+	aggregate.maxima.Enqueue(s)
+	aggregate.sketch.add(float64(value))
+}
+
+// remove removes the oldest sample.
+func (aggregate *aggregate[T]) remove() {
+	s, ok := aggregate.samples.Dequeue()
+	if !ok {
+		return
+	}
+	if first, _ := aggregate.minima.Peek(); first.seq == s.seq {
+		aggregate.minima.Dequeue()
+	}
+	if first, _ := aggregate.maxima.Peek(); first.seq == s.seq {
+		aggregate.maxima.Dequeue()
+	}
+	aggregate.sketch.remove(float64(s.value))
+	aggregate.sum -= s.value
+	// recompute the sum once per turn of the buffer, so that rounding errors of floats do not accumulate
+	if aggregate.removed++; aggregate.removed >= aggregate.samples.Size() {
+		aggregate.sum = 0
+		for it := aggregate.samples.Iterator(); it.Next(); {
+			aggregate.sum += it.Value().value
+		}
+		aggregate.removed = 0
+	}
+}
+
+// resize changes the capacity of the buffers.
+func (aggregate *aggregate[T]) resize(size int) {
+	aggregate.samples.Resize(size)
+	aggregate.minima.Resize(size)
+	aggregate.maxima.Resize(size)
+}
+
+func (aggregate *aggregate[T]) mean() (float64, bool) {
+	size := aggregate.samples.Size()
+	if size == 0 {
+		return 0, false
+	}
+	return float64(aggregate.sum) / float64(size), true
+}
+
+func (aggregate *aggregate[T]) min() (T, bool) {
+	s, ok := aggregate.minima.Peek()
+	return s.value, ok
+}
+
+func (aggregate *aggregate[T]) max() (T, bool) {
+	s, ok := aggregate.maxima.Peek()
+	return s.value, ok
+}
+
+func (aggregate *aggregate[T]) quantile(q float64) (float64, bool) {
+	if aggregate.samples.Empty() || !(q >= 0 && q <= 1) {
+		return 0, false
+	}
+	minimum, _ := aggregate.min()
+	maximum, _ := aggregate.max()
+	value := aggregate.sketch.quantile(q)
+	return min(max(value, float64(minimum)), float64(maximum)), true
+}
+
+func (aggregate *aggregate[T]) clear() {
+	aggregate.samples.Clear()
+	aggregate.minima.Clear()
+	aggregate.maxima.Clear()
+	aggregate.sketch.clear()
+	aggregate.sum = 0
+	aggregate.removed = 0
+}
+
+func (aggregate *aggregate[T]) values() []T {
+	values := make([]T, 0, aggregate.samples.Size())
+	for it := aggregate.samples.Iterator(); it.Next(); {
+		values = append(values, it.Value().value)
+	}
+	return values
+}
+
+func (aggregate *aggregate[T]) string(name string) string {
+	str := name + "\n"
+	values := []string{}
+	for _, value := range aggregate.values() {
+		values = append(values, fmt.Sprintf("%v", value))
+	}
+	str += strings.Join(values, ", ")
+	return str
+}
+
+// back returns the last sample of the buffer.
+func back[T Number](queue *circularbuffer.Queue[sample[T]]) (sample[T], bool) {
+	return queue.Get(queue.Size() - 1)
+}
@@ -0,0 +1,277 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package slidingwindow
+
+import (
+	"math"
+	"math/rand"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/emirpasic/gods/v2/queues/delayqueue"
+)
+
+func TestWindowAdd(t *testing.T) {
+	window := New[int](3)
+
+	if actualValue := window.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue, ok := window.Mean(); actualValue != 0 || ok {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+	if actualValue, ok := window.Min(); actualValue != 0 || ok {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+	if actualValue, ok := window.Max(); actualValue != 0 || ok {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+	if actualValue, ok := window.Quantile(0.5); actualValue != 0 || ok {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+
+	tests := []struct {
+		value, sum, min, max int
+		mean                 float64
+		values               []int
+	}{
+		{5, 5, 5, 5, 5, []int{5}},
+		{1, 6, 1, 5, 3, []int{5, 1}},
+		{3, 9, 1, 5, 3, []int{5, 1, 3}},
+		{4, 8, 1, 4, 8.0 / 3, []int{1, 3, 4}},
+		{2, 9, 2, 4, 3, []int{3, 4, 2}},
+		{7, 13, 2, 7, 13.0 / 3, []int{4, 2, 7}},
+		{7, 16, 2, 7, 16.0 / 3, []int{2, 7, 7}},
+		{6, 20, 6, 7, 20.0 / 3, []int{7, 7, 6}},
+	}
+	for _, test := range tests {
+		window.Add(test.value)
+		if actualValue := window.Sum(); actualValue != test.sum {
+			t.Errorf("Got %v expected %v", actualValue, test.sum)
+		}
+		if actualValue, ok := window.Mean(); actualValue != test.mean || !ok {
+			t.Errorf("Got %v expected %v", actualValue, test.mean)
+		}
+		if actualValue, ok := window.Min(); actualValue != test.min || !ok {
+			t.Errorf("Got %v expected %v", actualValue, test.min)
+		}
+		if actualValue, ok := window.Max(); actualValue != test.max || !ok {
+			t.Errorf("Got %v expected %v", actualValue, test.max)
+		}
+		if actualValue := window.Values(); !slices.Equal(actualValue, test.values) {
+			t.Errorf("Got %v expected %v", actualValue, test.values)
+		}
+	}
+	if actualValue := window.Full(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := window.MaxSize(); actualValue != 3 {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+
+	window.Clear()
+	if actualValue := window.Size(); actualValue != 0 {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+	if actualValue := window.Sum(); actualValue != 0 {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+	window.Add(-1)
+	if actualValue, ok := window.Max(); actualValue != -1 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, -1)
+	}
+}
+
+func TestWindowQuantile(t *testing.T) {
+	window := New[float64](100)
+	for i := 1; i <= 100; i++ {
+		window.Add(float64(i))
+	}
+
+	tests := []struct {
+		q     float64
+		value float64
+		ok    bool
+	}{
+		{0, 1, true},
+		{0.5, 50, true},
+		{0.9, 90, true},
+		{0.99, 99, true},
+		{1, 100, true},
+		{-0.1, 0, false},
+		{1.1, 0, false},
+		{math.NaN(), 0, false},
+	}
+	for _, test := range tests {
+		actualValue, ok := window.Quantile(test.q)
+		if ok != test.ok || math.Abs(actualValue-test.value) > DefaultAccuracy*test.value {
+			t.Errorf("Got %v %v expected %v %v", actualValue, ok, test.value, test.ok)
+		}
+	}
+
+	// quantiles 0 and 1 are exact
+	if actualValue, _ := window.Quantile(0); actualValue != 1 {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+	if actualValue, _ := window.Quantile(1); actualValue != 100 {
+		t.Errorf("Got %v expected %v", actualValue, 100)
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("Got no panic expected a panic for an invalid accuracy")
+		}
+	}()
+	NewWith[int](10, 1)
+}
+
+func TestWindowRandom(t *testing.T) {
+	const size = 50
+	const accuracy = 0.02
+	window := NewWith[float64](size, accuracy)
+	var samples []float64
+
+	rand.Seed(3)
+	for i := 0; i < 10000; i++ {
+		value := math.Round(rand.NormFloat64()*1000) / 10
+		if rand.Intn(10) == 0 {
+			value = 0
+		}
+		window.Add(value)
+		if samples = append(samples, value); len(samples) > size {
+			samples = samples[1:]
+		}
+
+		sorted := slices.Clone(samples)
+		slices.Sort(sorted)
+		var sum float64
+		for _, sample := range samples {
+			sum += sample
+		}
+		if actualValue := window.Sum(); math.Abs(actualValue-sum) > 1e-6 {
+			t.Fatalf("Got %v expected %v", actualValue, sum)
+		}
+		if actualValue, _ := window.Min(); actualValue != sorted[0] {
+			t.Fatalf("Got %v expected %v", actualValue, sorted[0])
+		}
+		if actualValue, _ := window.Max(); actualValue != sorted[len(sorted)-1] {
+			t.Fatalf("Got %v expected %v", actualValue, sorted[len(sorted)-1])
+		}
+		for _, q := range []float64{0.1, 0.25, 0.5, 0.75, 0.95} {
+			expectedValue := sorted[int(q*float64(len(sorted)-1))]
+			if actualValue, _ := window.Quantile(q); math.Abs(actualValue-expectedValue) > accuracy*math.Abs(expectedValue)+1e-9 {
+				t.Fatalf("Got %v expected %v for quantile %v of %v", actualValue, expectedValue, q, sorted)
+			}
+		}
+	}
+}
+
+func TestTimeWindow(t *testing.T) {
+	clock := delayqueue.NewManualClock(time.Date(2015, time.January, 1, 0, 0, 0, 0, time.UTC))
+	window := NewTimeWindowWith[int](time.Minute, DefaultAccuracy, clock)
+
+	if actualValue := window.Duration(); actualValue != time.Minute {
+		t.Errorf("Got %v expected %v", actualValue, time.Minute)
+	}
+
+	window.Add(5)
+	clock.Advance(30 * time.Second)
+	window.Add(3)
+	window.Add(4)
+	if actualValue := window.Sum(); actualValue != 12 {
+		t.Errorf("Got %v expected %v", actualValue, 12)
+	}
+	if actualValue, ok := window.Max(); actualValue != 5 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 5)
+	}
+
+	// the first sample leaves the window after a minute
+	clock.Advance(30 * time.Second)
+	if actualValue := window.Values(); !slices.Equal(actualValue, []int{3, 4}) {
+		t.Errorf("Got %v expected %v", actualValue, []int{3, 4})
+	}
+	if actualValue, ok := window.Max(); actualValue != 4 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 4)
+	}
+	if actualValue, ok := window.Mean(); actualValue != 3.5 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 3.5)
+	}
+	if actualValue, ok := window.Min(); actualValue != 3 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+	if actualValue, ok := window.Quantile(0.5); actualValue != 3 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+
+	clock.Advance(30 * time.Second)
+	if actualValue := window.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := window.Sum(); actualValue != 0 {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+
+	// grows with a burst of samples and shrinks once they expired
+	for i := 0; i < 1000; i++ {
+		window.Add(i)
+		clock.Advance(time.Millisecond)
+	}
+	if actualValue := window.Size(); actualValue != 1000 {
+		t.Errorf("Got %v expected %v", actualValue, 1000)
+	}
+	if actualValue, ok := window.Min(); actualValue != 0 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+	// samples added a minute ago or earlier expired
+	clock.Advance(time.Minute - 500*time.Millisecond)
+	if actualValue := window.Size(); actualValue != 499 {
+		t.Errorf("Got %v expected %v", actualValue, 499)
+	}
+	if actualValue, ok := window.Min(); actualValue != 501 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 501)
+	}
+	clock.Advance(time.Minute)
+	for i := 0; i < 10; i++ {
+		window.Add(i)
+	}
+	if actualValue := window.samples.MaxSize(); actualValue > 64 {
+		t.Errorf("Got %v expected at most %v", actualValue, 64)
+	}
+	if actualValue := window.Sum(); actualValue != 45 {
+		t.Errorf("Got %v expected %v", actualValue, 45)
+	}
+
+	window.Clear()
+	if actualValue := window.Size(); actualValue != 0 {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+}
+
+func TestWindowString(t *testing.T) {
+	window := New[int](3)
+	window.Add(1)
+	if !strings.HasPrefix(window.String(), "Window") {
+		t.Errorf("String should start with container name")
+	}
+	timeWindow := NewTimeWindow[int](time.Minute)
+	timeWindow.Add(1)
+	if !strings.HasPrefix(timeWindow.String(), "TimeWindow") {
+		t.Errorf("String should start with container name")
+	}
+}
+
+func benchmarkAdd(b *testing.B, window *Window[float64], size int) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			window.Add(float64(n % 1000))
+		}
+	}
+}
+
+func BenchmarkWindowAdd100000(b *testing.B) {
+	benchmarkAdd(b, New[float64](1000), 100000)
+}
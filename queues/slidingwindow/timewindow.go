@@ -0,0 +1,149 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package slidingwindow
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/emirpasic/gods/v2/containers"
+)
+
+// Assert Container implementation
+var _ containers.Container[int] = (*TimeWindow[int])(nil)
+
+// minCapacity is the initial and the least capacity of the buffers of a time window.
+const minCapacity = 16
+
+// Clock provides the current time to time windows.
+// SystemClock is used by default, a clock that only moves when told to, e.g. a delayqueue.ManualClock, makes tests
+// deterministic.
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+}
+
+// SystemClock is the clock of the system, based on time.Now.
+var SystemClock Clock = systemClock{}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+// TimeWindow holds the samples added within the last duration
+type TimeWindow[T Number] struct {
+	aggregate[T]
+	duration time.Duration
+	clock    Clock
+}
+
+// NewTimeWindow instantiates a new empty window holding the samples added within the last duration, as told by the
+// system clock.
+func NewTimeWindow[T Number](duration time.Duration) *TimeWindow[T] {
+	return NewTimeWindowWith[T](duration, DefaultAccuracy, SystemClock)
+}
+
+// NewTimeWindowWith instantiates a new empty window holding the samples added within the last duration, as told by
+// the clock, whose quantiles have a relative error of at most the accuracy, e.g. 0.01 for 1%.
+// Panics if the duration is not positive or the accuracy is not between 0 and 1.
+func NewTimeWindowWith[T Number](duration time.Duration, accuracy float64, clock Clock) *TimeWindow[T] {
+	if duration <= 0 {
+		panic(fmt.Sprintf("slidingwindow: duration %v is not positive", duration))
+	}
+	return &TimeWindow[T]{aggregate: newAggregate[T](minCapacity, accuracy), duration: duration, clock: clock}
+}
+
+// Add adds the sample to the window at the current time, evicting the samples that are older than the duration.
+func (window *TimeWindow[T]) Add(value T) {
+	window.expire()
+	if window.samples.Full() {
+		window.resize(2 * window.samples.MaxSize())
+	}
+	window.add(value)
+}
+
+// Sum returns the sum of the samples in the window, or 0 if the window is empty.
+func (window *TimeWindow[T]) Sum() T {
+	window.expire()
+	return window.sum
+}
+
+// Mean returns the arithmetic mean of the samples in the window.
+// Second return parameter is true, unless the window is empty.
+func (window *TimeWindow[T]) Mean() (float64, bool) {
+	window.expire()
+	return window.mean()
+}
+
+// Min returns the least sample in the window.
+// Second return parameter is true, unless the window is empty.
+func (window *TimeWindow[T]) Min() (T, bool) {
+	window.expire()
+	return window.min()
+}
+
+// Max returns the greatest sample in the window.
+// Second return parameter is true, unless the window is empty.
+func (window *TimeWindow[T]) Max() (T, bool) {
+	window.expire()
+	return window.max()
+}
+
+// Quantile returns an approximation of the sample at the quantile q of the window, see Window.Quantile.
+// Second return parameter is true, unless the window is empty or q is not between 0 and 1.
+func (window *TimeWindow[T]) Quantile(q float64) (float64, bool) {
+	window.expire()
+	return window.quantile(q)
+}
+
+// Duration returns the time for which samples stay in the window.
+func (window *TimeWindow[T]) Duration() time.Duration {
+	return window.duration
+}
+
+// Empty returns true if window does not contain any samples.
+func (window *TimeWindow[T]) Empty() bool {
+	return window.Size() == 0
+}
+
+// Size returns number of samples within the window.
+func (window *TimeWindow[T]) Size() int {
+	window.expire()
+	return window.samples.Size()
+}
+
+// Clear removes all samples from the window.
+func (window *TimeWindow[T]) Clear() {
+	window.clear()
+	window.resize(minCapacity)
+}
+
+// Values returns all samples in the window, the oldest first.
+func (window *TimeWindow[T]) Values() []T {
+	window.expire()
+	return window.values()
+}
+
+// String returns a string representation of container
+func (window *TimeWindow[T]) String() string {
+	window.expire()
+	return window.string("TimeWindow")
+}
+
+// expire removes the samples that are older than the duration and takes the current time for the next sample.
+func (window *TimeWindow[T]) expire() {
+	now := window.clock.Now().UnixNano()
+	window.timestamp = now
+	cutoff := now - int64(window.duration)
+	for s, ok := window.samples.Peek(); ok && s.time <= cutoff; s, ok = window.samples.Peek() {
+		window.remove()
+	}
+	// release memory after a burst of samples
+	if capacity := window.samples.MaxSize(); capacity > minCapacity && window.samples.Size() <= capacity/4 {
+		window.resize(capacity / 2)
+	}
+}
@@ -0,0 +1,321 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package copyonwriteset implements a set that is safe for concurrent use, for sets that are read much more often
+// than they are modified, e.g. registries of listeners.
+//
+// The elements are held in an immutable snapshot, a hash table for lookups and a slice in insertion-order for
+// iteration. Readers load the current snapshot and read it without locking, while writers, serialized by a mutex,
+// build a new snapshot and atomically swap it in. Every modification thus costs O(n), but reads never wait and
+// iterators see the snapshot of the set they were created on, never failing because of concurrent modifications.
+//
+// Note that insertion-order is not affected if an element is re-inserted into the set.
+//
+// Structure is thread safe.
+//
+// References: http://en.wikipedia.org/wiki/Set_%28abstract_data_type%29, https://en.wikipedia.org/wiki/Copy-on-write
+package copyonwriteset
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/emirpasic/gods/v2/sets"
+)
+
+// Assert Set implementation
+var _ sets.Set[int] = (*Set[int])(nil)
+
+// Set holds the elements in an immutable snapshot that is replaced on every modification
+type Set[T comparable] struct {
+	snapshot atomic.Pointer[snapshot[T]]
+	mutex    sync.Mutex // serializes writers
+}
+
+// snapshot holds the elements of the set at some point, it is never modified once stored.
+type snapshot[T comparable] struct {
+	table  map[T]struct{}
+	values []T // elements in insertion-order
+}
+
+var itemExists = struct{}{}
+
+// New instantiates a new empty set and adds the passed values, if any, to the set
+func New[T comparable](values ...T) *Set[T] {
+	set := &Set[T]{}
+	set.snapshot.Store(newSnapshot([]T{}))
+	if len(values) > 0 {
+		set.Add(values...)
+	}
+	return set
+}
+
+// Add adds the items (one or more) to the set.
+// Note that insertion-order is not affected if an element is re-inserted into the set.
+func (set *Set[T]) Add(items ...T) {
+	set.update(func(current *snapshot[T]) *snapshot[T] {
+		if current.contains(items...) {
+			return nil
+		}
+		values := make([]T, 0, len(current.values)+len(items))
+		return newSnapshot(append(append(values, current.values...), items...))
+	})
+}
+
+// AddIfAbsent adds the item to the set unless it is present.
+// Returns true if the item was added.
+func (set *Set[T]) AddIfAbsent(item T) bool {
+	added := false
+	set.update(func(current *snapshot[T]) *snapshot[T] {
+		if current.contains(item) {
+			return nil
+		}
+		added = true
+		values := make([]T, 0, len(current.values)+1)
+		return newSnapshot(append(append(values, current.values...), item))
+	})
+	return added
+}
+
+// Remove removes the items (one or more) from the set.
+func (set *Set[T]) Remove(items ...T) {
+	removed := make(map[T]struct{}, len(items))
+	for _, item := range items {
+		removed[item] = itemExists
+	}
+	set.filter(func(item T) bool {
+		_, contains := removed[item]
+		return !contains
+	})
+}
+
+// Contains check if items (one or more) are present in the set.
+// All items have to be present in the set for the method to return true.
+// Returns true if no arguments are passed at all, i.e. set is always superset of empty set.
+func (set *Set[T]) Contains(items ...T) bool {
+	return set.load().contains(items...)
+}
+
+// Empty returns true if set does not contain any elements.
+func (set *Set[T]) Empty() bool {
+	return set.Size() == 0
+}
+
+// Size returns number of elements within the set.
+func (set *Set[T]) Size() int {
+	return len(set.load().values)
+}
+
+// Clear clears all values in the set.
+func (set *Set[T]) Clear() {
+	set.update(func(current *snapshot[T]) *snapshot[T] {
+		return newSnapshot([]T{})
+	})
+}
+
+// Values returns all items in the set.
+func (set *Set[T]) Values() []T {
+	return slices.Clone(set.Elements())
+}
+
+// Elements returns the items in the set without copying them.
+// The returned slice is a snapshot of the set: it must not be modified, but it stays valid and unchanged when the
+// set is modified.
+func (set *Set[T]) Elements() []T {
+	values := set.load().values
+	return values[:len(values):len(values)]
+}
+
+// String returns a string representation of container
+func (set *Set[T]) String() string {
+	str := "CopyOnWriteSet\n"
+	items := []string{}
+	for _, item := range set.Elements() {
+		items = append(items, fmt.Sprintf("%v", item))
+	}
+	str += strings.Join(items, ", ")
+	return str
+}
+
+// Intersection returns the intersection between two sets.
+// The new set consists of all elements that are both in "set" and "another", in the order of "set".
+// The other set can be of any implementation of sets.Set.
+// Ref: https://en.wikipedia.org/wiki/Intersection_(set_theory)
+func (set *Set[T]) Intersection(another sets.Set[T]) *Set[T] {
+	var values []T
+	for _, item := range set.Elements() {
+		if another.Contains(item) {
+			values = append(values, item)
+		}
+	}
+	return New(values...)
+}
+
+// Union returns the union of two sets.
+// The new set consists of all elements that are in "set" or "another" (possibly both),
+// elements of "set" come first.
+// The other set can be of any implementation of sets.Set.
+// Ref: https://en.wikipedia.org/wiki/Union_(set_theory)
+func (set *Set[T]) Union(another sets.Set[T]) *Set[T] {
+	return New(append(set.Values(), another.Values()...)...)
+}
+
+// Difference returns the difference between two sets.
+// The new set consists of all elements that are in "set" but not in "another", in the order of "set".
+// The other set can be of any implementation of sets.Set.
+// Ref: https://proofwiki.org/wiki/Definition:Set_Difference
+func (set *Set[T]) Difference(another sets.Set[T]) *Set[T] {
+	var values []T
+	for _, item := range set.Elements() {
+		if !another.Contains(item) {
+			values = append(values, item)
+		}
+	}
+	return New(values...)
+}
+
+// SymmetricDifference returns the symmetric difference between two sets.
+// The new set consists of all elements that are either in "set" or in "another", but not in both,
+// elements of "set" come first.
+// The other set can be of any implementation of sets.Set.
+// Ref: https://en.wikipedia.org/wiki/Symmetric_difference
+func (set *Set[T]) SymmetricDifference(another sets.Set[T]) *Set[T] {
+	current := set.load()
+	var values []T
+	for _, item := range current.values {
+		if !another.Contains(item) {
+			values = append(values, item)
+		}
+	}
+	for _, item := range another.Values() {
+		if !current.contains(item) {
+			values = append(values, item)
+		}
+	}
+	return New(values...)
+}
+
+// IsSubsetOf returns true if all elements of "set" are in "another".
+// Ref: https://en.wikipedia.org/wiki/Subset
+func (set *Set[T]) IsSubsetOf(another sets.Set[T]) bool {
+	values := set.Elements()
+	return len(values) <= another.Size() && another.Contains(values...)
+}
+
+// IsSupersetOf returns true if all elements of "another" are in "set".
+// Ref: https://en.wikipedia.org/wiki/Subset
+func (set *Set[T]) IsSupersetOf(another sets.Set[T]) bool {
+	current := set.load()
+	return len(current.values) >= another.Size() && current.contains(another.Values()...)
+}
+
+// IsDisjoint returns true if "set" and "another" have no elements in common.
+// Ref: https://en.wikipedia.org/wiki/Disjoint_sets
+func (set *Set[T]) IsDisjoint(another sets.Set[T]) bool {
+	current := set.load()
+	// Iterate over smaller set (optimization)
+	if len(current.values) <= another.Size() {
+		for _, item := range current.values {
+			if another.Contains(item) {
+				return false
+			}
+		}
+		return true
+	}
+	for _, item := range another.Values() {
+		if current.contains(item) {
+			return false
+		}
+	}
+	return true
+}
+
+// Equal returns true if "set" and "another" contain exactly the same elements.
+// Insertion-order is not taken into account.
+func (set *Set[T]) Equal(another sets.Set[T]) bool {
+	values := set.Elements()
+	return len(values) == another.Size() && another.Contains(values...)
+}
+
+// UnionWith adds all elements of "another" to the set, appending the new ones in the order of "another".
+func (set *Set[T]) UnionWith(another sets.Set[T]) {
+	set.Add(another.Values()...)
+}
+
+// RetainAll removes all elements from the set that are not in "another".
+// Insertion-order of the remaining elements is preserved.
+func (set *Set[T]) RetainAll(another sets.Set[T]) {
+	retained := newSnapshot(another.Values())
+	set.filter(func(item T) bool { return retained.contains(item) })
+}
+
+// RemoveAll removes all elements from the set that are in "another".
+// Insertion-order of the remaining elements is preserved.
+func (set *Set[T]) RemoveAll(another sets.Set[T]) {
+	removed := newSnapshot(another.Values())
+	set.filter(func(item T) bool { return !removed.contains(item) })
+}
+
+// load returns the current snapshot of the set.
+func (set *Set[T]) load() *snapshot[T] {
+	return set.snapshot.Load()
+}
+
+// update replaces the snapshot by the result of f, which is passed the current snapshot and must not modify it.
+// Writers are serialized, so that f sees the snapshot as last replaced. Returning nil leaves the set as is.
+// Functions of other sets are not called by f, so that writers of different sets never wait for each other.
+func (set *Set[T]) update(f func(current *snapshot[T]) *snapshot[T]) {
+	set.mutex.Lock()
+	defer set.mutex.Unlock()
+	if next := f(set.load()); next != nil {
+		set.snapshot.Store(next)
+	}
+}
+
+// filter keeps only the elements for which keep returns true, preserving their insertion-order.
+func (set *Set[T]) filter(keep func(item T) bool) {
+	set.update(func(current *snapshot[T]) *snapshot[T] {
+		var values []T
+		for i, item := range current.values {
+			if !keep(item) {
+				if values == nil {
+					values = make([]T, i, len(current.values)-1)
+					copy(values, current.values)
+				}
+			} else if values != nil {
+				values = append(values, item)
+			}
+		}
+		if values == nil {
+			return nil
+		}
+		return newSnapshot(values)
+	})
+}
+
+// newSnapshot returns a snapshot of the distinct values, which are owned by the snapshot from then on.
+func newSnapshot[T comparable](values []T) *snapshot[T] {
+	table := make(map[T]struct{}, len(values))
+	distinct := values[:0]
+	for _, item := range values {
+		if _, contains := table[item]; !contains {
+			table[item] = itemExists
+			distinct = append(distinct, item)
+		}
+	}
+	return &snapshot[T]{table: table, values: distinct}
+}
+
+// contains returns true if all items are in the snapshot.
+func (s *snapshot[T]) contains(items ...T) bool {
+	for _, item := range items {
+		if _, contains := s.table[item]; !contains {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,267 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package copyonwriteset
+
+import (
+	"encoding/json"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/emirpasic/gods/v2/sets/hashset"
+)
+
+func TestSetNew(t *testing.T) {
+	set := New(2, 1, 2)
+	if actualValue := set.Size(); actualValue != 2 {
+		t.Errorf("Got %v expected %v", actualValue, 2)
+	}
+	if actualValue, expectedValue := set.Values(), []int{2, 1}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue := New[int]().Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+}
+
+func TestSetAdd(t *testing.T) {
+	set := New[int]()
+	set.Add()
+	set.Add(3)
+	set.Add(1, 3, 2, 1)
+	if actualValue, expectedValue := set.Values(), []int{3, 1, 2}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue := set.AddIfAbsent(2); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if actualValue := set.AddIfAbsent(4); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := set.Contains(1, 4); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := set.Contains(1, 5); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if actualValue := set.Contains(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+}
+
+func TestSetRemove(t *testing.T) {
+	set := New(3, 1, 2, 4)
+	set.Remove()
+	set.Remove(5)
+	set.Remove(1, 4)
+	if actualValue, expectedValue := set.Values(), []int{3, 2}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	set.Add(1)
+	if actualValue, expectedValue := set.Values(), []int{3, 2, 1}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	set.Clear()
+	if actualValue := set.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := set.Contains(3); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+}
+
+func TestSetSnapshot(t *testing.T) {
+	set := New("a", "b")
+	elements := set.Elements()
+	it := set.Iterator()
+
+	set.Remove("a")
+	set.Add("c")
+
+	if actualValue, expectedValue := elements, []string{"a", "b"}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	var values []string
+	for it.Next() {
+		values = append(values, it.Value())
+	}
+	if actualValue, expectedValue := values, []string{"a", "b"}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := set.Values(), []string{"b", "c"}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestSetOperations(t *testing.T) {
+	set := New(1, 2, 3, 4)
+	another := hashset.New(3, 4, 5)
+
+	if actualValue, expectedValue := set.Intersection(another).Values(), []int{3, 4}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := set.Union(another).Values(), []int{1, 2, 3, 4, 5}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := set.Difference(another).Values(), []int{1, 2}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := set.SymmetricDifference(another).Values(), []int{1, 2, 5}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue := New(3, 4).IsSubsetOf(another); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := set.IsSubsetOf(another); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if actualValue := set.IsSupersetOf(hashset.New(1, 4)); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := set.IsDisjoint(another); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if actualValue := set.IsDisjoint(hashset.New(7, 8, 9, 10, 11)); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := set.Equal(hashset.New(4, 3, 2, 1)); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := set.Equal(another); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+
+	set.UnionWith(another)
+	if actualValue, expectedValue := set.Values(), []int{1, 2, 3, 4, 5}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	set.RemoveAll(hashset.New(2, 4))
+	if actualValue, expectedValue := set.Values(), []int{1, 3, 5}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	set.RetainAll(another)
+	if actualValue, expectedValue := set.Values(), []int{3, 5}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	set.RetainAll(set) // the set itself is a valid argument
+	if actualValue, expectedValue := set.Values(), []int{3, 5}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestSetEnumerableAndIterator(t *testing.T) {
+	set := New("c", "a", "b")
+
+	if actualValue, expectedValue := set.Map(func(index int, value string) string { return strings.ToUpper(value) }).Values(), []string{"C", "A", "B"}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := set.Select(func(index int, value string) bool { return value != "a" }).Values(), []string{"c", "b"}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if index, value := set.Find(func(index int, value string) bool { return value == "b" }); index != 2 || value != "b" {
+		t.Errorf("Got %v, %v expected %v, %v", index, value, 2, "b")
+	}
+
+	it := set.Iterator()
+	if actualValue := it.Last(); actualValue != true || it.Index() != 2 || it.Value() != "b" {
+		t.Errorf("Got %v, %v, %v expected %v, %v, %v", actualValue, it.Index(), it.Value(), true, 2, "b")
+	}
+	if actualValue := it.Prev(); actualValue != true || it.Value() != "a" {
+		t.Errorf("Got %v, %v expected %v, %v", actualValue, it.Value(), true, "a")
+	}
+}
+
+func TestSetConcurrent(t *testing.T) {
+	const writers, count = 4, 200
+	set := New[int]()
+
+	var wg sync.WaitGroup
+	for w := 0; w < writers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < count; i++ {
+				set.Add(w*count + i)
+				set.AddIfAbsent(w*count + i)
+				if i%2 == 1 {
+					set.Remove(w*count + i - 1)
+				}
+			}
+		}(w)
+	}
+
+	done := make(chan struct{})
+	var readers sync.WaitGroup
+	readers.Add(1)
+	go func() {
+		defer readers.Done()
+		for {
+			select {
+			case <-done:
+				return
+			default:
+			}
+			seen := make(map[int]bool)
+			for it := set.Iterator(); it.Next(); {
+				if seen[it.Value()] {
+					t.Errorf("Got %v twice", it.Value())
+				}
+				seen[it.Value()] = true
+			}
+			set.Contains(1, 2, 3)
+		}
+	}()
+	wg.Wait()
+	close(done)
+	readers.Wait()
+
+	if actualValue, expectedValue := set.Size(), writers*count/2; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	for i := 0; i < writers*count; i++ {
+		if actualValue := set.Contains(i); actualValue != (i%2 == 1) {
+			t.Fatalf("Got %v expected %v for %v", actualValue, i%2 == 1, i)
+		}
+	}
+}
+
+func TestSetSerialization(t *testing.T) {
+	set := New("a", "b", "c")
+
+	var err error
+	assert := func() {
+		if actualValue, expectedValue := set.Values(), []string{"a", "b", "c"}; !slices.Equal(actualValue, expectedValue) {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		if err != nil {
+			t.Errorf("Got error %v", err)
+		}
+	}
+
+	assert()
+
+	bytes, err := set.ToJSON()
+	assert()
+
+	err = set.FromJSON(bytes)
+	assert()
+
+	bytes, err = json.Marshal([]interface{}{"a", "b", "c", set})
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+
+	err = json.Unmarshal([]byte(`["a","b","c","a"]`), &set)
+	assert()
+}
+
+func TestSetString(t *testing.T) {
+	c := New[int]()
+	c.Add(1)
+	if !strings.HasPrefix(c.String(), "CopyOnWriteSet") {
+		t.Errorf("String should start with container name")
+	}
+}
@@ -100,19 +100,20 @@ func (set *Set[T]) String() string {
 
 // Intersection returns the intersection between two sets.
 // The new set consists of all elements that are both in "set" and "another".
+// The other set can be of any implementation of sets.Set.
 // Ref: https://en.wikipedia.org/wiki/Intersection_(set_theory)
-func (set *Set[T]) Intersection(another *Set[T]) *Set[T] {
+func (set *Set[T]) Intersection(another sets.Set[T]) *Set[T] {
 	result := New[T]()
 
 	// Iterate over smaller set (optimization)
 	if set.Size() <= another.Size() {
 		for item := range set.items {
-			if _, contains := another.items[item]; contains {
+			if another.Contains(item) {
 				result.Add(item)
 			}
 		}
 	} else {
-		for item := range another.items {
+		for _, item := range another.Values() {
 			if _, contains := set.items[item]; contains {
 				result.Add(item)
 			}
@@ -124,31 +125,123 @@ func (set *Set[T]) Intersection(another *Set[T]) *Set[T] {
 
 // Union returns the union of two sets.
 // The new set consists of all elements that are in "set" or "another" (possibly both).
+// The other set can be of any implementation of sets.Set.
 // Ref: https://en.wikipedia.org/wiki/Union_(set_theory)
-func (set *Set[T]) Union(another *Set[T]) *Set[T] {
+func (set *Set[T]) Union(another sets.Set[T]) *Set[T] {
 	result := New[T]()
 
 	for item := range set.items {
 		result.Add(item)
 	}
-	for item := range another.items {
-		result.Add(item)
-	}
+	result.Add(another.Values()...)
 
 	return result
 }
 
 // Difference returns the difference between two sets.
 // The new set consists of all elements that are in "set" but not in "another".
+// The other set can be of any implementation of sets.Set.
 // Ref: https://proofwiki.org/wiki/Definition:Set_Difference
-func (set *Set[T]) Difference(another *Set[T]) *Set[T] {
+func (set *Set[T]) Difference(another sets.Set[T]) *Set[T] {
 	result := New[T]()
 
 	for item := range set.items {
-		if _, contains := another.items[item]; !contains {
+		if !another.Contains(item) {
 			result.Add(item)
 		}
 	}
 
 	return result
 }
+
+// SymmetricDifference returns the symmetric difference between two sets.
+// The new set consists of all elements that are either in "set" or in "another", but not in both.
+// The other set can be of any implementation of sets.Set.
+// Ref: https://en.wikipedia.org/wiki/Symmetric_difference
+func (set *Set[T]) SymmetricDifference(another sets.Set[T]) *Set[T] {
+	result := set.Difference(another)
+
+	for _, item := range another.Values() {
+		if _, contains := set.items[item]; !contains {
+			result.Add(item)
+		}
+	}
+
+	return result
+}
+
+// IsSubsetOf returns true if all elements of "set" are in "another".
+// Ref: https://en.wikipedia.org/wiki/Subset
+func (set *Set[T]) IsSubsetOf(another sets.Set[T]) bool {
+	if set.Size() > another.Size() {
+		return false
+	}
+	for item := range set.items {
+		if !another.Contains(item) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsSupersetOf returns true if all elements of "another" are in "set".
+// Ref: https://en.wikipedia.org/wiki/Subset
+func (set *Set[T]) IsSupersetOf(another sets.Set[T]) bool {
+	if set.Size() < another.Size() {
+		return false
+	}
+	return set.Contains(another.Values()...)
+}
+
+// IsDisjoint returns true if "set" and "another" have no elements in common.
+// Ref: https://en.wikipedia.org/wiki/Disjoint_sets
+func (set *Set[T]) IsDisjoint(another sets.Set[T]) bool {
+	// Iterate over smaller set (optimization)
+	if set.Size() <= another.Size() {
+		for item := range set.items {
+			if another.Contains(item) {
+				return false
+			}
+		}
+		return true
+	}
+	for _, item := range another.Values() {
+		if _, contains := set.items[item]; contains {
+			return false
+		}
+	}
+	return true
+}
+
+// Equal returns true if "set" and "another" contain exactly the same elements.
+func (set *Set[T]) Equal(another sets.Set[T]) bool {
+	return set.Size() == another.Size() && set.IsSubsetOf(another)
+}
+
+// UnionWith adds all elements of "another" to the set.
+func (set *Set[T]) UnionWith(another sets.Set[T]) {
+	set.Add(another.Values()...)
+}
+
+// RetainAll removes all elements from the set that are not in "another".
+func (set *Set[T]) RetainAll(another sets.Set[T]) {
+	for item := range set.items {
+		if !another.Contains(item) {
+			delete(set.items, item)
+		}
+	}
+}
+
+// RemoveAll removes all elements from the set that are in "another".
+func (set *Set[T]) RemoveAll(another sets.Set[T]) {
+	// Iterate over smaller set (optimization)
+	if set.Size() <= another.Size() {
+		for item := range set.items {
+			if another.Contains(item) {
+				delete(set.items, item)
+			}
+		}
+		return
+	}
+	set.Remove(another.Values()...)
+}
@@ -5,9 +5,12 @@
 package hashset
 
 import (
-	"encoding/json"
 	"strings"
 	"testing"
+
+	"encoding/json"
+	"github.com/emirpasic/gods/v2/sets/treeset"
+	"github.com/emirpasic/gods/v2/testutils"
 )
 
 func TestSetNew(t *testing.T) {
@@ -192,6 +195,122 @@ func TestSetDifference(t *testing.T) {
 	}
 }
 
+func TestSetSymmetricDifference(t *testing.T) {
+	set := New[string]("a", "b", "c", "d")
+	another := New[string]("c", "d", "e", "f")
+
+	symmetricDifference := set.SymmetricDifference(another)
+	testutils.SameElements(t, symmetricDifference.Values(), []string{"a", "b", "e", "f"})
+
+	symmetricDifference = set.SymmetricDifference(set)
+	if actualValue, expectedValue := symmetricDifference.Size(), 0; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestSetSubsetAndSuperset(t *testing.T) {
+	set := New[string]("a", "b")
+	another := New[string]("a", "b", "c")
+	empty := New[string]()
+
+	tests := []struct {
+		subset, superset *Set[string]
+		expected         bool
+	}{
+		{set, another, true},
+		{another, set, false},
+		{set, set, true},
+		{empty, set, true},
+		{set, empty, false},
+		{New[string]("a", "x"), another, false},
+	}
+	for _, test := range tests {
+		if actualValue := test.subset.IsSubsetOf(test.superset); actualValue != test.expected {
+			t.Errorf("Got %v expected %v for %v subset of %v", actualValue, test.expected, test.subset.Values(), test.superset.Values())
+		}
+		if actualValue := test.superset.IsSupersetOf(test.subset); actualValue != test.expected {
+			t.Errorf("Got %v expected %v for %v superset of %v", actualValue, test.expected, test.superset.Values(), test.subset.Values())
+		}
+	}
+}
+
+func TestSetIsDisjointAndEqual(t *testing.T) {
+	set := New[string]("a", "b", "c")
+
+	if actualValue := set.IsDisjoint(New[string]("d", "e")); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := set.IsDisjoint(New[string]("c", "d", "e", "f")); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if actualValue := set.IsDisjoint(New[string]()); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := set.Equal(New[string]("c", "b", "a")); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := set.Equal(New[string]("a", "b", "d")); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if actualValue := set.Equal(New[string]("a", "b")); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+}
+
+func TestSetInPlaceOperations(t *testing.T) {
+	set := New[string]("a", "b", "c")
+	set.UnionWith(New[string]("c", "d"))
+	testutils.SameElements(t, set.Values(), []string{"a", "b", "c", "d"})
+
+	set.RetainAll(New[string]("b", "c", "d", "e"))
+	testutils.SameElements(t, set.Values(), []string{"b", "c", "d"})
+
+	set.RemoveAll(New[string]("a", "d"))
+	testutils.SameElements(t, set.Values(), []string{"b", "c"})
+
+	set.RemoveAll(set)
+	if actualValue := set.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+}
+
+func TestSetWithOtherImplementation(t *testing.T) {
+	set := New[string]("a", "b", "c", "d")
+	another := treeset.New[string]("c", "d", "e", "f")
+
+	intersection := set.Intersection(another)
+	testutils.SameElements(t, intersection.Values(), []string{"c", "d"})
+
+	union := set.Union(another)
+	testutils.SameElements(t, union.Values(), []string{"a", "b", "c", "d", "e", "f"})
+
+	difference := set.Difference(another)
+	testutils.SameElements(t, difference.Values(), []string{"a", "b"})
+
+	symmetricDifference := set.SymmetricDifference(another)
+	testutils.SameElements(t, symmetricDifference.Values(), []string{"a", "b", "e", "f"})
+
+	if actualValue := set.IsSubsetOf(treeset.New[string]("a", "b", "c", "d", "e")); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := set.IsSupersetOf(treeset.New[string]("a", "d")); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := set.IsDisjoint(another); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if actualValue := set.Equal(treeset.New[string]("d", "c", "b", "a")); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+
+	set.RetainAll(another)
+	testutils.SameElements(t, set.Values(), []string{"c", "d"})
+	set.UnionWith(another)
+	testutils.SameElements(t, set.Values(), []string{"c", "d", "e", "f"})
+	set.RemoveAll(treeset.New[string]("c", "f"))
+	testutils.SameElements(t, set.Values(), []string{"d", "e"})
+}
+
 func benchmarkContains(b *testing.B, set *Set[int], size int) {
 	for i := 0; i < b.N; i++ {
 		for n := 0; n < size; n++ {
@@ -27,6 +27,7 @@ func (set *Set[T]) Iterator() Iterator[T] {
 // If Next() was called for the first time, then it will point the iterator to the first element if it exists.
 // Modifies the state of the iterator.
 func (iterator *Iterator[T]) Next() bool {
+	defer renameModification()
 	return iterator.iterator.Next()
 }
 
@@ -34,6 +35,7 @@ func (iterator *Iterator[T]) Next() bool {
 // If Prev() returns true, then previous element's index and value can be retrieved by Index() and Value().
 // Modifies the state of the iterator.
 func (iterator *Iterator[T]) Prev() bool {
+	defer renameModification()
 	return iterator.iterator.Prev()
 }
 
@@ -65,6 +67,7 @@ func (iterator *Iterator[T]) End() {
 // If First() returns true, then first element's index and value can be retrieved by Index() and Value().
 // Modifies the state of the iterator.
 func (iterator *Iterator[T]) First() bool {
+	defer renameModification()
 	return iterator.iterator.First()
 }
 
@@ -72,6 +75,7 @@ func (iterator *Iterator[T]) First() bool {
 // If Last() returns true, then last element's index and value can be retrieved by Index() and Value().
 // Modifies the state of the iterator.
 func (iterator *Iterator[T]) Last() bool {
+	defer renameModification()
 	return iterator.iterator.Last()
 }
 
@@ -102,3 +106,14 @@ func (iterator *Iterator[T]) PrevTo(f func(index int, value T) bool) bool {
 	}
 	return false
 }
+
+// renameModification re-panics a concurrent modification of the ordering as a modification of the set, so that
+// the error names the container the caller iterates. Other panics are passed on as they are.
+func renameModification() {
+	if r := recover(); r != nil {
+		if _, ok := r.(*containers.ConcurrentModificationError); ok {
+			panic(&containers.ConcurrentModificationError{Container: "LinkedHashSet"})
+		}
+		panic(r)
+	}
+}
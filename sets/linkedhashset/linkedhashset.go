@@ -17,12 +17,14 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/emirpasic/gods/v2/containers"
 	"github.com/emirpasic/gods/v2/lists/doublylinkedlist"
 	"github.com/emirpasic/gods/v2/sets"
 )
 
 // Assert Set implementation
 var _ sets.Set[int] = (*Set[int])(nil)
+var _ containers.FailFast = (*Set[int])(nil)
 
 // Set holds elements in go's native map
 type Set[T comparable] struct {
@@ -105,6 +107,12 @@ func (set *Set[T]) Values() []T {
 	return values
 }
 
+// SetFailFast enables or disables the checks of iterators for modifications of the set done while iterating,
+// see containers.ConcurrentModificationError. Checks are enabled by default.
+func (set *Set[T]) SetFailFast(enabled bool) {
+	set.ordering.SetFailFast(enabled)
+}
+
 // String returns a string representation of container
 func (set *Set[T]) String() string {
 	str := "LinkedHashSet\n"
@@ -118,23 +126,15 @@ func (set *Set[T]) String() string {
 }
 
 // Intersection returns the intersection between two sets.
-// The new set consists of all elements that are both in "set" and "another".
+// The new set consists of all elements that are both in "set" and "another", in the order of "set".
+// The other set can be of any implementation of sets.Set.
 // Ref: https://en.wikipedia.org/wiki/Intersection_(set_theory)
-func (set *Set[T]) Intersection(another *Set[T]) *Set[T] {
+func (set *Set[T]) Intersection(another sets.Set[T]) *Set[T] {
 	result := New[T]()
 
-	// Iterate over smaller set (optimization)
-	if set.Size() <= another.Size() {
-		for item := range set.table {
-			if _, contains := another.table[item]; contains {
-				result.Add(item)
-			}
-		}
-	} else {
-		for item := range another.table {
-			if _, contains := set.table[item]; contains {
-				result.Add(item)
-			}
+	for it := set.Iterator(); it.Next(); {
+		if another.Contains(it.Value()) {
+			result.Add(it.Value())
 		}
 	}
 
@@ -142,32 +142,128 @@ func (set *Set[T]) Intersection(another *Set[T]) *Set[T] {
 }
 
 // Union returns the union of two sets.
-// The new set consists of all elements that are in "set" or "another" (possibly both).
+// The new set consists of all elements that are in "set" or "another" (possibly both),
+// elements of "set" come first.
+// The other set can be of any implementation of sets.Set.
 // Ref: https://en.wikipedia.org/wiki/Union_(set_theory)
-func (set *Set[T]) Union(another *Set[T]) *Set[T] {
+func (set *Set[T]) Union(another sets.Set[T]) *Set[T] {
 	result := New[T]()
 
-	for item := range set.table {
-		result.Add(item)
-	}
-	for item := range another.table {
-		result.Add(item)
+	for it := set.Iterator(); it.Next(); {
+		result.Add(it.Value())
 	}
+	result.Add(another.Values()...)
 
 	return result
 }
 
 // Difference returns the difference between two sets.
-// The new set consists of all elements that are in "set" but not in "another".
+// The new set consists of all elements that are in "set" but not in "another", in the order of "set".
+// The other set can be of any implementation of sets.Set.
 // Ref: https://proofwiki.org/wiki/Definition:Set_Difference
-func (set *Set[T]) Difference(another *Set[T]) *Set[T] {
+func (set *Set[T]) Difference(another sets.Set[T]) *Set[T] {
 	result := New[T]()
 
-	for item := range set.table {
-		if _, contains := another.table[item]; !contains {
+	for it := set.Iterator(); it.Next(); {
+		if !another.Contains(it.Value()) {
+			result.Add(it.Value())
+		}
+	}
+
+	return result
+}
+
+// SymmetricDifference returns the symmetric difference between two sets.
+// The new set consists of all elements that are either in "set" or in "another", but not in both,
+// elements of "set" come first.
+// The other set can be of any implementation of sets.Set.
+// Ref: https://en.wikipedia.org/wiki/Symmetric_difference
+func (set *Set[T]) SymmetricDifference(another sets.Set[T]) *Set[T] {
+	result := set.Difference(another)
+
+	for _, item := range another.Values() {
+		if _, contains := set.table[item]; !contains {
 			result.Add(item)
 		}
 	}
 
 	return result
 }
+
+// IsSubsetOf returns true if all elements of "set" are in "another".
+// Ref: https://en.wikipedia.org/wiki/Subset
+func (set *Set[T]) IsSubsetOf(another sets.Set[T]) bool {
+	if set.Size() > another.Size() {
+		return false
+	}
+	for item := range set.table {
+		if !another.Contains(item) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsSupersetOf returns true if all elements of "another" are in "set".
+// Ref: https://en.wikipedia.org/wiki/Subset
+func (set *Set[T]) IsSupersetOf(another sets.Set[T]) bool {
+	if set.Size() < another.Size() {
+		return false
+	}
+	return set.Contains(another.Values()...)
+}
+
+// IsDisjoint returns true if "set" and "another" have no elements in common.
+// Ref: https://en.wikipedia.org/wiki/Disjoint_sets
+func (set *Set[T]) IsDisjoint(another sets.Set[T]) bool {
+	// Iterate over smaller set (optimization)
+	if set.Size() <= another.Size() {
+		for item := range set.table {
+			if another.Contains(item) {
+				return false
+			}
+		}
+		return true
+	}
+	for _, item := range another.Values() {
+		if _, contains := set.table[item]; contains {
+			return false
+		}
+	}
+	return true
+}
+
+// Equal returns true if "set" and "another" contain exactly the same elements.
+// Insertion-order is not taken into account.
+func (set *Set[T]) Equal(another sets.Set[T]) bool {
+	return set.Size() == another.Size() && set.IsSubsetOf(another)
+}
+
+// UnionWith adds all elements of "another" to the set, appending the new ones in the order of "another".
+func (set *Set[T]) UnionWith(another sets.Set[T]) {
+	set.Add(another.Values()...)
+}
+
+// RetainAll removes all elements from the set that are not in "another".
+// Insertion-order of the remaining elements is preserved.
+func (set *Set[T]) RetainAll(another sets.Set[T]) {
+	set.filter(func(item T) bool { return another.Contains(item) })
+}
+
+// RemoveAll removes all elements from the set that are in "another".
+// Insertion-order of the remaining elements is preserved.
+func (set *Set[T]) RemoveAll(another sets.Set[T]) {
+	set.filter(func(item T) bool { return !another.Contains(item) })
+}
+
+// filter keeps only the elements for which keep returns true, in a single pass over the ordering.
+// The ordering is changed in place, so that iterators notice the modification and fail-fast settings are kept.
+func (set *Set[T]) filter(keep func(item T) bool) {
+	set.ordering.RemoveIf(func(item T) bool {
+		if keep(item) {
+			return false
+		}
+		delete(set.table, item)
+		return true
+	})
+}
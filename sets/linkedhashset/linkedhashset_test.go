@@ -5,10 +5,15 @@
 package linkedhashset
 
 import (
-	"encoding/json"
 	"fmt"
+	"slices"
 	"strings"
 	"testing"
+
+	"encoding/json"
+	"github.com/emirpasic/gods/v2/containers"
+	"github.com/emirpasic/gods/v2/sets/treeset"
+	"github.com/emirpasic/gods/v2/testutils"
 )
 
 func TestSetNew(t *testing.T) {
@@ -437,6 +442,44 @@ func TestSetIteratorPrevTo(t *testing.T) {
 	}
 }
 
+func TestSetIteratorFailFast(t *testing.T) {
+	set := New[int]()
+	set.Add(1)
+	set.Add(2)
+	it := set.Iterator()
+	it.Next()
+	set.Add(3)
+	testutils.ConcurrentModificationPanic(t, func() { it.Next() })
+	testutils.ConcurrentModificationPanic(t, func() { it.Prev() })
+
+	// reset iterator is valid again
+	count := 0
+	for it.Begin(); it.Next(); {
+		count++
+	}
+	if actualValue, expectedValue := count, 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// in-place operations invalidate iterators as well and report the set
+	it.Begin()
+	it.Next()
+	set.RetainAll(New[int](2, 3))
+	defer func() {
+		err, ok := recover().(*containers.ConcurrentModificationError)
+		if !ok || err.Container != "LinkedHashSet" {
+			t.Errorf("Got %v expected %v", err, "LinkedHashSet")
+		}
+
+		set.SetFailFast(false)
+		it.Begin()
+		it.Next()
+		set.RemoveAll(New[int](2))
+		it.Next() // no panic, fail-fast stays disabled after in-place operations
+	}()
+	it.Next()
+}
+
 func TestSetSerialization(t *testing.T) {
 	set := New[string]()
 	set.Add("a", "b", "c")
@@ -548,6 +591,144 @@ func TestSetDifference(t *testing.T) {
 	}
 }
 
+func TestSetSymmetricDifference(t *testing.T) {
+	set := New[string]("a", "b", "c", "d")
+	another := New[string]("c", "d", "e", "f")
+
+	symmetricDifference := set.SymmetricDifference(another)
+	if actualValue, expectedValue := symmetricDifference.Values(), []string{"a", "b", "e", "f"}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	symmetricDifference = set.SymmetricDifference(set)
+	if actualValue, expectedValue := symmetricDifference.Size(), 0; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestSetSubsetAndSuperset(t *testing.T) {
+	set := New[string]("a", "b")
+	another := New[string]("a", "b", "c")
+	empty := New[string]()
+
+	tests := []struct {
+		subset, superset *Set[string]
+		expected         bool
+	}{
+		{set, another, true},
+		{another, set, false},
+		{set, set, true},
+		{empty, set, true},
+		{set, empty, false},
+		{New[string]("a", "x"), another, false},
+	}
+	for _, test := range tests {
+		if actualValue := test.subset.IsSubsetOf(test.superset); actualValue != test.expected {
+			t.Errorf("Got %v expected %v for %v subset of %v", actualValue, test.expected, test.subset.Values(), test.superset.Values())
+		}
+		if actualValue := test.superset.IsSupersetOf(test.subset); actualValue != test.expected {
+			t.Errorf("Got %v expected %v for %v superset of %v", actualValue, test.expected, test.superset.Values(), test.subset.Values())
+		}
+	}
+}
+
+func TestSetIsDisjointAndEqual(t *testing.T) {
+	set := New[string]("a", "b", "c")
+
+	if actualValue := set.IsDisjoint(New[string]("d", "e")); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := set.IsDisjoint(New[string]("c", "d", "e", "f")); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if actualValue := set.IsDisjoint(New[string]()); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := set.Equal(New[string]("c", "b", "a")); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := set.Equal(New[string]("a", "b", "d")); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if actualValue := set.Equal(New[string]("a", "b")); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+}
+
+func TestSetInPlaceOperations(t *testing.T) {
+	set := New[string]("a", "b", "c")
+	set.UnionWith(New[string]("c", "d"))
+	if actualValue, expectedValue := set.Values(), []string{"a", "b", "c", "d"}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	set.RetainAll(New[string]("b", "c", "d", "e"))
+	if actualValue, expectedValue := set.Values(), []string{"b", "c", "d"}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	set.RemoveAll(New[string]("a", "d"))
+	if actualValue, expectedValue := set.Values(), []string{"b", "c"}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	set.RemoveAll(set)
+	if actualValue := set.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+}
+
+func TestSetWithOtherImplementation(t *testing.T) {
+	set := New[string]("a", "b", "c", "d")
+	another := treeset.New[string]("c", "d", "e", "f")
+
+	intersection := set.Intersection(another)
+	if actualValue, expectedValue := intersection.Values(), []string{"c", "d"}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	union := set.Union(another)
+	if actualValue, expectedValue := union.Values(), []string{"a", "b", "c", "d", "e", "f"}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	difference := set.Difference(another)
+	if actualValue, expectedValue := difference.Values(), []string{"a", "b"}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	symmetricDifference := set.SymmetricDifference(another)
+	if actualValue, expectedValue := symmetricDifference.Values(), []string{"a", "b", "e", "f"}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	if actualValue := set.IsSubsetOf(treeset.New[string]("a", "b", "c", "d", "e")); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := set.IsSupersetOf(treeset.New[string]("a", "d")); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := set.IsDisjoint(another); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if actualValue := set.Equal(treeset.New[string]("d", "c", "b", "a")); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+
+	set.RetainAll(another)
+	if actualValue, expectedValue := set.Values(), []string{"c", "d"}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	set.UnionWith(another)
+	if actualValue, expectedValue := set.Values(), []string{"c", "d", "e", "f"}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	set.RemoveAll(treeset.New[string]("c", "f"))
+	if actualValue, expectedValue := set.Values(), []string{"d", "e"}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
 func benchmarkContains(b *testing.B, set *Set[int], size int) {
 	for i := 0; i < b.N; i++ {
 		for n := 0; n < size; n++ {
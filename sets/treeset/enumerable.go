@@ -4,10 +4,7 @@
 
 package treeset
 
-import (
-	"github.com/emirpasic/gods/v2/containers"
-	rbt "github.com/emirpasic/gods/v2/trees/redblacktree"
-)
+import "github.com/emirpasic/gods/v2/containers"
 
 // Assert Enumerable implementation
 var _ containers.EnumerableWithIndex[int] = (*Set[int])(nil)
@@ -23,7 +20,7 @@ func (set *Set[T]) Each(f func(index int, value T)) {
 // Map invokes the given function once for each element and returns a
 // container containing the values returned by the given function.
 func (set *Set[T]) Map(f func(index int, value T) T) *Set[T] {
-	newSet := &Set[T]{tree: rbt.NewWith[T, struct{}](set.tree.Comparator)}
+	newSet := set.newEmpty()
 	iterator := set.Iterator()
 	for iterator.Next() {
 		newSet.Add(f(iterator.Index(), iterator.Value()))
@@ -33,7 +30,7 @@ func (set *Set[T]) Map(f func(index int, value T) T) *Set[T] {
 
 // Select returns a new container containing all elements for which the given function returns a true value.
 func (set *Set[T]) Select(f func(index int, value T) bool) *Set[T] {
-	newSet := &Set[T]{tree: rbt.NewWith[T, struct{}](set.tree.Comparator)}
+	newSet := set.newEmpty()
 	iterator := set.Iterator()
 	for iterator.Next() {
 		if f(iterator.Index(), iterator.Value()) {
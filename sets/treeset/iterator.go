@@ -6,7 +6,7 @@ package treeset
 
 import (
 	"github.com/emirpasic/gods/v2/containers"
-	rbt "github.com/emirpasic/gods/v2/trees/redblacktree"
+	"github.com/emirpasic/gods/v2/trees"
 )
 
 // Assert Iterator implementation
@@ -15,13 +15,13 @@ var _ containers.ReverseIteratorWithIndex[int] = (*Iterator[int])(nil)
 // Iterator returns a stateful iterator whose values can be fetched by an index.
 type Iterator[T comparable] struct {
 	index    int
-	iterator *rbt.Iterator[T, struct{}]
-	tree     *rbt.Tree[T, struct{}]
+	iterator containers.ReverseIteratorWithKey[T, struct{}]
+	tree     trees.OrderedTree[T, struct{}]
 }
 
 // Iterator holding the iterator's state
 func (set *Set[T]) Iterator() Iterator[T] {
-	return Iterator[T]{index: -1, iterator: set.tree.Iterator(), tree: set.tree}
+	return Iterator[T]{index: -1, iterator: set.tree.IteratorWithKey(), tree: set.tree}
 }
 
 // Next moves the iterator to the next element and returns true if there was a next element in the container.
@@ -114,3 +114,146 @@ func (iterator *Iterator[T]) PrevTo(f func(index int, value T) bool) bool {
 	}
 	return false
 }
+
+// Assert Iterator implementation
+var _ containers.ReverseIteratorWithIndex[int] = (*ViewIterator[int])(nil)
+
+// ViewIterator is a stateful iterator over the elements of a view, in the view's order.
+//
+// The iterator moves by looking up the neighbour of the current element, i.e. each step is O(log n) and the
+// iterator stays usable when the backing set is modified while iterating.
+type ViewIterator[T comparable] struct {
+	view     *View[T]
+	value    T
+	index    int
+	position position
+}
+
+type position byte
+
+const (
+	begin, between, end position = 0, 1, 2
+)
+
+// Iterator returns a stateful iterator over the elements of the view, in the view's order.
+func (view *View[T]) Iterator() ViewIterator[T] {
+	return ViewIterator[T]{view: view, index: -1, position: begin}
+}
+
+// Next moves the iterator to the next element and returns true if there was a next element in the view.
+// If Next() returns true, then next element's index and value can be retrieved by Index() and Value().
+// If Next() was called for the first time, then it will point the iterator to the first element if it exists.
+// Modifies the state of the iterator.
+func (iterator *ViewIterator[T]) Next() bool {
+	var value T
+	var found bool
+	switch iterator.position {
+	case begin:
+		value, found = iterator.view.First()
+	case between:
+		value, found = iterator.view.Higher(iterator.value)
+	case end:
+		return false
+	}
+	iterator.index++
+	if !found {
+		iterator.position = end
+		return false
+	}
+	iterator.value, iterator.position = value, between
+	return true
+}
+
+// Prev moves the iterator to the previous element and returns true if there was a previous element in the view.
+// If Prev() returns true, then previous element's index and value can be retrieved by Index() and Value().
+// Modifies the state of the iterator.
+func (iterator *ViewIterator[T]) Prev() bool {
+	var value T
+	var found bool
+	switch iterator.position {
+	case begin:
+		return false
+	case between:
+		value, found = iterator.view.Lower(iterator.value)
+	case end:
+		value, found = iterator.view.Last()
+	}
+	iterator.index--
+	if !found {
+		iterator.position = begin
+		iterator.index = -1
+		return false
+	}
+	iterator.value, iterator.position = value, between
+	return true
+}
+
+// Value returns the current element's value.
+// Does not modify the state of the iterator.
+func (iterator *ViewIterator[T]) Value() T {
+	return iterator.value
+}
+
+// Index returns the current element's index.
+// Does not modify the state of the iterator.
+func (iterator *ViewIterator[T]) Index() int {
+	return iterator.index
+}
+
+// Begin resets the iterator to its initial state (one-before-first)
+// Call Next() to fetch the first element if any.
+func (iterator *ViewIterator[T]) Begin() {
+	iterator.index = -1
+	iterator.position = begin
+}
+
+// End moves the iterator past the last element (one-past-the-end).
+// Call Prev() to fetch the last element if any.
+func (iterator *ViewIterator[T]) End() {
+	iterator.index = iterator.view.Size()
+	iterator.position = end
+}
+
+// First moves the iterator to the first element and returns true if there was a first element in the view.
+// If First() returns true, then first element's index and value can be retrieved by Index() and Value().
+// Modifies the state of the iterator.
+func (iterator *ViewIterator[T]) First() bool {
+	iterator.Begin()
+	return iterator.Next()
+}
+
+// Last moves the iterator to the last element and returns true if there was a last element in the view.
+// If Last() returns true, then last element's index and value can be retrieved by Index() and Value().
+// Modifies the state of the iterator.
+func (iterator *ViewIterator[T]) Last() bool {
+	iterator.End()
+	return iterator.Prev()
+}
+
+// NextTo moves the iterator to the next element from current position that satisfies the condition given by the
+// passed function, and returns true if there was a next element in the view.
+// If NextTo() returns true, then next element's index and value can be retrieved by Index() and Value().
+// Modifies the state of the iterator.
+func (iterator *ViewIterator[T]) NextTo(f func(index int, value T) bool) bool {
+	for iterator.Next() {
+		index, value := iterator.Index(), iterator.Value()
+		if f(index, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// PrevTo moves the iterator to the previous element from current position that satisfies the condition given by the
+// passed function, and returns true if there was a previous element in the view.
+// If PrevTo() returns true, then previous element's index and value can be retrieved by Index() and Value().
+// Modifies the state of the iterator.
+func (iterator *ViewIterator[T]) PrevTo(f func(index int, value T) bool) bool {
+	for iterator.Prev() {
+		index, value := iterator.Index(), iterator.Value()
+		if f(index, value) {
+			return true
+		}
+	}
+	return false
+}
@@ -4,6 +4,9 @@
 
 // Package treeset implements a tree backed by a red-black tree.
 //
+// Any other ordered tree (AVL tree, B-tree, treap, splay tree, ...) can be used as the backing tree instead,
+// see NewWithTree.
+//
 // Structure is not thread safe.
 //
 // Reference: http://en.wikipedia.org/wiki/Set_%28abstract_data_type%29
@@ -15,17 +18,20 @@ import (
 	"reflect"
 	"strings"
 
+	"github.com/emirpasic/gods/v2/containers"
 	"github.com/emirpasic/gods/v2/sets"
+	"github.com/emirpasic/gods/v2/trees"
 	rbt "github.com/emirpasic/gods/v2/trees/redblacktree"
 	"github.com/emirpasic/gods/v2/utils"
 )
 
 // Assert Set implementation
 var _ sets.Set[int] = (*Set[int])(nil)
+var _ containers.FailFast = (*Set[int])(nil)
 
-// Set holds elements in a red-black tree
+// Set holds elements in an ordered tree (red-black tree by default)
 type Set[T comparable] struct {
-	tree *rbt.Tree[T, struct{}]
+	tree trees.OrderedTree[T, struct{}]
 }
 
 var itemExists = struct{}{}
@@ -36,7 +42,13 @@ func New[T cmp.Ordered](values ...T) *Set[T] {
 
 // NewWith instantiates a new empty set with the custom comparator.
 func NewWith[T comparable](comparator utils.Comparator[T], values ...T) *Set[T] {
-	set := &Set[T]{tree: rbt.NewWith[T, struct{}](comparator)}
+	return NewWithTree[T](rbt.NewWith[T, struct{}](comparator), values...)
+}
+
+// NewWithTree instantiates a new set backed by the given ordered tree, e.g. avltree.New[T, struct{}]().
+// The tree should be empty, it is used as is, i.e. its keys are ordered by its comparator.
+func NewWithTree[T comparable](tree trees.OrderedTree[T, struct{}], values ...T) *Set[T] {
+	set := &Set[T]{tree: tree}
 	if len(values) > 0 {
 		set.Add(values...)
 	}
@@ -89,6 +101,12 @@ func (set *Set[T]) Values() []T {
 	return set.tree.Keys()
 }
 
+// SetFailFast enables or disables the checks of iterators for modifications of the set done while iterating,
+// see containers.ConcurrentModificationError. Checks are enabled by default.
+func (set *Set[T]) SetFailFast(enabled bool) {
+	set.tree.SetFailFast(enabled)
+}
+
 // String returns a string representation of container
 func (set *Set[T]) String() string {
 	str := "TreeSet\n"
@@ -100,16 +118,109 @@ func (set *Set[T]) String() string {
 	return str
 }
 
+// First returns the smallest element of the set.
+// Second return parameter is false if the set is empty.
+func (set *Set[T]) First() (value T, found bool) {
+	value, _, found = set.tree.LeftEntry()
+	return value, found
+}
+
+// Last returns the largest element of the set.
+// Second return parameter is false if the set is empty.
+func (set *Set[T]) Last() (value T, found bool) {
+	value, _, found = set.tree.RightEntry()
+	return value, found
+}
+
+// Floor returns the largest element of the set that is smaller than or equal to the given value.
+// Second return parameter is false if there is no such element.
+func (set *Set[T]) Floor(value T) (floor T, found bool) {
+	floor, _, found = set.tree.FloorEntry(value)
+	return floor, found
+}
+
+// Ceiling returns the smallest element of the set that is larger than or equal to the given value.
+// Second return parameter is false if there is no such element.
+func (set *Set[T]) Ceiling(value T) (ceiling T, found bool) {
+	ceiling, _, found = set.tree.CeilingEntry(value)
+	return ceiling, found
+}
+
+// Lower returns the largest element of the set that is strictly smaller than the given value.
+// Second return parameter is false if there is no such element.
+func (set *Set[T]) Lower(value T) (lower T, found bool) {
+	lower, _, found = set.tree.LowerEntry(value)
+	return lower, found
+}
+
+// Higher returns the smallest element of the set that is strictly larger than the given value.
+// Second return parameter is false if there is no such element.
+func (set *Set[T]) Higher(value T) (higher T, found bool) {
+	higher, _, found = set.tree.HigherEntry(value)
+	return higher, found
+}
+
+// PollFirst removes and returns the smallest element of the set.
+// Second return parameter is false if the set is empty.
+func (set *Set[T]) PollFirst() (value T, found bool) {
+	if value, found = set.First(); found {
+		set.tree.Remove(value)
+	}
+	return value, found
+}
+
+// PollLast removes and returns the largest element of the set.
+// Second return parameter is false if the set is empty.
+func (set *Set[T]) PollLast() (value T, found bool) {
+	if value, found = set.Last(); found {
+		set.tree.Remove(value)
+	}
+	return value, found
+}
+
+// SubSet returns a live view of the elements ranging from "from" to "to".
+// Each bound is included in the view if its inclusive flag is true.
+// Changes to the set are visible through the view and vice versa, see View.
+func (set *Set[T]) SubSet(from T, fromInclusive bool, to T, toInclusive bool) *View[T] {
+	return set.view().SubSet(from, fromInclusive, to, toInclusive)
+}
+
+// HeadSet returns a live view of the elements smaller than (or equal to, if inclusive is true) "to".
+// Changes to the set are visible through the view and vice versa, see View.
+func (set *Set[T]) HeadSet(to T, inclusive bool) *View[T] {
+	return set.view().HeadSet(to, inclusive)
+}
+
+// TailSet returns a live view of the elements larger than (or equal to, if inclusive is true) "from".
+// Changes to the set are visible through the view and vice versa, see View.
+func (set *Set[T]) TailSet(from T, inclusive bool) *View[T] {
+	return set.view().TailSet(from, inclusive)
+}
+
+// DescendingSet returns a live view of all elements in reverse order.
+// Changes to the set are visible through the view and vice versa, see View.
+func (set *Set[T]) DescendingSet() *View[T] {
+	return set.view().DescendingSet()
+}
+
 // Intersection returns the intersection between two sets.
 // The new set consists of all elements that are both in "set" and "another".
-// The two sets should have the same comparators, otherwise the result is empty set.
+// The other set can be of any implementation of sets.Set. If it is a tree set,
+// the two sets should have the same comparators, otherwise the result is empty set.
 // Ref: https://en.wikipedia.org/wiki/Intersection_(set_theory)
-func (set *Set[T]) Intersection(another *Set[T]) *Set[T] {
-	result := NewWith(set.tree.Comparator)
+func (set *Set[T]) Intersection(another sets.Set[T]) *Set[T] {
+	result := set.newEmpty()
 
-	setComparator := reflect.ValueOf(set.tree.Comparator)
-	anotherComparator := reflect.ValueOf(another.tree.Comparator)
-	if setComparator.Pointer() != anotherComparator.Pointer() {
+	if other, ok := another.(*Set[T]); ok {
+		if !set.sameComparator(other) {
+			return result
+		}
+		set.merge(other, func(item T, inSet, inAnother bool) bool {
+			if inSet && inAnother {
+				result.Add(item)
+			}
+			return true
+		})
 		return result
 	}
 
@@ -121,9 +232,9 @@ func (set *Set[T]) Intersection(another *Set[T]) *Set[T] {
 			}
 		}
 	} else {
-		for it := another.Iterator(); it.Next(); {
-			if set.Contains(it.Value()) {
-				result.Add(it.Value())
+		for _, item := range another.Values() {
+			if set.Contains(item) {
+				result.Add(item)
 			}
 		}
 	}
@@ -133,37 +244,42 @@ func (set *Set[T]) Intersection(another *Set[T]) *Set[T] {
 
 // Union returns the union of two sets.
 // The new set consists of all elements that are in "set" or "another" (possibly both).
-// The two sets should have the same comparators, otherwise the result is empty set.
+// The other set can be of any implementation of sets.Set. If it is a tree set,
+// the two sets should have the same comparators, otherwise the result is empty set.
 // Ref: https://en.wikipedia.org/wiki/Union_(set_theory)
-func (set *Set[T]) Union(another *Set[T]) *Set[T] {
-	result := NewWith(set.tree.Comparator)
+func (set *Set[T]) Union(another sets.Set[T]) *Set[T] {
+	result := set.newEmpty()
 
-	setComparator := reflect.ValueOf(set.tree.Comparator)
-	anotherComparator := reflect.ValueOf(another.tree.Comparator)
-	if setComparator.Pointer() != anotherComparator.Pointer() {
+	if other, ok := another.(*Set[T]); ok && !set.sameComparator(other) {
 		return result
 	}
 
 	for it := set.Iterator(); it.Next(); {
 		result.Add(it.Value())
 	}
-	for it := another.Iterator(); it.Next(); {
-		result.Add(it.Value())
-	}
+	result.Add(another.Values()...)
 
 	return result
 }
 
 // Difference returns the difference between two sets.
-// The two sets should have the same comparators, otherwise the result is empty set.
 // The new set consists of all elements that are in "set" but not in "another".
+// The other set can be of any implementation of sets.Set. If it is a tree set,
+// the two sets should have the same comparators, otherwise the result is empty set.
 // Ref: https://proofwiki.org/wiki/Definition:Set_Difference
-func (set *Set[T]) Difference(another *Set[T]) *Set[T] {
-	result := NewWith(set.tree.Comparator)
+func (set *Set[T]) Difference(another sets.Set[T]) *Set[T] {
+	result := set.newEmpty()
 
-	setComparator := reflect.ValueOf(set.tree.Comparator)
-	anotherComparator := reflect.ValueOf(another.tree.Comparator)
-	if setComparator.Pointer() != anotherComparator.Pointer() {
+	if other, ok := another.(*Set[T]); ok {
+		if !set.sameComparator(other) {
+			return result
+		}
+		set.merge(other, func(item T, inSet, inAnother bool) bool {
+			if inSet && !inAnother {
+				result.Add(item)
+			}
+			return true
+		})
 		return result
 	}
 
@@ -175,3 +291,190 @@ func (set *Set[T]) Difference(another *Set[T]) *Set[T] {
 
 	return result
 }
+
+// SymmetricDifference returns the symmetric difference between two sets.
+// The new set consists of all elements that are either in "set" or in "another", but not in both.
+// The other set can be of any implementation of sets.Set. If it is a tree set,
+// the two sets should have the same comparators, otherwise the result is empty set.
+// Ref: https://en.wikipedia.org/wiki/Symmetric_difference
+func (set *Set[T]) SymmetricDifference(another sets.Set[T]) *Set[T] {
+	result := set.newEmpty()
+
+	if other, ok := another.(*Set[T]); ok {
+		if !set.sameComparator(other) {
+			return result
+		}
+		set.merge(other, func(item T, inSet, inAnother bool) bool {
+			if inSet != inAnother {
+				result.Add(item)
+			}
+			return true
+		})
+		return result
+	}
+
+	for it := set.Iterator(); it.Next(); {
+		if !another.Contains(it.Value()) {
+			result.Add(it.Value())
+		}
+	}
+	for _, item := range another.Values() {
+		if !set.Contains(item) {
+			result.Add(item)
+		}
+	}
+
+	return result
+}
+
+// IsSubsetOf returns true if all elements of "set" are in "another".
+// Ref: https://en.wikipedia.org/wiki/Subset
+func (set *Set[T]) IsSubsetOf(another sets.Set[T]) bool {
+	if set.Size() > another.Size() {
+		return false
+	}
+	if other, ok := set.ordered(another); ok {
+		subset := true
+		set.merge(other, func(item T, inSet, inAnother bool) bool {
+			subset = !inSet || inAnother
+			return subset
+		})
+		return subset
+	}
+	for it := set.Iterator(); it.Next(); {
+		if !another.Contains(it.Value()) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsSupersetOf returns true if all elements of "another" are in "set".
+// Ref: https://en.wikipedia.org/wiki/Subset
+func (set *Set[T]) IsSupersetOf(another sets.Set[T]) bool {
+	if set.Size() < another.Size() {
+		return false
+	}
+	if other, ok := set.ordered(another); ok {
+		return other.IsSubsetOf(set)
+	}
+	return set.Contains(another.Values()...)
+}
+
+// IsDisjoint returns true if "set" and "another" have no elements in common.
+// Ref: https://en.wikipedia.org/wiki/Disjoint_sets
+func (set *Set[T]) IsDisjoint(another sets.Set[T]) bool {
+	if other, ok := set.ordered(another); ok {
+		disjoint := true
+		set.merge(other, func(item T, inSet, inAnother bool) bool {
+			disjoint = !inSet || !inAnother
+			return disjoint
+		})
+		return disjoint
+	}
+	// Iterate over smaller set (optimization)
+	if set.Size() <= another.Size() {
+		for it := set.Iterator(); it.Next(); {
+			if another.Contains(it.Value()) {
+				return false
+			}
+		}
+		return true
+	}
+	for _, item := range another.Values() {
+		if set.Contains(item) {
+			return false
+		}
+	}
+	return true
+}
+
+// Equal returns true if "set" and "another" contain exactly the same elements.
+func (set *Set[T]) Equal(another sets.Set[T]) bool {
+	return set.Size() == another.Size() && set.IsSubsetOf(another)
+}
+
+// UnionWith adds all elements of "another" to the set.
+func (set *Set[T]) UnionWith(another sets.Set[T]) {
+	set.Add(another.Values()...)
+}
+
+// RetainAll removes all elements from the set that are not in "another".
+func (set *Set[T]) RetainAll(another sets.Set[T]) {
+	var removed []T
+	if other, ok := set.ordered(another); ok {
+		set.merge(other, func(item T, inSet, inAnother bool) bool {
+			if inSet && !inAnother {
+				removed = append(removed, item)
+			}
+			return true
+		})
+	} else {
+		for it := set.Iterator(); it.Next(); {
+			if !another.Contains(it.Value()) {
+				removed = append(removed, it.Value())
+			}
+		}
+	}
+	set.Remove(removed...)
+}
+
+// RemoveAll removes all elements from the set that are in "another".
+func (set *Set[T]) RemoveAll(another sets.Set[T]) {
+	set.Remove(another.Values()...)
+}
+
+// sameComparator returns true if both sets are ordered by the same comparator function.
+func (set *Set[T]) sameComparator(another *Set[T]) bool {
+	setComparator := reflect.ValueOf(set.tree.KeyComparator())
+	anotherComparator := reflect.ValueOf(another.tree.KeyComparator())
+	return setComparator.Pointer() == anotherComparator.Pointer()
+}
+
+// ordered returns "another" as a tree set if it is one ordered by the same comparator as "set".
+func (set *Set[T]) ordered(another sets.Set[T]) (*Set[T], bool) {
+	other, ok := another.(*Set[T])
+	if !ok || !set.sameComparator(other) {
+		return nil, false
+	}
+	return other, true
+}
+
+// merge walks both sets in order at the same time (linear merge) and calls f once for every distinct element
+// with flags telling in which of the sets the element is. The walk stops as soon as f returns false.
+func (set *Set[T]) merge(another *Set[T], f func(item T, inSet, inAnother bool) bool) {
+	comparator := set.tree.KeyComparator()
+	setIterator, anotherIterator := set.tree.IteratorWithKey(), another.tree.IteratorWithKey()
+	hasSet, hasAnother := setIterator.Next(), anotherIterator.Next()
+	for hasSet || hasAnother {
+		var compare int
+		switch {
+		case !hasSet:
+			compare = 1
+		case !hasAnother:
+			compare = -1
+		default:
+			compare = comparator(setIterator.Key(), anotherIterator.Key())
+		}
+		var proceed bool
+		switch {
+		case compare < 0:
+			proceed = f(setIterator.Key(), true, false)
+			hasSet = setIterator.Next()
+		case compare > 0:
+			proceed = f(anotherIterator.Key(), false, true)
+			hasAnother = anotherIterator.Next()
+		default:
+			proceed = f(setIterator.Key(), true, true)
+			hasSet, hasAnother = setIterator.Next(), anotherIterator.Next()
+		}
+		if !proceed {
+			return
+		}
+	}
+}
+
+// newEmpty returns a new empty set backed by the same kind of tree and comparator.
+func (set *Set[T]) newEmpty() *Set[T] {
+	return &Set[T]{tree: set.tree.NewEmpty()}
+}
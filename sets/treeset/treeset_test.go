@@ -5,12 +5,21 @@
 package treeset
 
 import (
-	"encoding/json"
+	"cmp"
 	"fmt"
+	"slices"
 	"strings"
 	"testing"
 
+	"encoding/json"
+	"github.com/emirpasic/gods/v2/sets/hashset"
 	"github.com/emirpasic/gods/v2/testutils"
+	"github.com/emirpasic/gods/v2/trees"
+	"github.com/emirpasic/gods/v2/trees/avltree"
+	"github.com/emirpasic/gods/v2/trees/btree"
+	"github.com/emirpasic/gods/v2/trees/redblacktree"
+	"github.com/emirpasic/gods/v2/trees/splaytree"
+	"github.com/emirpasic/gods/v2/trees/treap"
 )
 
 func TestSetNew(t *testing.T) {
@@ -443,6 +452,32 @@ func TestSetIteratorPrevTo(t *testing.T) {
 	}
 }
 
+func TestSetIteratorFailFast(t *testing.T) {
+	set := New[int]()
+	set.Add(1)
+	set.Add(2)
+	it := set.Iterator()
+	it.Next()
+	set.Add(3)
+	testutils.ConcurrentModificationPanic(t, func() { it.Next() })
+	testutils.ConcurrentModificationPanic(t, func() { it.Prev() })
+
+	// reset iterator is valid again
+	count := 0
+	for it.Begin(); it.Next(); {
+		count++
+	}
+	if actualValue, expectedValue := count, 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	set.SetFailFast(false)
+	it.Begin()
+	it.Next()
+	set.Add(4)
+	it.Next() // no panic
+}
+
 func TestSetSerialization(t *testing.T) {
 	set := New[string]()
 	set.Add("a", "b", "c")
@@ -487,6 +522,56 @@ func TestSetString(t *testing.T) {
 	}
 }
 
+func TestSetWithTree(t *testing.T) {
+	backends := map[string]func() trees.OrderedTree[int, struct{}]{
+		"redblacktree": func() trees.OrderedTree[int, struct{}] { return redblacktree.New[int, struct{}]() },
+		"avltree":      func() trees.OrderedTree[int, struct{}] { return avltree.New[int, struct{}]() },
+		"btree":        func() trees.OrderedTree[int, struct{}] { return btree.New[int, struct{}](3) },
+		"treap":        func() trees.OrderedTree[int, struct{}] { return treap.New[int, struct{}]() },
+		"splaytree":    func() trees.OrderedTree[int, struct{}] { return splaytree.New[int, struct{}]() },
+	}
+	for name, backend := range backends {
+		set := NewWithTree(backend(), 5, 3, 9, 1, 7)
+		set.Add(3, 4)
+		set.Remove(9, 10)
+
+		if actualValue, expectedValue := set.Values(), []int{1, 3, 4, 5, 7}; !slices.Equal(actualValue, expectedValue) {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, expectedValue)
+		}
+		if actualValue := set.Contains(1, 4, 7); actualValue != true {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, true)
+		}
+		if actualValue := set.Contains(1, 9); actualValue != false {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, false)
+		}
+
+		var reversed []int
+		it := set.Iterator()
+		for it.End(); it.Prev(); {
+			reversed = append(reversed, it.Value())
+		}
+		if actualValue, expectedValue := reversed, []int{7, 5, 4, 3, 1}; !slices.Equal(actualValue, expectedValue) {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, expectedValue)
+		}
+
+		another := NewWithTree(backend(), 4, 5, 6)
+		if actualValue, expectedValue := set.Intersection(another).Values(), []int{4, 5}; !slices.Equal(actualValue, expectedValue) {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, expectedValue)
+		}
+		if actualValue, expectedValue := set.Union(another).Values(), []int{1, 3, 4, 5, 6, 7}; !slices.Equal(actualValue, expectedValue) {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, expectedValue)
+		}
+		if actualValue, expectedValue := set.Difference(another).Values(), []int{1, 3, 7}; !slices.Equal(actualValue, expectedValue) {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, expectedValue)
+		}
+
+		mapped := set.Map(func(index int, value int) int { return -value })
+		if actualValue, expectedValue := mapped.Values(), []int{-7, -5, -4, -3, -1}; !slices.Equal(actualValue, expectedValue) {
+			t.Errorf("[%s] Got %v expected %v", name, actualValue, expectedValue)
+		}
+	}
+}
+
 func TestSetIntersection(t *testing.T) {
 	set := New[string]()
 	another := New[string]()
@@ -553,6 +638,369 @@ func TestSetDifference(t *testing.T) {
 	}
 }
 
+func TestSetSymmetricDifference(t *testing.T) {
+	set := New[string]("a", "b", "c", "d")
+	another := New[string]("c", "d", "e", "f")
+
+	symmetricDifference := set.SymmetricDifference(another)
+	if actualValue, expectedValue := symmetricDifference.Values(), []string{"a", "b", "e", "f"}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	symmetricDifference = set.SymmetricDifference(set)
+	if actualValue, expectedValue := symmetricDifference.Size(), 0; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestSetSubsetAndSuperset(t *testing.T) {
+	set := New[string]("a", "b")
+	another := New[string]("a", "b", "c")
+	empty := New[string]()
+
+	tests := []struct {
+		subset, superset *Set[string]
+		expected         bool
+	}{
+		{set, another, true},
+		{another, set, false},
+		{set, set, true},
+		{empty, set, true},
+		{set, empty, false},
+		{New[string]("a", "x"), another, false},
+	}
+	for _, test := range tests {
+		if actualValue := test.subset.IsSubsetOf(test.superset); actualValue != test.expected {
+			t.Errorf("Got %v expected %v for %v subset of %v", actualValue, test.expected, test.subset.Values(), test.superset.Values())
+		}
+		if actualValue := test.superset.IsSupersetOf(test.subset); actualValue != test.expected {
+			t.Errorf("Got %v expected %v for %v superset of %v", actualValue, test.expected, test.superset.Values(), test.subset.Values())
+		}
+	}
+}
+
+func TestSetIsDisjointAndEqual(t *testing.T) {
+	set := New[string]("a", "b", "c")
+
+	if actualValue := set.IsDisjoint(New[string]("d", "e")); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := set.IsDisjoint(New[string]("c", "d", "e", "f")); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if actualValue := set.IsDisjoint(New[string]()); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := set.Equal(New[string]("c", "b", "a")); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := set.Equal(New[string]("a", "b", "d")); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if actualValue := set.Equal(New[string]("a", "b")); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+}
+
+func TestSetInPlaceOperations(t *testing.T) {
+	set := New[string]("a", "b", "c")
+	set.UnionWith(New[string]("c", "d"))
+	if actualValue, expectedValue := set.Values(), []string{"a", "b", "c", "d"}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	set.RetainAll(New[string]("b", "c", "d", "e"))
+	if actualValue, expectedValue := set.Values(), []string{"b", "c", "d"}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	set.RemoveAll(New[string]("a", "d"))
+	if actualValue, expectedValue := set.Values(), []string{"b", "c"}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	set.RemoveAll(set)
+	if actualValue := set.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+}
+
+func TestSetWithOtherImplementation(t *testing.T) {
+	set := New[string]("a", "b", "c", "d")
+	another := hashset.New[string]("c", "d", "e", "f")
+
+	intersection := set.Intersection(another)
+	if actualValue, expectedValue := intersection.Values(), []string{"c", "d"}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	union := set.Union(another)
+	if actualValue, expectedValue := union.Values(), []string{"a", "b", "c", "d", "e", "f"}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	difference := set.Difference(another)
+	if actualValue, expectedValue := difference.Values(), []string{"a", "b"}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	symmetricDifference := set.SymmetricDifference(another)
+	if actualValue, expectedValue := symmetricDifference.Values(), []string{"a", "b", "e", "f"}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	if actualValue := set.IsSubsetOf(hashset.New[string]("a", "b", "c", "d", "e")); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := set.IsSupersetOf(hashset.New[string]("a", "d")); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := set.IsDisjoint(another); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if actualValue := set.Equal(hashset.New[string]("d", "c", "b", "a")); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+
+	set.RetainAll(another)
+	if actualValue, expectedValue := set.Values(), []string{"c", "d"}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	set.UnionWith(another)
+	if actualValue, expectedValue := set.Values(), []string{"c", "d", "e", "f"}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	set.RemoveAll(hashset.New[string]("c", "f"))
+	if actualValue, expectedValue := set.Values(), []string{"d", "e"}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestSetMergeAcrossBackends(t *testing.T) {
+	set := NewWith[int](cmp.Compare[int], 1, 3, 5, 7, 9)
+	another := NewWithTree[int](avltree.NewWith[int, struct{}](cmp.Compare[int]), 3, 4, 5, 10)
+
+	if actualValue, expectedValue := set.Intersection(another).Values(), []int{3, 5}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := set.SymmetricDifference(another).Values(), []int{1, 4, 7, 9, 10}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue := set.IsDisjoint(another); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if actualValue := NewWith[int](cmp.Compare[int], 3, 5).IsSubsetOf(another); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+
+	set.RetainAll(another)
+	if actualValue, expectedValue := set.Values(), []int{3, 5}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestSetDifferentComparators(t *testing.T) {
+	set := New[int](1, 2, 3)
+	reversed := NewWith[int](func(a, b int) int { return b - a }, 1, 2, 3, 4)
+
+	if actualValue := set.Intersection(reversed).Size(); actualValue != 0 {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+	if actualValue := set.Union(reversed).Size(); actualValue != 0 {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+	if actualValue := set.IsSubsetOf(reversed); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := reversed.IsSupersetOf(set); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := set.Equal(reversed); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+}
+
+func TestSetNavigation(t *testing.T) {
+	set := New[int]()
+	if _, found := set.First(); found {
+		t.Errorf("Got %v expected %v", found, false)
+	}
+	if _, found := set.PollLast(); found {
+		t.Errorf("Got %v expected %v", found, false)
+	}
+
+	set.Add(10, 20, 30, 40)
+	tests := []struct {
+		name     string
+		f        func(int) (int, bool)
+		value    int
+		expected int
+		found    bool
+	}{
+		{"Floor", set.Floor, 20, 20, true},
+		{"Floor", set.Floor, 25, 20, true},
+		{"Floor", set.Floor, 5, 0, false},
+		{"Ceiling", set.Ceiling, 20, 20, true},
+		{"Ceiling", set.Ceiling, 25, 30, true},
+		{"Ceiling", set.Ceiling, 45, 0, false},
+		{"Lower", set.Lower, 20, 10, true},
+		{"Lower", set.Lower, 25, 20, true},
+		{"Lower", set.Lower, 10, 0, false},
+		{"Higher", set.Higher, 20, 30, true},
+		{"Higher", set.Higher, 25, 30, true},
+		{"Higher", set.Higher, 40, 0, false},
+	}
+	for _, test := range tests {
+		value, found := test.f(test.value)
+		if value != test.expected || found != test.found {
+			t.Errorf("%s(%v): Got %v %v expected %v %v", test.name, test.value, value, found, test.expected, test.found)
+		}
+	}
+
+	if value, found := set.First(); value != 10 || !found {
+		t.Errorf("Got %v %v expected %v %v", value, found, 10, true)
+	}
+	if value, found := set.Last(); value != 40 || !found {
+		t.Errorf("Got %v %v expected %v %v", value, found, 40, true)
+	}
+	if value, found := set.PollFirst(); value != 10 || !found {
+		t.Errorf("Got %v %v expected %v %v", value, found, 10, true)
+	}
+	if value, found := set.PollLast(); value != 40 || !found {
+		t.Errorf("Got %v %v expected %v %v", value, found, 40, true)
+	}
+	if actualValue, expectedValue := set.Values(), []int{20, 30}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestSetViews(t *testing.T) {
+	set := New[int](1, 2, 3, 4, 5, 6, 7, 8, 9)
+
+	tests := []struct {
+		name     string
+		view     *View[int]
+		expected []int
+	}{
+		{"SubSet[3,7)", set.SubSet(3, true, 7, false), []int{3, 4, 5, 6}},
+		{"SubSet(3,7]", set.SubSet(3, false, 7, true), []int{4, 5, 6, 7}},
+		{"SubSet(7,3)", set.SubSet(7, false, 3, false), nil},
+		{"HeadSet(4)", set.HeadSet(4, false), []int{1, 2, 3}},
+		{"HeadSet[4]", set.HeadSet(4, true), []int{1, 2, 3, 4}},
+		{"TailSet(7)", set.TailSet(7, false), []int{8, 9}},
+		{"TailSet[7]", set.TailSet(7, true), []int{7, 8, 9}},
+		{"DescendingSet", set.DescendingSet(), []int{9, 8, 7, 6, 5, 4, 3, 2, 1}},
+		{"DescendingSet.HeadSet(4)", set.DescendingSet().HeadSet(4, false), []int{9, 8, 7, 6, 5}},
+		{"DescendingSet.SubSet[7,3]", set.DescendingSet().SubSet(7, true, 3, true), []int{7, 6, 5, 4, 3}},
+		{"SubSet[2,8].SubSet[0,5)", set.SubSet(2, true, 8, true).SubSet(0, true, 5, false), []int{2, 3, 4}},
+		{"SubSet[2,8).DescendingSet", set.SubSet(2, true, 8, false).DescendingSet(), []int{7, 6, 5, 4, 3, 2}},
+		{"TailSet(3).HeadSet[3]", set.TailSet(3, false).HeadSet(3, true), nil},
+	}
+	for _, test := range tests {
+		if actualValue, expectedValue := test.view.Values(), test.expected; !slices.Equal(actualValue, expectedValue) {
+			t.Errorf("%s: Got %v expected %v", test.name, actualValue, expectedValue)
+		}
+		if actualValue, expectedValue := test.view.Size(), len(test.expected); actualValue != expectedValue {
+			t.Errorf("%s: Got %v expected %v", test.name, actualValue, expectedValue)
+		}
+		if actualValue, expectedValue := test.view.Empty(), len(test.expected) == 0; actualValue != expectedValue {
+			t.Errorf("%s: Got %v expected %v", test.name, actualValue, expectedValue)
+		}
+		var reversed []int
+		it := test.view.Iterator()
+		for it.End(); it.Prev(); {
+			if actualValue, expectedValue := it.Index(), len(test.expected)-len(reversed)-1; actualValue != expectedValue {
+				t.Errorf("%s: Got %v expected %v", test.name, actualValue, expectedValue)
+			}
+			reversed = append(reversed, it.Value())
+		}
+		slices.Reverse(reversed)
+		if actualValue, expectedValue := reversed, test.expected; !slices.Equal(actualValue, expectedValue) {
+			t.Errorf("%s: Got %v expected %v", test.name, actualValue, expectedValue)
+		}
+	}
+
+	view := set.SubSet(3, true, 7, true)
+	if value, found := view.Floor(10); value != 7 || !found {
+		t.Errorf("Got %v %v expected %v %v", value, found, 7, true)
+	}
+	if _, found := view.Floor(2); found {
+		t.Errorf("Got %v expected %v", found, false)
+	}
+	if value, found := view.Ceiling(0); value != 3 || !found {
+		t.Errorf("Got %v %v expected %v %v", value, found, 3, true)
+	}
+	if value, found := view.Lower(7); value != 6 || !found {
+		t.Errorf("Got %v %v expected %v %v", value, found, 6, true)
+	}
+	if _, found := view.Higher(7); found {
+		t.Errorf("Got %v expected %v", found, false)
+	}
+	descending := view.DescendingSet()
+	if value, found := descending.Floor(5); value != 5 || !found {
+		t.Errorf("Got %v %v expected %v %v", value, found, 5, true)
+	}
+	if value, found := descending.Higher(5); value != 4 || !found {
+		t.Errorf("Got %v %v expected %v %v", value, found, 4, true)
+	}
+	if value, found := descending.First(); value != 7 || !found {
+		t.Errorf("Got %v %v expected %v %v", value, found, 7, true)
+	}
+	if actualValue := view.Contains(3, 7); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := view.Contains(8); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+}
+
+func TestSetViewsAreLive(t *testing.T) {
+	set := New[int](1, 5, 9)
+	view := set.SubSet(2, true, 8, true)
+
+	set.Add(2, 3, 10)
+	if actualValue, expectedValue := view.Values(), []int{2, 3, 5}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	view.Add(8)
+	view.Remove(3, 9)
+	if actualValue, expectedValue := set.Values(), []int{1, 2, 5, 8, 9, 10}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	if value, found := view.DescendingSet().PollFirst(); value != 8 || !found {
+		t.Errorf("Got %v %v expected %v %v", value, found, 8, true)
+	}
+	if value, found := view.PollFirst(); value != 2 || !found {
+		t.Errorf("Got %v %v expected %v %v", value, found, 2, true)
+	}
+
+	// modifying the set while iterating the view
+	set.Add(3, 4, 6, 7)
+	it := view.Iterator()
+	for it.Next() {
+		if it.Value()%2 == 0 {
+			set.Remove(it.Value())
+		}
+	}
+	if actualValue, expectedValue := view.Values(), []int{3, 5, 7}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	view.Clear()
+	if actualValue, expectedValue := set.Values(), []int{1, 9, 10}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("Adding a value outside of the view's range should panic")
+		}
+	}()
+	view.Add(1)
+}
+
 func benchmarkContains(b *testing.B, set *Set[int], size int) {
 	for i := 0; i < b.N; i++ {
 		for n := 0; n < size; n++ {
@@ -0,0 +1,361 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package treeset
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/emirpasic/gods/v2/sets"
+)
+
+// Assert Set implementation
+var _ sets.Set[int] = (*View[int])(nil)
+
+// View is a live view of a range of the elements of a tree set, possibly in descending order.
+//
+// The view holds no elements of its own, all operations go through to the backing set,
+// i.e. changes to the set are visible through the view and vice versa.
+// Adding an element outside of the view's range panics, removing one is a no-op.
+//
+// Size is computed on each call by walking the range, i.e. it is O(k) for a range of k elements.
+type View[T comparable] struct {
+	set        *Set[T]
+	low        bound[T] // lower bound in the set's (ascending) order
+	high       bound[T] // upper bound in the set's (ascending) order
+	descending bool
+}
+
+// bound is one end of a view's range, an unset bound is unbounded.
+type bound[T comparable] struct {
+	value     T
+	inclusive bool
+	set       bool
+}
+
+// view returns a view over all elements of the set in ascending order.
+func (set *Set[T]) view() *View[T] {
+	return &View[T]{set: set}
+}
+
+// Add adds the items (one or more) to the backing set.
+// Panics if any of the items is outside of the view's range.
+func (view *View[T]) Add(items ...T) {
+	for _, item := range items {
+		if !view.inRange(item) {
+			panic(fmt.Sprintf("value %v is outside of the view's range", item))
+		}
+	}
+	view.set.Add(items...)
+}
+
+// Remove removes the items (one or more) from the backing set.
+// Items outside of the view's range are ignored.
+func (view *View[T]) Remove(items ...T) {
+	for _, item := range items {
+		if view.inRange(item) {
+			view.set.Remove(item)
+		}
+	}
+}
+
+// Contains checks weather items (one or more) are present in the view.
+// All items have to be present in the view for the method to return true.
+// Returns true if no arguments are passed at all, i.e. set is always superset of empty set.
+func (view *View[T]) Contains(items ...T) bool {
+	for _, item := range items {
+		if !view.inRange(item) || !view.set.Contains(item) {
+			return false
+		}
+	}
+	return true
+}
+
+// Empty returns true if view does not contain any elements.
+func (view *View[T]) Empty() bool {
+	_, found := view.First()
+	return !found
+}
+
+// Size returns number of elements within the view.
+func (view *View[T]) Size() int {
+	size := 0
+	for value, found := view.First(); found; value, found = view.Higher(value) {
+		size++
+	}
+	return size
+}
+
+// Clear removes all elements within the view from the backing set.
+func (view *View[T]) Clear() {
+	view.set.Remove(view.Values()...)
+}
+
+// Values returns all items in the view, in the view's order.
+func (view *View[T]) Values() []T {
+	var values []T
+	for value, found := view.First(); found; value, found = view.Higher(value) {
+		values = append(values, value)
+	}
+	return values
+}
+
+// String returns a string representation of container
+func (view *View[T]) String() string {
+	str := "TreeSetView\n"
+	items := []string{}
+	for _, v := range view.Values() {
+		items = append(items, fmt.Sprintf("%v", v))
+	}
+	str += strings.Join(items, ", ")
+	return str
+}
+
+// First returns the first element of the view.
+// Second return parameter is false if the view is empty.
+func (view *View[T]) First() (value T, found bool) {
+	if view.descending {
+		return view.last()
+	}
+	return view.first()
+}
+
+// Last returns the last element of the view.
+// Second return parameter is false if the view is empty.
+func (view *View[T]) Last() (value T, found bool) {
+	if view.descending {
+		return view.first()
+	}
+	return view.last()
+}
+
+// Floor returns the greatest element of the view, in the view's order, that is less than or equal to the given value.
+// Second return parameter is false if there is no such element.
+func (view *View[T]) Floor(value T) (floor T, found bool) {
+	if view.descending {
+		return view.ceiling(value)
+	}
+	return view.floor(value)
+}
+
+// Ceiling returns the least element of the view, in the view's order, that is greater than or equal to the given value.
+// Second return parameter is false if there is no such element.
+func (view *View[T]) Ceiling(value T) (ceiling T, found bool) {
+	if view.descending {
+		return view.floor(value)
+	}
+	return view.ceiling(value)
+}
+
+// Lower returns the greatest element of the view, in the view's order, that is strictly less than the given value.
+// Second return parameter is false if there is no such element.
+func (view *View[T]) Lower(value T) (lower T, found bool) {
+	if view.descending {
+		return view.higher(value)
+	}
+	return view.lower(value)
+}
+
+// Higher returns the least element of the view, in the view's order, that is strictly greater than the given value.
+// Second return parameter is false if there is no such element.
+func (view *View[T]) Higher(value T) (higher T, found bool) {
+	if view.descending {
+		return view.lower(value)
+	}
+	return view.higher(value)
+}
+
+// PollFirst removes and returns the first element of the view.
+// Second return parameter is false if the view is empty.
+func (view *View[T]) PollFirst() (value T, found bool) {
+	if value, found = view.First(); found {
+		view.set.Remove(value)
+	}
+	return value, found
+}
+
+// PollLast removes and returns the last element of the view.
+// Second return parameter is false if the view is empty.
+func (view *View[T]) PollLast() (value T, found bool) {
+	if value, found = view.Last(); found {
+		view.set.Remove(value)
+	}
+	return value, found
+}
+
+// SubSet returns a live view of the elements of this view ranging from "from" to "to", in the view's order.
+// Each bound is included if its inclusive flag is true. The range is narrowed to the one of this view.
+func (view *View[T]) SubSet(from T, fromInclusive bool, to T, toInclusive bool) *View[T] {
+	return view.TailSet(from, fromInclusive).HeadSet(to, toInclusive)
+}
+
+// HeadSet returns a live view of the elements of this view that come before (or equal to, if inclusive is true) "to",
+// in the view's order. The range is narrowed to the one of this view.
+func (view *View[T]) HeadSet(to T, inclusive bool) *View[T] {
+	result := *view
+	if view.descending {
+		result.low = view.tighterLow(bound[T]{value: to, inclusive: inclusive, set: true})
+	} else {
+		result.high = view.tighterHigh(bound[T]{value: to, inclusive: inclusive, set: true})
+	}
+	return &result
+}
+
+// TailSet returns a live view of the elements of this view that come after (or equal to, if inclusive is true) "from",
+// in the view's order. The range is narrowed to the one of this view.
+func (view *View[T]) TailSet(from T, inclusive bool) *View[T] {
+	result := *view
+	if view.descending {
+		result.high = view.tighterHigh(bound[T]{value: from, inclusive: inclusive, set: true})
+	} else {
+		result.low = view.tighterLow(bound[T]{value: from, inclusive: inclusive, set: true})
+	}
+	return &result
+}
+
+// DescendingSet returns a live view of the elements of this view in reverse order.
+func (view *View[T]) DescendingSet() *View[T] {
+	result := *view
+	result.descending = !view.descending
+	return &result
+}
+
+// tooLow returns true if the value is below the view's lower bound (in ascending order).
+func (view *View[T]) tooLow(value T) bool {
+	if !view.low.set {
+		return false
+	}
+	c := view.set.tree.KeyComparator()(value, view.low.value)
+	return c < 0 || (c == 0 && !view.low.inclusive)
+}
+
+// tooHigh returns true if the value is above the view's upper bound (in ascending order).
+func (view *View[T]) tooHigh(value T) bool {
+	if !view.high.set {
+		return false
+	}
+	c := view.set.tree.KeyComparator()(value, view.high.value)
+	return c > 0 || (c == 0 && !view.high.inclusive)
+}
+
+// inRange returns true if the value is within the view's range.
+func (view *View[T]) inRange(value T) bool {
+	return !view.tooLow(value) && !view.tooHigh(value)
+}
+
+// tighterLow returns the more restrictive of the view's lower bound and the given one.
+func (view *View[T]) tighterLow(other bound[T]) bound[T] {
+	if !view.low.set {
+		return other
+	}
+	c := view.set.tree.KeyComparator()(view.low.value, other.value)
+	switch {
+	case c > 0:
+		return view.low
+	case c < 0:
+		return other
+	}
+	return bound[T]{value: other.value, inclusive: view.low.inclusive && other.inclusive, set: true}
+}
+
+// tighterHigh returns the more restrictive of the view's upper bound and the given one.
+func (view *View[T]) tighterHigh(other bound[T]) bound[T] {
+	if !view.high.set {
+		return other
+	}
+	c := view.set.tree.KeyComparator()(view.high.value, other.value)
+	switch {
+	case c < 0:
+		return view.high
+	case c > 0:
+		return other
+	}
+	return bound[T]{value: other.value, inclusive: view.high.inclusive && other.inclusive, set: true}
+}
+
+// first returns the smallest element within the range.
+func (view *View[T]) first() (value T, found bool) {
+	switch {
+	case !view.low.set:
+		value, found = view.set.First()
+	case view.low.inclusive:
+		value, found = view.set.Ceiling(view.low.value)
+	default:
+		value, found = view.set.Higher(view.low.value)
+	}
+	if found && view.tooHigh(value) {
+		return view.none()
+	}
+	return value, found
+}
+
+// last returns the largest element within the range.
+func (view *View[T]) last() (value T, found bool) {
+	switch {
+	case !view.high.set:
+		value, found = view.set.Last()
+	case view.high.inclusive:
+		value, found = view.set.Floor(view.high.value)
+	default:
+		value, found = view.set.Lower(view.high.value)
+	}
+	if found && view.tooLow(value) {
+		return view.none()
+	}
+	return value, found
+}
+
+// floor returns the largest element within the range that is smaller than or equal to the value.
+func (view *View[T]) floor(value T) (T, bool) {
+	if view.tooHigh(value) {
+		return view.last()
+	}
+	return view.checkLow(view.set.Floor(value))
+}
+
+// lower returns the largest element within the range that is strictly smaller than the value.
+func (view *View[T]) lower(value T) (T, bool) {
+	if view.tooHigh(value) {
+		return view.last()
+	}
+	return view.checkLow(view.set.Lower(value))
+}
+
+// ceiling returns the smallest element within the range that is larger than or equal to the value.
+func (view *View[T]) ceiling(value T) (T, bool) {
+	if view.tooLow(value) {
+		return view.first()
+	}
+	return view.checkHigh(view.set.Ceiling(value))
+}
+
+// higher returns the smallest element within the range that is strictly larger than the value.
+func (view *View[T]) higher(value T) (T, bool) {
+	if view.tooLow(value) {
+		return view.first()
+	}
+	return view.checkHigh(view.set.Higher(value))
+}
+
+// checkLow discards a found element if it is below the range.
+func (view *View[T]) checkLow(value T, found bool) (T, bool) {
+	if found && view.tooLow(value) {
+		return view.none()
+	}
+	return value, found
+}
+
+// checkHigh discards a found element if it is above the range.
+func (view *View[T]) checkHigh(value T, found bool) (T, bool) {
+	if found && view.tooHigh(value) {
+		return view.none()
+	}
+	return value, found
+}
+
+// none returns the zero value and false, i.e. no element found.
+func (view *View[T]) none() (value T, found bool) {
+	return value, false
+}
@@ -13,16 +13,20 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/emirpasic/gods/v2/containers"
 	"github.com/emirpasic/gods/v2/lists/arraylist"
 	"github.com/emirpasic/gods/v2/stacks"
 )
 
 // Assert Stack implementation
 var _ stacks.Stack[int] = (*Stack[int])(nil)
+var _ containers.FailFast = (*Stack[int])(nil)
 
 // Stack holds elements in an array-list
 type Stack[T comparable] struct {
-	list *arraylist.List[T]
+	list             *arraylist.List[T]
+	modCount         int  // number of structural modifications, checked by iterators
+	failFastDisabled bool // iterators do not check for modifications
 }
 
 // New instantiates a new empty stack
@@ -30,9 +34,16 @@ func New[T comparable]() *Stack[T] {
 	return &Stack[T]{list: arraylist.New[T]()}
 }
 
+// NewWithCapacity instantiates a new empty stack that can hold the given number of elements without allocating.
+// The stack does not shrink below that capacity, see EnsureCapacity.
+func NewWithCapacity[T comparable](capacity int) *Stack[T] {
+	return &Stack[T]{list: arraylist.NewWithCapacity[T](capacity)}
+}
+
 // Push adds a value onto the top of the stack
 func (stack *Stack[T]) Push(value T) {
 	stack.list.Add(value)
+	stack.modCount++
 }
 
 // Pop removes top element on stack and returns it, or nil if stack is empty.
@@ -40,6 +51,9 @@ func (stack *Stack[T]) Push(value T) {
 func (stack *Stack[T]) Pop() (value T, ok bool) {
 	value, ok = stack.list.Get(stack.list.Size() - 1)
 	stack.list.Remove(stack.list.Size() - 1)
+	if ok {
+		stack.modCount++
+	}
 	return
 }
 
@@ -62,6 +76,7 @@ func (stack *Stack[T]) Size() int {
 // Clear removes all elements from the stack.
 func (stack *Stack[T]) Clear() {
 	stack.list.Clear()
+	stack.modCount++
 }
 
 // Values returns all elements in the stack (LIFO order).
@@ -74,6 +89,53 @@ func (stack *Stack[T]) Values() []T {
 	return elements
 }
 
+// AppendValuesTo appends all elements in the stack (LIFO order) to dst and returns the extended slice, like append.
+// Unlike Values, it does not allocate if dst has enough capacity.
+func (stack *Stack[T]) AppendValuesTo(dst []T) []T {
+	elements := stack.list.Elements()
+	for i := len(elements) - 1; i >= 0; i-- {
+		dst = append(dst, elements[i]) // in reverse (LIFO)
+	}
+	return dst
+}
+
+// Elements returns the elements in the stack without copying them, from the bottom to the top of the stack,
+// i.e. the top element is the last one.
+// The returned slice shares the stack's storage: it must not be modified, and it is only valid until the stack is
+// modified.
+func (stack *Stack[T]) Elements() []T {
+	return stack.list.Elements()
+}
+
+// Capacity returns the number of elements the stack can hold without allocating.
+func (stack *Stack[T]) Capacity() int {
+	return stack.list.Capacity()
+}
+
+// EnsureCapacity increases the capacity of the stack, if necessary, so that it can hold at least the given number of
+// elements without allocating. The stack does not shrink below that capacity until TrimToSize is called.
+func (stack *Stack[T]) EnsureCapacity(capacity int) {
+	stack.list.EnsureCapacity(capacity)
+}
+
+// TrimToSize reduces the capacity of the stack to its size and drops the capacity requested by NewWithCapacity or
+// EnsureCapacity.
+func (stack *Stack[T]) TrimToSize() {
+	stack.list.TrimToSize()
+}
+
+// SetResizePolicy sets how the capacity of the stack changes as elements are pushed and popped,
+// see arraylist.ResizePolicy.
+func (stack *Stack[T]) SetResizePolicy(policy arraylist.ResizePolicy) {
+	stack.list.SetResizePolicy(policy)
+}
+
+// SetFailFast enables or disables the checks of iterators for modifications of the stack done while iterating,
+// see containers.ConcurrentModificationError. Checks are enabled by default.
+func (stack *Stack[T]) SetFailFast(enabled bool) {
+	stack.failFastDisabled = !enabled
+}
+
 // String returns a string representation of container
 func (stack *Stack[T]) String() string {
 	str := "ArrayStack\n"
@@ -6,9 +6,11 @@ package arraystack
 
 import (
 	"encoding/json"
+	"slices"
 	"strings"
 	"testing"
 
+	"github.com/emirpasic/gods/v2/lists/arraylist"
 	"github.com/emirpasic/gods/v2/testutils"
 )
 
@@ -347,6 +349,68 @@ func TestStackIteratorPrevTo(t *testing.T) {
 	}
 }
 
+func TestStackIteratorFailFast(t *testing.T) {
+	stack := New[int]()
+	stack.Push(1)
+	stack.Push(2)
+	it := stack.Iterator()
+	it.Next()
+	stack.Push(3)
+	testutils.ConcurrentModificationPanic(t, func() { it.Next() })
+	testutils.ConcurrentModificationPanic(t, func() { it.Prev() })
+
+	// reset iterator is valid again
+	count := 0
+	for it.Begin(); it.Next(); {
+		count++
+	}
+	if actualValue, expectedValue := count, 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	stack.SetFailFast(false)
+	it.Begin()
+	it.Next()
+	stack.Push(4)
+	it.Next() // no panic
+}
+
+func TestStackCapacity(t *testing.T) {
+	stack := NewWithCapacity[int](10)
+	if actualValue := stack.Capacity(); actualValue != 10 {
+		t.Errorf("Got %v expected %v", actualValue, 10)
+	}
+	allocs := testing.AllocsPerRun(10, func() {
+		for i := 0; i < 10; i++ {
+			stack.Push(i)
+		}
+		stack.Clear()
+	})
+	if allocs != 0 {
+		t.Errorf("Got %v allocations expected %v", allocs, 0)
+	}
+	stack.SetResizePolicy(arraylist.ResizePolicy{GrowthFactor: 3, ShrinkFactor: 0})
+	stack.EnsureCapacity(20)
+	stack.Push(1)
+	stack.Push(2)
+	stack.Push(3)
+	if actualValue := stack.Capacity(); actualValue != 20 {
+		t.Errorf("Got %v expected %v", actualValue, 20)
+	}
+	stack.TrimToSize()
+	if actualValue := stack.Capacity(); actualValue != 3 {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+
+	dst := make([]int, 0, 3)
+	if actualValue, expectedValue := stack.AppendValuesTo(dst), []int{3, 2, 1}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := stack.Elements(), []int{1, 2, 3}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
 func TestStackSerialization(t *testing.T) {
 	stack := New[string]()
 	stack.Push("a")
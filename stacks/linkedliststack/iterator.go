@@ -11,13 +11,14 @@ var _ containers.IteratorWithIndex[int] = (*Iterator[int])(nil)
 
 // Iterator returns a stateful iterator whose values can be fetched by an index.
 type Iterator[T comparable] struct {
-	stack *Stack[T]
-	index int
+	stack    *Stack[T]
+	index    int
+	modCount int // stack's modification count the iterator is in sync with
 }
 
 // Iterator returns a stateful iterator whose values can be fetched by an index.
 func (stack *Stack[T]) Iterator() *Iterator[T] {
-	return &Iterator[T]{stack: stack, index: -1}
+	return &Iterator[T]{stack: stack, index: -1, modCount: stack.modCount}
 }
 
 // Next moves the iterator to the next element and returns true if there was a next element in the container.
@@ -25,6 +26,7 @@ func (stack *Stack[T]) Iterator() *Iterator[T] {
 // If Next() was called for the first time, then it will point the iterator to the first element if it exists.
 // Modifies the state of the iterator.
 func (iterator *Iterator[T]) Next() bool {
+	iterator.checkModification()
 	if iterator.index < iterator.stack.Size() {
 		iterator.index++
 	}
@@ -48,6 +50,7 @@ func (iterator *Iterator[T]) Index() int {
 // Call Next() to fetch the first element if any.
 func (iterator *Iterator[T]) Begin() {
 	iterator.index = -1
+	iterator.modCount = iterator.stack.modCount
 }
 
 // First moves the iterator to the first element and returns true if there was a first element in the container.
@@ -71,3 +74,16 @@ func (iterator *Iterator[T]) NextTo(f func(index int, value T) bool) bool {
 	}
 	return false
 }
+
+// checkModification panics if the stack was modified since the iterator moved to its current element,
+// unless fail-fast iteration is disabled for the stack.
+func (iterator *Iterator[T]) checkModification() {
+	if iterator.index == -1 {
+		// not on an element yet, nothing to invalidate
+		iterator.modCount = iterator.stack.modCount
+		return
+	}
+	if iterator.modCount != iterator.stack.modCount && !iterator.stack.failFastDisabled {
+		panic(&containers.ConcurrentModificationError{Container: "LinkedListStack"})
+	}
+}
@@ -13,16 +13,20 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/emirpasic/gods/v2/containers"
 	"github.com/emirpasic/gods/v2/lists/singlylinkedlist"
 	"github.com/emirpasic/gods/v2/stacks"
 )
 
 // Assert Stack implementation
 var _ stacks.Stack[int] = (*Stack[int])(nil)
+var _ containers.FailFast = (*Stack[int])(nil)
 
 // Stack holds elements in a singly-linked-list
 type Stack[T comparable] struct {
-	list *singlylinkedlist.List[T]
+	list             *singlylinkedlist.List[T]
+	modCount         int  // number of structural modifications, checked by iterators
+	failFastDisabled bool // iterators do not check for modifications
 }
 
 // New nnstantiates a new empty stack
@@ -33,6 +37,7 @@ func New[T comparable]() *Stack[T] {
 // Push adds a value onto the top of the stack
 func (stack *Stack[T]) Push(value T) {
 	stack.list.Prepend(value)
+	stack.modCount++
 }
 
 // Pop removes top element on stack and returns it, or nil if stack is empty.
@@ -40,6 +45,9 @@ func (stack *Stack[T]) Push(value T) {
 func (stack *Stack[T]) Pop() (value T, ok bool) {
 	value, ok = stack.list.Get(0)
 	stack.list.Remove(0)
+	if ok {
+		stack.modCount++
+	}
 	return
 }
 
@@ -62,6 +70,7 @@ func (stack *Stack[T]) Size() int {
 // Clear removes all elements from the stack.
 func (stack *Stack[T]) Clear() {
 	stack.list.Clear()
+	stack.modCount++
 }
 
 // Values returns all elements in the stack (LIFO order).
@@ -69,6 +78,12 @@ func (stack *Stack[T]) Values() []T {
 	return stack.list.Values()
 }
 
+// SetFailFast enables or disables the checks of iterators for modifications of the stack done while iterating,
+// see containers.ConcurrentModificationError. Checks are enabled by default.
+func (stack *Stack[T]) SetFailFast(enabled bool) {
+	stack.failFastDisabled = !enabled
+}
+
 // String returns a string representation of container
 func (stack *Stack[T]) String() string {
 	str := "LinkedListStack\n"
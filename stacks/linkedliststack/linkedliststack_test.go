@@ -203,6 +203,31 @@ func TestStackIteratorNextTo(t *testing.T) {
 	}
 }
 
+func TestStackIteratorFailFast(t *testing.T) {
+	stack := New[int]()
+	stack.Push(1)
+	stack.Push(2)
+	it := stack.Iterator()
+	it.Next()
+	stack.Push(3)
+	testutils.ConcurrentModificationPanic(t, func() { it.Next() })
+
+	// reset iterator is valid again
+	count := 0
+	for it.Begin(); it.Next(); {
+		count++
+	}
+	if actualValue, expectedValue := count, 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	stack.SetFailFast(false)
+	it.Begin()
+	it.Next()
+	stack.Push(4)
+	it.Next() // no panic
+}
+
 func TestStackSerialization(t *testing.T) {
 	stack := New[string]()
 	stack.Push("a")
@@ -0,0 +1,80 @@
+package testutils
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/emirpasic/gods/v2/trees"
+)
+
+// HeapContract checks the behavior every trees.Heap shares on heaps of ints ordered ascending,
+// which newHeap has to return empty.
+func HeapContract(t *testing.T, newHeap func() trees.Heap[int]) {
+	t.Helper()
+	heap := newHeap()
+	if actualValue, ok := heap.Pop(); actualValue != 0 || ok {
+		t.Errorf("Got %v, %v expected %v, %v", actualValue, ok, 0, false)
+	}
+	if actualValue, ok := heap.Peek(); actualValue != 0 || ok {
+		t.Errorf("Got %v, %v expected %v, %v", actualValue, ok, 0, false)
+	}
+	if actualValue := heap.Values(); len(actualValue) != 0 {
+		t.Errorf("Got %v expected %v", actualValue, "[]")
+	}
+
+	random := rand.New(rand.NewSource(1))
+	expected := make([]int, 100)
+	for i := range expected {
+		expected[i] = random.Intn(50)
+	}
+	heap.Push(expected[0])
+	heap.Push(expected[1:10]...)
+	heap.Push()
+	for _, value := range expected[10:] {
+		heap.Push(value)
+	}
+	slices.Sort(expected)
+	if actualValue, expectedValue := heap.Size(), len(expected); actualValue != expectedValue || heap.Empty() {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, ok := heap.Peek(); actualValue != expected[0] || !ok {
+		t.Errorf("Got %v expected %v", actualValue, expected[0])
+	}
+	values := heap.Values()
+	if len(values) == 0 || values[0] != expected[0] {
+		t.Errorf("Got %v expected %v first", values, expected[0])
+	}
+	SameElements(t, values, expected)
+
+	data, err := heap.ToJSON()
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	copied := newHeap()
+	copied.Push(-1)
+	if err := copied.FromJSON(data); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+
+	for _, h := range []trees.Heap[int]{heap, copied} {
+		var popped []int
+		for !h.Empty() {
+			value, _ := h.Pop()
+			popped = append(popped, value)
+		}
+		if !slices.Equal(popped, expected) {
+			t.Errorf("Got %v expected %v", popped, expected)
+		}
+	}
+
+	heap.Push(3, 1, 2)
+	heap.Clear()
+	if actualValue := heap.Empty(); actualValue != true || heap.Size() != 0 {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	heap.Push(2, 1)
+	if actualValue, ok := heap.Pop(); actualValue != 1 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+}
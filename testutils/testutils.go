@@ -1,6 +1,11 @@
 package testutils
 
-import "testing"
+import (
+	"errors"
+	"testing"
+
+	"github.com/emirpasic/gods/v2/containers"
+)
 
 func SameElements[T comparable](t *testing.T, actual, expected []T) {
 	if len(actual) != len(expected) {
@@ -16,3 +21,17 @@ outer:
 		t.Errorf("Did not find expected element %v in %v", e, actual)
 	}
 }
+
+// ConcurrentModificationPanic checks that f panics with a containers.ConcurrentModificationError.
+func ConcurrentModificationPanic(t *testing.T, f func()) {
+	t.Helper()
+	defer func() {
+		r := recover()
+		err, ok := r.(error)
+		var target *containers.ConcurrentModificationError
+		if !ok || !errors.As(err, &target) {
+			t.Errorf("Got %v expected %v", r, "ConcurrentModificationError panic")
+		}
+	}()
+	f()
+}
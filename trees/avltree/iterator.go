@@ -14,6 +14,7 @@ type Iterator[K comparable, V any] struct {
 	tree     *Tree[K, V]
 	node     *Node[K, V]
 	position position
+	modCount int // tree's modification count the iterator is in sync with
 }
 
 type position byte
@@ -24,7 +25,12 @@ const (
 
 // Iterator returns a stateful iterator whose elements are key/value pairs.
 func (tree *Tree[K, V]) Iterator() *Iterator[K, V] {
-	return &Iterator[K, V]{tree: tree, node: nil, position: begin}
+	return &Iterator[K, V]{tree: tree, node: nil, position: begin, modCount: tree.modCount}
+}
+
+// IteratorWithKey returns the same iterator as Iterator, typed as containers.ReverseIteratorWithKey.
+func (tree *Tree[K, V]) IteratorWithKey() containers.ReverseIteratorWithKey[K, V] {
+	return tree.Iterator()
 }
 
 // Next moves the iterator to the next element and returns true if there was a next element in the container.
@@ -32,6 +38,7 @@ func (tree *Tree[K, V]) Iterator() *Iterator[K, V] {
 // If Next() was called for the first time, then it will point the iterator to the first element if it exists.
 // Modifies the state of the iterator.
 func (iterator *Iterator[K, V]) Next() bool {
+	iterator.checkModification()
 	switch iterator.position {
 	case begin:
 		iterator.position = between
@@ -52,6 +59,7 @@ func (iterator *Iterator[K, V]) Next() bool {
 // If Prev() was called for the first time, then it will point the iterator to the first element if it exists.
 // Modifies the state of the iterator.
 func (iterator *Iterator[K, V]) Prev() bool {
+	iterator.checkModification()
 	switch iterator.position {
 	case end:
 		iterator.position = between
@@ -148,3 +156,12 @@ func (iterator *Iterator[K, V]) PrevTo(f func(key K, value V) bool) bool {
 	}
 	return false
 }
+
+// checkModification panics if the tree was structurally modified since the iterator moved to its current element,
+// unless fail-fast iteration is disabled for the tree.
+func (iterator *Iterator[K, V]) checkModification() {
+	if iterator.position == between && iterator.modCount != iterator.tree.modCount && !iterator.tree.failFastDisabled {
+		panic(&containers.ConcurrentModificationError{Container: "AVLTree"})
+	}
+	iterator.modCount = iterator.tree.modCount
+}
@@ -14,8 +14,10 @@ package binaryheap
 import (
 	"cmp"
 	"fmt"
+	"math/bits"
 	"strings"
 
+	"github.com/emirpasic/gods/v2/containers"
 	"github.com/emirpasic/gods/v2/lists/arraylist"
 	"github.com/emirpasic/gods/v2/trees"
 	"github.com/emirpasic/gods/v2/utils"
@@ -23,11 +25,16 @@ import (
 
 // Assert Tree implementation
 var _ trees.Tree[int] = (*Heap[int])(nil)
+var _ trees.Heap[int] = (*Heap[int])(nil)
+var _ containers.FailFast = (*Heap[int])(nil)
 
 // Heap holds elements in an array-list
 type Heap[T comparable] struct {
-	list       *arraylist.List[T]
-	Comparator utils.Comparator[T]
+	list             *arraylist.List[T]
+	Comparator       utils.Comparator[T]
+	bound            int  // maximum number of elements kept in top-k mode, zero if unbounded
+	modCount         int  // number of structural modifications, checked by iterators
+	failFastDisabled bool // iterators do not check for modifications
 }
 
 // New instantiates a new empty heap tree with the built-in comparator for T
@@ -40,21 +47,114 @@ func NewWith[T comparable](comparator utils.Comparator[T]) *Heap[T] {
 	return &Heap[T]{list: arraylist.New[T](), Comparator: comparator}
 }
 
-// Push adds a value onto the heap and bubbles it up accordingly.
+// NewFromSlice instantiates a new heap tree of the values with the custom comparator in O(n).
+// The values are copied, the slice is not modified.
+func NewFromSlice[T comparable](values []T, comparator utils.Comparator[T]) *Heap[T] {
+	heap := &Heap[T]{list: arraylist.New(values...), Comparator: comparator}
+	heap.heapify()
+	return heap
+}
+
+// Push adds values onto the heap and bubbles them up accordingly.
+// Many values are added by rebuilding the heap in O(n) if that is cheaper than bubbling up each of them.
+// In top-k mode values are pushed one by one, see TopK.
 func (heap *Heap[T]) Push(values ...T) {
-	if len(values) == 1 {
-		heap.list.Add(values[0])
-		heap.bubbleUp()
-	} else {
-		// Reference: https://en.wikipedia.org/wiki/Binary_heap#Building_a_heap
+	switch {
+	case len(values) == 0:
+		return
+	case heap.bound > 0:
 		for _, value := range values {
-			heap.list.Add(value)
+			heap.pushBounded(value)
 		}
-		size := heap.list.Size()/2 + 1
-		for i := size; i >= 0; i-- {
-			heap.bubbleDownIndex(i)
+		return
+	case len(values) == 1:
+		heap.list.Add(values[0])
+		heap.bubbleUp()
+	default:
+		size := heap.list.Size() + len(values)
+		heap.list.Add(values...)
+		if len(values)*bits.Len(uint(size)) < size {
+			for i := size - len(values); i < size; i++ {
+				heap.bubbleUpIndex(i)
+			}
+		} else {
+			heap.heapify()
 		}
 	}
+	heap.modCount++
+}
+
+// Merge moves all elements of the other heap into this heap, leaving the other heap empty.
+// The elements are ordered by the comparator of this heap.
+func (heap *Heap[T]) Merge(other *Heap[T]) {
+	if other == heap {
+		return
+	}
+	heap.Push(other.Elements()...)
+	other.Clear()
+}
+
+// PushPop pushes the value onto the heap and then pops the top element and returns it, in a single bubble down.
+// The value itself is returned if the heap is empty or the value would be on top, the heap is unchanged then.
+func (heap *Heap[T]) PushPop(value T) T {
+	top, ok := heap.list.Get(0)
+	if !ok || heap.Comparator(value, top) <= 0 {
+		return value
+	}
+	heap.list.Set(0, value)
+	heap.bubbleDown()
+	heap.modCount++
+	return top
+}
+
+// Replace pops the top element and then pushes the value onto the heap, in a single bubble down.
+// Returns the popped element. Second return parameter is true, unless the heap was empty and there was nothing to
+// pop, the value is pushed anyway.
+func (heap *Heap[T]) Replace(value T) (top T, ok bool) {
+	top, ok = heap.list.Get(0)
+	if !ok {
+		heap.Push(value)
+		return
+	}
+	heap.list.Set(0, value)
+	heap.bubbleDown()
+	heap.modCount++
+	return
+}
+
+// Fix restores the heap order after the element at the index changed its ordering, e.g. a pointer element was
+// modified in place. The index is the position in the heap's underlying array, see IndexOf.
+// Equivalent to, but cheaper than, removing the element and pushing it again.
+// Does not do anything if the index is out of bounds.
+func (heap *Heap[T]) Fix(index int) {
+	if !heap.withinRange(index) {
+		return
+	}
+	heap.bubbleDownIndex(index)
+	heap.bubbleUpIndex(index)
+	heap.modCount++
+}
+
+// IndexOf returns the position of the value in the heap's underlying array, or -1 if the value is not in the heap.
+// Positions are not related to the indexes of the iterator and change with every modification of the heap.
+func (heap *Heap[T]) IndexOf(value T) int {
+	return heap.list.IndexOf(value)
+}
+
+// TopK switches the heap to top-k mode, in which it keeps only the k greatest elements with respect to the
+// comparator, dropping the top (least) elements first. Once k elements are in the heap, a pushed value replaces the
+// top element if it is greater and is discarded otherwise, so a stream of values is reduced to its k greatest in
+// O(log k) per value. Popping all elements then yields them in ascending order.
+// Elements beyond k already in the heap are popped. Zero or a negative k switches top-k mode off.
+func (heap *Heap[T]) TopK(k int) {
+	if k <= 0 {
+		heap.bound = 0
+		return
+	}
+	heap.bound = k
+	for heap.list.Size() > k {
+		heap.Pop()
+	}
 }
 
 // Pop removes top element on heap and returns it, or nil if heap is empty.
@@ -68,6 +168,7 @@ func (heap *Heap[T]) Pop() (value T, ok bool) {
 	heap.list.Swap(0, lastIndex)
 	heap.list.Remove(lastIndex)
 	heap.bubbleDown()
+	heap.modCount++
 	return
 }
 
@@ -90,6 +191,7 @@ func (heap *Heap[T]) Size() int {
 // Clear removes all elements from the heap.
 func (heap *Heap[T]) Clear() {
 	heap.list.Clear()
+	heap.modCount++
 }
 
 // Values returns all elements in the heap.
@@ -101,6 +203,20 @@ func (heap *Heap[T]) Values() []T {
 	return values
 }
 
+// Elements returns the elements in heap order, i.e. the order of the heap's underlying array as used by IndexOf and
+// Fix, without copying them. The top element comes first, but the elements are not sorted.
+// The returned slice shares the heap's storage: it must not be modified, and it is only valid until the heap is
+// modified.
+func (heap *Heap[T]) Elements() []T {
+	return heap.list.Elements()
+}
+
+// SetFailFast enables or disables the checks of iterators for modifications of the heap done while iterating,
+// see containers.ConcurrentModificationError. Checks are enabled by default.
+func (heap *Heap[T]) SetFailFast(enabled bool) {
+	heap.failFastDisabled = !enabled
+}
+
 // String returns a string representation of container
 func (heap *Heap[T]) String() string {
 	str := "BinaryHeap\n"
@@ -112,6 +228,26 @@ func (heap *Heap[T]) String() string {
 	return str
 }
 
+// pushBounded pushes the value onto the heap in top-k mode.
+func (heap *Heap[T]) pushBounded(value T) {
+	if heap.list.Size() < heap.bound {
+		heap.list.Add(value)
+		heap.bubbleUp()
+		heap.modCount++
+		return
+	}
+	heap.PushPop(value)
+}
+
+// heapify restores the heap order of all elements in O(n) by bubbling down the inner nodes bottom-up
+// (Floyd's method).
+// Reference: https://en.wikipedia.org/wiki/Binary_heap#Building_a_heap
+func (heap *Heap[T]) heapify() {
+	for i := heap.list.Size()/2 - 1; i >= 0; i-- {
+		heap.bubbleDownIndex(i)
+	}
+}
+
 // Performs the "bubble down" operation. This is to place the element that is at the root
 // of the heap in its correct place so that the heap maintains the min/max-heap order property.
 func (heap *Heap[T]) bubbleDown() {
@@ -145,7 +281,12 @@ func (heap *Heap[T]) bubbleDownIndex(index int) {
 // element (i.e. last element in the list) in its correct place so that
 // the heap maintains the min/max-heap order property.
 func (heap *Heap[T]) bubbleUp() {
-	index := heap.list.Size() - 1
+	heap.bubbleUpIndex(heap.list.Size() - 1)
+}
+
+// Performs the "bubble up" operation. This is to place the element that is at the index
+// of the heap in its correct place so that the heap maintains the min/max-heap order property.
+func (heap *Heap[T]) bubbleUpIndex(index int) {
 	for parentIndex := (index - 1) >> 1; index > 0; parentIndex = (index - 1) >> 1 {
 		indexValue, _ := heap.list.Get(index)
 		parentValue, _ := heap.list.Get(parentIndex)
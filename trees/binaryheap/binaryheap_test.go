@@ -5,13 +5,21 @@
 package binaryheap
 
 import (
+	"cmp"
 	"encoding/json"
 	"math/rand"
 	"slices"
 	"strings"
 	"testing"
+
+	"github.com/emirpasic/gods/v2/testutils"
+	"github.com/emirpasic/gods/v2/trees"
 )
 
+func TestBinaryHeapContract(t *testing.T) {
+	testutils.HeapContract(t, func() trees.Heap[int] { return New[int]() })
+}
+
 func TestBinaryHeapPush(t *testing.T) {
 	heap := New[int]()
 
@@ -101,6 +109,176 @@ func TestBinaryHeapRandom(t *testing.T) {
 	}
 }
 
+func TestBinaryHeapNewFromSlice(t *testing.T) {
+	values := []int{5, 9, 1, 7, 3, 8, 2, 6, 4, 0}
+	heap := NewFromSlice(values, cmp.Compare[int])
+
+	if actualValue, expectedValue := values, []int{5, 9, 1, 7, 3, 8, 2, 6, 4, 0}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	for i := 0; i < 10; i++ {
+		if actualValue, ok := heap.Pop(); actualValue != i || !ok {
+			t.Errorf("Got %v expected %v", actualValue, i)
+		}
+	}
+	if actualValue := heap.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+}
+
+func TestBinaryHeapPushBulkRandom(t *testing.T) {
+	rand.Seed(5)
+	for _, sizes := range [][2]int{{1000, 3}, {3, 1000}, {100, 100}, {0, 10}} {
+		heap := New[int]()
+		var expected []int
+		for _, size := range sizes {
+			values := make([]int, size)
+			for i := range values {
+				values[i] = rand.Intn(1000)
+			}
+			heap.Push(values...)
+			expected = append(expected, values...)
+		}
+		slices.Sort(expected)
+		for _, expectedValue := range expected {
+			if actualValue, _ := heap.Pop(); actualValue != expectedValue {
+				t.Fatalf("Got %v expected %v", actualValue, expectedValue)
+			}
+		}
+	}
+}
+
+func TestBinaryHeapMerge(t *testing.T) {
+	heap := NewFromSlice([]int{5, 1, 3}, cmp.Compare[int])
+	other := NewFromSlice([]int{4, 2, 6}, cmp.Compare[int])
+	heap.Merge(other)
+	heap.Merge(heap)
+
+	if actualValue := other.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue, expectedValue := heap.Size(), 6; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	for i := 1; i <= 6; i++ {
+		if actualValue, ok := heap.Pop(); actualValue != i || !ok {
+			t.Errorf("Got %v expected %v", actualValue, i)
+		}
+	}
+}
+
+func TestBinaryHeapPushPopAndReplace(t *testing.T) {
+	heap := New[int]()
+
+	if actualValue := heap.PushPop(3); actualValue != 3 {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+	if actualValue, ok := heap.Replace(3); actualValue != 0 || ok {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+	heap.Push(5, 7)
+
+	tests := []struct {
+		replace  bool
+		value    int
+		expected int
+		top      int
+	}{
+		{false, 1, 1, 3},
+		{false, 4, 3, 4},
+		{true, 1, 4, 1},
+		{true, 9, 1, 5},
+	}
+	for _, test := range tests {
+		var actualValue int
+		if test.replace {
+			actualValue, _ = heap.Replace(test.value)
+		} else {
+			actualValue = heap.PushPop(test.value)
+		}
+		if actualValue != test.expected {
+			t.Errorf("Got %v expected %v", actualValue, test.expected)
+		}
+		if actualValue, _ := heap.Peek(); actualValue != test.top {
+			t.Errorf("Got %v expected %v", actualValue, test.top)
+		}
+		if actualValue, expectedValue := heap.Size(), 3; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+}
+
+func TestBinaryHeapFix(t *testing.T) {
+	type item struct{ priority int }
+	items := []*item{{5}, {1}, {3}, {4}, {2}}
+	heap := NewFromSlice(items, func(a, b *item) int { return cmp.Compare(a.priority, b.priority) })
+
+	heap.Fix(-1)
+	heap.Fix(5)
+	items[0].priority = 0
+	heap.Fix(heap.IndexOf(items[0]))
+	if actualValue, _ := heap.Peek(); actualValue != items[0] {
+		t.Errorf("Got %v expected %v", actualValue, items[0])
+	}
+	items[1].priority = 10
+	heap.Fix(heap.IndexOf(items[1]))
+	if actualValue, expectedValue := heap.IndexOf(&item{}), -1; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	var priorities []int
+	for !heap.Empty() {
+		value, _ := heap.Pop()
+		priorities = append(priorities, value.priority)
+	}
+	if actualValue, expectedValue := priorities, []int{0, 2, 3, 4, 10}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestBinaryHeapTopK(t *testing.T) {
+	heap := New[int]()
+	heap.Push(8, 3, 5)
+	heap.TopK(2)
+
+	if actualValue, expectedValue := heap.Size(), 2; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	heap.Push(1)
+	heap.Push(9, 7, 2)
+	heap.Push(6)
+	if actualValue, expectedValue := heap.Size(), 2; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, ok := heap.Pop(); actualValue != 8 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 8)
+	}
+	if actualValue, ok := heap.Pop(); actualValue != 9 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 9)
+	}
+
+	rand.Seed(7)
+	heap.TopK(10)
+	var values []int
+	for i := 0; i < 1000; i++ {
+		value := rand.Intn(10000)
+		heap.Push(value)
+		values = append(values, value)
+	}
+	slices.Sort(values)
+	for _, expectedValue := range values[len(values)-10:] {
+		if actualValue, _ := heap.Pop(); actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+
+	heap.TopK(0)
+	heap.Push(1, 2, 3)
+	if actualValue, expectedValue := heap.Size(), 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
 func TestBinaryHeapIteratorOnEmpty(t *testing.T) {
 	heap := New[int]()
 	it := heap.Iterator()
@@ -368,6 +546,39 @@ func TestBinaryHeapIteratorPrevTo(t *testing.T) {
 	}
 }
 
+func TestBinaryHeapIteratorFailFast(t *testing.T) {
+	heap := New[int]()
+	heap.Push(1)
+	heap.Push(2)
+	it := heap.Iterator()
+	it.Next()
+	heap.Push(3)
+	testutils.ConcurrentModificationPanic(t, func() { it.Next() })
+	testutils.ConcurrentModificationPanic(t, func() { it.Prev() })
+
+	// reset iterator is valid again
+	count := 0
+	for it.Begin(); it.Next(); {
+		count++
+	}
+	if actualValue, expectedValue := count, 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// pushing nothing and values rejected in top-k mode do not modify the heap
+	it.First()
+	heap.Push()
+	heap.TopK(3)
+	heap.Push(0)
+	it.Next() // no panic
+
+	heap.SetFailFast(false)
+	it.Begin()
+	it.Next()
+	heap.Push(4)
+	it.Next() // no panic
+}
+
 func TestBinaryHeapSerialization(t *testing.T) {
 	heap := New[string]()
 
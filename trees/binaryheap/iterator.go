@@ -13,13 +13,14 @@ var _ containers.ReverseIteratorWithIndex[int] = (*Iterator[int])(nil)
 
 // Iterator returns a stateful iterator whose values can be fetched by an index.
 type Iterator[T comparable] struct {
-	heap  *Heap[T]
-	index int
+	heap     *Heap[T]
+	index    int
+	modCount int // heap's modification count the iterator is in sync with
 }
 
 // Iterator returns a stateful iterator whose values can be fetched by an index.
 func (heap *Heap[T]) Iterator() *Iterator[T] {
-	return &Iterator[T]{heap: heap, index: -1}
+	return &Iterator[T]{heap: heap, index: -1, modCount: heap.modCount}
 }
 
 // Next moves the iterator to the next element and returns true if there was a next element in the container.
@@ -27,6 +28,7 @@ func (heap *Heap[T]) Iterator() *Iterator[T] {
 // If Next() was called for the first time, then it will point the iterator to the first element if it exists.
 // Modifies the state of the iterator.
 func (iterator *Iterator[T]) Next() bool {
+	iterator.checkModification()
 	if iterator.index < iterator.heap.Size() {
 		iterator.index++
 	}
@@ -37,6 +39,7 @@ func (iterator *Iterator[T]) Next() bool {
 // If Prev() returns true, then previous element's index and value can be retrieved by Index() and Value().
 // Modifies the state of the iterator.
 func (iterator *Iterator[T]) Prev() bool {
+	iterator.checkModification()
 	if iterator.index >= 0 {
 		iterator.index--
 	}
@@ -72,12 +75,14 @@ func (iterator *Iterator[T]) Index() int {
 // Call Next() to fetch the first element if any.
 func (iterator *Iterator[T]) Begin() {
 	iterator.index = -1
+	iterator.modCount = iterator.heap.modCount
 }
 
 // End moves the iterator past the last element (one-past-the-end).
 // Call Prev() to fetch the last element if any.
 func (iterator *Iterator[T]) End() {
 	iterator.index = iterator.heap.Size()
+	iterator.modCount = iterator.heap.modCount
 }
 
 // First moves the iterator to the first element and returns true if there was a first element in the container.
@@ -141,3 +146,16 @@ func evaluateRange(index int) (start int, end int) {
 	end = start + 1<<bits
 	return
 }
+
+// checkModification panics if the heap was modified since the iterator moved to its current element,
+// unless fail-fast iteration is disabled for the heap.
+func (iterator *Iterator[T]) checkModification() {
+	if iterator.index == -1 {
+		// not on an element yet, nothing to invalidate
+		iterator.modCount = iterator.heap.modCount
+		return
+	}
+	if iterator.modCount != iterator.heap.modCount && !iterator.heap.failFastDisabled {
+		panic(&containers.ConcurrentModificationError{Container: "BinaryHeap"})
+	}
+}
@@ -28,13 +28,16 @@ import (
 
 // Assert Tree implementation
 var _ trees.Tree[int] = (*Tree[string, int])(nil)
+var _ trees.OrderedTree[string, int] = (*Tree[string, int])(nil)
 
 // Tree holds elements of the B-tree
 type Tree[K comparable, V any] struct {
-	Root       *Node[K, V]         // Root node
-	Comparator utils.Comparator[K] // Key comparator
-	size       int                 // Total number of keys in the tree
-	m          int                 // order (maximum number of children)
+	Root             *Node[K, V]         // Root node
+	Comparator       utils.Comparator[K] // Key comparator
+	size             int                 // Total number of keys in the tree
+	m                int                 // order (maximum number of children)
+	modCount         int                 // number of structural modifications, checked by iterators
+	failFastDisabled bool                // iterators do not check for modifications
 }
 
 // Node is a single element within the tree
@@ -72,11 +75,13 @@ func (tree *Tree[K, V]) Put(key K, value V) {
 	if tree.Root == nil {
 		tree.Root = &Node[K, V]{Entries: []*Entry[K, V]{entry}, Children: []*Node[K, V]{}}
 		tree.size++
+		tree.modCount++
 		return
 	}
 
 	if tree.insert(tree.Root, entry) {
 		tree.size++
+		tree.modCount++
 	}
 }
 
@@ -105,6 +110,7 @@ func (tree *Tree[K, V]) Remove(key K) {
 	if found {
 		tree.delete(node, index)
 		tree.size--
+		tree.modCount++
 	}
 }
 
@@ -155,6 +161,7 @@ func (tree *Tree[K, V]) Values() []V {
 func (tree *Tree[K, V]) Clear() {
 	tree.Root = nil
 	tree.size = 0
+	tree.modCount++
 }
 
 // Height returns the height of the tree.
@@ -204,6 +211,171 @@ func (tree *Tree[K, V]) RightValue() interface{} {
 	return nil
 }
 
+// LeftEntry returns the left-most (min) key and its value.
+// Third return parameter is false if the tree is empty.
+func (tree *Tree[K, V]) LeftEntry() (key K, value V, found bool) {
+	if left := tree.Left(); left != nil {
+		entry := left.Entries[0]
+		return entry.Key, entry.Value, true
+	}
+	return key, value, false
+}
+
+// RightEntry returns the right-most (max) key and its value.
+// Third return parameter is false if the tree is empty.
+func (tree *Tree[K, V]) RightEntry() (key K, value V, found bool) {
+	if right := tree.Right(); right != nil {
+		entry := right.Entries[len(right.Entries)-1]
+		return entry.Key, entry.Value, true
+	}
+	return key, value, false
+}
+
+// Floor finds the floor entry of the input key, return the floor entry or nil if no floor is found.
+// Second return parameter is true if floor was found, otherwise false.
+//
+// Floor entry is defined as the largest entry that is smaller than or equal to the given key.
+// A floor entry may not be found, either because the tree is empty, or because
+// all entries in the tree are larger than the given key.
+//
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (tree *Tree[K, V]) Floor(key K) (floor *Entry[K, V], found bool) {
+	for node := tree.Root; node != nil && len(node.Entries) > 0; {
+		index, ok := tree.search(node, key)
+		if ok {
+			return node.Entries[index], true
+		}
+		if index > 0 {
+			floor, found = node.Entries[index-1], true
+		}
+		if tree.isLeaf(node) {
+			break
+		}
+		node = node.Children[index]
+	}
+	return floor, found
+}
+
+// Ceiling finds the ceiling entry of the input key, return the ceiling entry or nil if no ceiling is found.
+// Second return parameter is true if ceiling was found, otherwise false.
+//
+// Ceiling entry is defined as the smallest entry that is larger than or equal to the given key.
+// A ceiling entry may not be found, either because the tree is empty, or because
+// all entries in the tree are smaller than the given key.
+//
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (tree *Tree[K, V]) Ceiling(key K) (ceiling *Entry[K, V], found bool) {
+	for node := tree.Root; node != nil && len(node.Entries) > 0; {
+		index, ok := tree.search(node, key)
+		if ok {
+			return node.Entries[index], true
+		}
+		if index < len(node.Entries) {
+			ceiling, found = node.Entries[index], true
+		}
+		if tree.isLeaf(node) {
+			break
+		}
+		node = node.Children[index]
+	}
+	return ceiling, found
+}
+
+// Lower finds the lower entry of the input key, return the lower entry or nil if no lower is found.
+// Second return parameter is true if lower was found, otherwise false.
+//
+// Lower entry is defined as the largest entry that is strictly smaller than the given key.
+//
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (tree *Tree[K, V]) Lower(key K) (lower *Entry[K, V], found bool) {
+	for node := tree.Root; node != nil && len(node.Entries) > 0; {
+		index, _ := tree.search(node, key)
+		if index > 0 {
+			lower, found = node.Entries[index-1], true
+		}
+		if tree.isLeaf(node) {
+			break
+		}
+		node = node.Children[index]
+	}
+	return lower, found
+}
+
+// Higher finds the higher entry of the input key, return the higher entry or nil if no higher is found.
+// Second return parameter is true if higher was found, otherwise false.
+//
+// Higher entry is defined as the smallest entry that is strictly larger than the given key.
+//
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (tree *Tree[K, V]) Higher(key K) (higher *Entry[K, V], found bool) {
+	for node := tree.Root; node != nil && len(node.Entries) > 0; {
+		index, ok := tree.search(node, key)
+		if ok {
+			index++
+		}
+		if index < len(node.Entries) {
+			higher, found = node.Entries[index], true
+		}
+		if tree.isLeaf(node) {
+			break
+		}
+		node = node.Children[index]
+	}
+	return higher, found
+}
+
+// FloorEntry returns the floor key and its value, see Floor.
+// Third return parameter is false if no floor is found.
+func (tree *Tree[K, V]) FloorEntry(key K) (floorKey K, floorValue V, found bool) {
+	if entry, found := tree.Floor(key); found {
+		return entry.Key, entry.Value, true
+	}
+	return floorKey, floorValue, false
+}
+
+// CeilingEntry returns the ceiling key and its value, see Ceiling.
+// Third return parameter is false if no ceiling is found.
+func (tree *Tree[K, V]) CeilingEntry(key K) (ceilingKey K, ceilingValue V, found bool) {
+	if entry, found := tree.Ceiling(key); found {
+		return entry.Key, entry.Value, true
+	}
+	return ceilingKey, ceilingValue, false
+}
+
+// LowerEntry returns the lower key and its value, see Lower.
+// Third return parameter is false if no lower is found.
+func (tree *Tree[K, V]) LowerEntry(key K) (lowerKey K, lowerValue V, found bool) {
+	if entry, found := tree.Lower(key); found {
+		return entry.Key, entry.Value, true
+	}
+	return lowerKey, lowerValue, false
+}
+
+// HigherEntry returns the higher key and its value, see Higher.
+// Third return parameter is false if no higher is found.
+func (tree *Tree[K, V]) HigherEntry(key K) (higherKey K, higherValue V, found bool) {
+	if entry, found := tree.Higher(key); found {
+		return entry.Key, entry.Value, true
+	}
+	return higherKey, higherValue, false
+}
+
+// KeyComparator returns the comparator that orders the keys.
+func (tree *Tree[K, V]) KeyComparator() utils.Comparator[K] {
+	return tree.Comparator
+}
+
+// NewEmpty returns a new empty tree with the same order and comparator.
+func (tree *Tree[K, V]) NewEmpty() trees.OrderedTree[K, V] {
+	return NewWith[K, V](tree.m, tree.Comparator)
+}
+
+// SetFailFast enables or disables the checks of iterators for modifications of the tree done while iterating,
+// see containers.ConcurrentModificationError. Checks are enabled by default.
+func (tree *Tree[K, V]) SetFailFast(enabled bool) {
+	tree.failFastDisabled = !enabled
+}
+
 // String returns a string representation of container (for debugging purposes)
 func (tree *Tree[K, V]) String() string {
 	var buffer bytes.Buffer
@@ -6,9 +6,12 @@ package btree
 
 import (
 	"encoding/json"
+	"fmt"
 	"slices"
 	"strings"
 	"testing"
+
+	"github.com/emirpasic/gods/v2/testutils"
 )
 
 func TestBTreeGet1(t *testing.T) {
@@ -697,6 +700,47 @@ func TestBTreeLeftAndRight(t *testing.T) {
 	}
 }
 
+func TestBTreeLowerAndHigher(t *testing.T) {
+	tree := New[int, int](3)
+	if _, found := tree.Lower(10); found {
+		t.Errorf("Got %v expected %v", found, false)
+	}
+	if _, found := tree.Higher(10); found {
+		t.Errorf("Got %v expected %v", found, false)
+	}
+
+	for i := 2; i <= 40; i += 2 {
+		tree.Put(i, i*10)
+	}
+	for key := 0; key <= 42; key++ {
+		expectedLower, expectedHigher := -1, -1
+		for i := 2; i <= 40; i += 2 {
+			if i < key {
+				expectedLower = i
+			}
+			if i > key && expectedHigher == -1 {
+				expectedHigher = i
+			}
+		}
+
+		lowerKey, lowerValue, found := tree.LowerEntry(key)
+		if actualValue, expectedValue := found, expectedLower != -1; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v for lower of %v", actualValue, expectedValue, key)
+		}
+		if found && (lowerKey != expectedLower || lowerValue != expectedLower*10) {
+			t.Errorf("Got %v expected %v for lower of %v", lowerKey, expectedLower, key)
+		}
+
+		higherKey, higherValue, found := tree.HigherEntry(key)
+		if actualValue, expectedValue := found, expectedHigher != -1; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v for higher of %v", actualValue, expectedValue, key)
+		}
+		if found && (higherKey != expectedHigher || higherValue != expectedHigher*10) {
+			t.Errorf("Got %v expected %v for higher of %v", higherKey, expectedHigher, key)
+		}
+	}
+}
+
 func TestBTreeIteratorValuesAndKeys(t *testing.T) {
 	tree := New[int, string](4)
 	tree.Put(4, "d")
@@ -1043,6 +1087,67 @@ func TestBTreeSearch(t *testing.T) {
 	}
 }
 
+func TestBTreeFloorAndCeiling(t *testing.T) {
+	tree := New[int, string](3)
+
+	if _, found := tree.Floor(1); found {
+		t.Errorf("Got %v expected %v", found, false)
+	}
+	if _, found := tree.Ceiling(1); found {
+		t.Errorf("Got %v expected %v", found, false)
+	}
+
+	for i := 2; i <= 40; i += 2 {
+		tree.Put(i, fmt.Sprintf("%d", i))
+	}
+
+	for key := 0; key <= 42; key++ {
+		floor, foundFloor := tree.Floor(key)
+		expectedFloor := key - key%2
+		if expectedFloor < 2 || expectedFloor > 40 {
+			if expectedFloor > 40 {
+				expectedFloor = 40
+			} else {
+				expectedFloor = -1
+			}
+		}
+		if actualValue, expectedValue := foundFloor, expectedFloor != -1; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v for floor of %v", actualValue, expectedValue, key)
+		}
+		if foundFloor && floor.Key != expectedFloor {
+			t.Errorf("Got %v expected %v for floor of %v", floor.Key, expectedFloor, key)
+		}
+
+		ceiling, foundCeiling := tree.Ceiling(key)
+		expectedCeiling := key + key%2
+		if expectedCeiling < 2 {
+			expectedCeiling = 2
+		}
+		if expectedCeiling > 40 {
+			expectedCeiling = -1
+		}
+		if actualValue, expectedValue := foundCeiling, expectedCeiling != -1; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v for ceiling of %v", actualValue, expectedValue, key)
+		}
+		if foundCeiling && ceiling.Key != expectedCeiling {
+			t.Errorf("Got %v expected %v for ceiling of %v", ceiling.Key, expectedCeiling, key)
+		}
+	}
+
+	if key, value, found := tree.FloorEntry(13); key != 12 || value != "12" || !found {
+		t.Errorf("Got %v %v %v expected %v %v %v", key, value, found, 12, "12", true)
+	}
+	if key, value, found := tree.CeilingEntry(13); key != 14 || value != "14" || !found {
+		t.Errorf("Got %v %v %v expected %v %v %v", key, value, found, 14, "14", true)
+	}
+	if key, value, found := tree.LeftEntry(); key != 2 || value != "2" || !found {
+		t.Errorf("Got %v %v %v expected %v %v %v", key, value, found, 2, "2", true)
+	}
+	if key, value, found := tree.RightEntry(); key != 40 || value != "40" || !found {
+		t.Errorf("Got %v %v %v expected %v %v %v", key, value, found, 40, "40", true)
+	}
+}
+
 func assertValidTree[K comparable, V any](t *testing.T, tree *Tree[K, V], expectedSize int) {
 	if actualValue, expectedValue := tree.size, expectedSize; actualValue != expectedValue {
 		t.Errorf("Got %v expected %v for tree size", actualValue, expectedValue)
@@ -1172,6 +1277,53 @@ func TestBTreeIteratorPrevTo(t *testing.T) {
 	}
 }
 
+func TestBTreeIteratorFailFast(t *testing.T) {
+	tree := New[int, string](3)
+	tree.Put(1, "a")
+	tree.Put(2, "b")
+	tree.Put(3, "c")
+	it := tree.Iterator()
+	it.Next()
+	tree.Put(4, "d")
+	testutils.ConcurrentModificationPanic(t, func() { it.Next() })
+	testutils.ConcurrentModificationPanic(t, func() { it.Prev() })
+
+	// reset iterator is valid again
+	count := 0
+	for it.Begin(); it.Next(); {
+		count++
+	}
+	if actualValue, expectedValue := count, 4; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// replacing a value is not a structural modification
+	it.First()
+	tree.Put(1, "z")
+	tree.Get(3)
+	if actualValue := it.Next(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+
+	for _, modify := range []func(){
+		func() { tree.Remove(2) },
+		func() { tree.Put(5, "e") },
+		func() { tree.Clear() },
+	} {
+		tree.Put(1, "a")
+		tree.Put(2, "b")
+		it.First()
+		modify()
+		testutils.ConcurrentModificationPanic(t, func() { it.Next() })
+	}
+
+	tree.SetFailFast(false)
+	tree.Put(1, "a")
+	it.First()
+	tree.Put(6, "f")
+	it.Next() // no panic
+}
+
 func TestBTreeSerialization(t *testing.T) {
 	tree := New[string, string](3)
 	tree.Put("c", "3")
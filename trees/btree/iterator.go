@@ -15,6 +15,7 @@ type Iterator[K comparable, V any] struct {
 	node     *Node[K, V]
 	entry    *Entry[K, V]
 	position position
+	modCount int // tree's modification count the iterator is in sync with
 }
 
 type position byte
@@ -25,7 +26,12 @@ const (
 
 // Iterator returns a stateful iterator whose elements are key/value pairs.
 func (tree *Tree[K, V]) Iterator() *Iterator[K, V] {
-	return &Iterator[K, V]{tree: tree, node: nil, position: begin}
+	return &Iterator[K, V]{tree: tree, node: nil, position: begin, modCount: tree.modCount}
+}
+
+// IteratorWithKey returns the same iterator as Iterator, typed as containers.ReverseIteratorWithKey.
+func (tree *Tree[K, V]) IteratorWithKey() containers.ReverseIteratorWithKey[K, V] {
+	return tree.Iterator()
 }
 
 // Next moves the iterator to the next element and returns true if there was a next element in the container.
@@ -33,6 +39,7 @@ func (tree *Tree[K, V]) Iterator() *Iterator[K, V] {
 // If Next() was called for the first time, then it will point the iterator to the first element if it exists.
 // Modifies the state of the iterator.
 func (iterator *Iterator[K, V]) Next() bool {
+	iterator.checkModification()
 	// If already at end, go to end
 	if iterator.position == end {
 		goto end
@@ -92,6 +99,7 @@ between:
 // If Prev() returns true, then previous element's key and value can be retrieved by Key() and Value().
 // Modifies the state of the iterator.
 func (iterator *Iterator[K, V]) Prev() bool {
+	iterator.checkModification()
 	// If already at beginning, go to begin
 	if iterator.position == begin {
 		goto begin
@@ -224,3 +232,12 @@ func (iterator *Iterator[K, V]) PrevTo(f func(key K, value V) bool) bool {
 	}
 	return false
 }
+
+// checkModification panics if the tree was structurally modified since the iterator moved to its current element,
+// unless fail-fast iteration is disabled for the tree.
+func (iterator *Iterator[K, V]) checkModification() {
+	if iterator.position == between && iterator.modCount != iterator.tree.modCount && !iterator.tree.failFastDisabled {
+		panic(&containers.ConcurrentModificationError{Container: "BTree"})
+	}
+	iterator.modCount = iterator.tree.modCount
+}
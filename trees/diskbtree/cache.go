@@ -0,0 +1,73 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package diskbtree
+
+import "container/list"
+
+// DefaultCacheSize is the number of decoded nodes kept in memory by default.
+const DefaultCacheSize = 1024
+
+// nodeCache is a bounded least-recently-used cache of decoded nodes.
+// Pages are never modified in place (copy-on-write), so a cached node never gets stale while its page is in use.
+type nodeCache[K comparable, V any] struct {
+	capacity int
+	order    *list.List // front is most recently used
+	elements map[PageID]*list.Element
+}
+
+func newNodeCache[K comparable, V any](capacity int) *nodeCache[K, V] {
+	return &nodeCache[K, V]{capacity: capacity, order: list.New(), elements: make(map[PageID]*list.Element)}
+}
+
+func (cache *nodeCache[K, V]) get(id PageID) (*node[K, V], bool) {
+	element, ok := cache.elements[id]
+	if !ok {
+		return nil, false
+	}
+	cache.order.MoveToFront(element)
+	return element.Value.(*node[K, V]), true
+}
+
+func (cache *nodeCache[K, V]) put(n *node[K, V]) {
+	if cache.capacity <= 0 {
+		return
+	}
+	if element, ok := cache.elements[n.id]; ok {
+		element.Value = n
+		cache.order.MoveToFront(element)
+		return
+	}
+	cache.elements[n.id] = cache.order.PushFront(n)
+	for cache.order.Len() > cache.capacity {
+		oldest := cache.order.Back()
+		cache.order.Remove(oldest)
+		delete(cache.elements, oldest.Value.(*node[K, V]).id)
+	}
+}
+
+func (cache *nodeCache[K, V]) remove(id PageID) {
+	if element, ok := cache.elements[id]; ok {
+		cache.order.Remove(element)
+		delete(cache.elements, id)
+	}
+}
+
+func (cache *nodeCache[K, V]) resize(capacity int) {
+	cache.capacity = capacity
+	for cache.order.Len() > max(capacity, 0) {
+		oldest := cache.order.Back()
+		cache.order.Remove(oldest)
+		delete(cache.elements, oldest.Value.(*node[K, V]).id)
+	}
+}
+
+func (cache *nodeCache[K, V]) clear() {
+	cache.order.Init()
+	cache.elements = make(map[PageID]*list.Element)
+}
+
+func (cache *nodeCache[K, V]) len() int {
+	return cache.order.Len()
+}
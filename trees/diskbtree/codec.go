@@ -0,0 +1,117 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package diskbtree
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math"
+)
+
+// Codec converts keys or values to and from their on-disk representation.
+type Codec[T any] interface {
+	// Encode appends the encoded value to buffer and returns the extended buffer.
+	Encode(buffer []byte, value T) ([]byte, error)
+
+	// Decode decodes a value previously encoded by Encode.
+	Decode(data []byte) (T, error)
+}
+
+// Integer is a constraint for all built-in integer types.
+type Integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 | ~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// StringCodec encodes strings as their raw bytes.
+type StringCodec struct{}
+
+// Encode appends the string's bytes.
+func (StringCodec) Encode(buffer []byte, value string) ([]byte, error) {
+	return append(buffer, value...), nil
+}
+
+// Decode returns the bytes as a string.
+func (StringCodec) Decode(data []byte) (string, error) {
+	return string(data), nil
+}
+
+// BytesCodec stores byte slices as they are.
+type BytesCodec struct{}
+
+// Encode appends the bytes.
+func (BytesCodec) Encode(buffer []byte, value []byte) ([]byte, error) {
+	return append(buffer, value...), nil
+}
+
+// Decode returns a copy of the bytes.
+func (BytesCodec) Decode(data []byte) ([]byte, error) {
+	return append([]byte(nil), data...), nil
+}
+
+// IntCodec encodes signed or unsigned integers as variable-length integers.
+type IntCodec[T Integer] struct{}
+
+// Encode appends the integer as a varint.
+func (IntCodec[T]) Encode(buffer []byte, value T) ([]byte, error) {
+	if T(0)-1 > 0 { // unsigned
+		return binary.AppendUvarint(buffer, uint64(value)), nil
+	}
+	return binary.AppendVarint(buffer, int64(value)), nil
+}
+
+// Decode reads a varint.
+func (IntCodec[T]) Decode(data []byte) (T, error) {
+	var n int
+	var value T
+	if T(0)-1 > 0 { // unsigned
+		var decoded uint64
+		decoded, n = binary.Uvarint(data)
+		value = T(decoded)
+	} else {
+		var decoded int64
+		decoded, n = binary.Varint(data)
+		value = T(decoded)
+	}
+	if n <= 0 || n != len(data) {
+		return value, fmt.Errorf("%w: invalid integer", ErrCorrupted)
+	}
+	return value, nil
+}
+
+// Float64Codec encodes float64 values in their IEEE 754 binary representation.
+type Float64Codec struct{}
+
+// Encode appends the 8 bytes of the float.
+func (Float64Codec) Encode(buffer []byte, value float64) ([]byte, error) {
+	return binary.LittleEndian.AppendUint64(buffer, math.Float64bits(value)), nil
+}
+
+// Decode reads the 8 bytes of the float.
+func (Float64Codec) Decode(data []byte) (float64, error) {
+	if len(data) != 8 {
+		return 0, fmt.Errorf("%w: invalid float", ErrCorrupted)
+	}
+	return math.Float64frombits(binary.LittleEndian.Uint64(data)), nil
+}
+
+// JSONCodec encodes any value through encoding/json.
+type JSONCodec[T any] struct{}
+
+// Encode appends the JSON representation of the value.
+func (JSONCodec[T]) Encode(buffer []byte, value T) ([]byte, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return buffer, err
+	}
+	return append(buffer, data...), nil
+}
+
+// Decode parses the JSON representation of the value.
+func (JSONCodec[T]) Decode(data []byte) (T, error) {
+	var value T
+	err := json.Unmarshal(data, &value)
+	return value, err
+}
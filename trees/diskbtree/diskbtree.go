@@ -0,0 +1,754 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package diskbtree implements a B tree whose nodes live in pages of a PageStore, e.g. a file.
+//
+// Each node is stored in a single page and only a bounded number of decoded nodes is cached in memory,
+// so the tree can grow beyond the available memory.
+//
+// Modifications are copy-on-write: a Put or Remove writes every touched node to a fresh page and then
+// commits the new root through the page store, which makes each operation atomic. A crash in the middle of
+// an operation leaves the previously committed tree on disk.
+//
+// Keys and values are converted to bytes with codecs, see Codec.
+// All nodes must fit into a page, so the order of the tree has to be chosen with the page size and the
+// encoded size of keys and values in mind.
+//
+// Structure is not thread safe.
+//
+// References: https://en.wikipedia.org/wiki/B-tree
+package diskbtree
+
+import (
+	"bytes"
+	"cmp"
+	"encoding/binary"
+	"fmt"
+	"strings"
+
+	"github.com/emirpasic/gods/v2/containers"
+	"github.com/emirpasic/gods/v2/utils"
+)
+
+// Assert FailFast implementation
+var _ containers.FailFast = (*Tree[string, int])(nil)
+
+const metaVersion = 1
+
+// Tree holds elements of the disk-backed B-tree
+type Tree[K comparable, V any] struct {
+	Comparator       utils.Comparator[K] // Key comparator
+	store            PageStore
+	keyCodec         Codec[K]
+	valueCodec       Codec[V]
+	cache            *nodeCache[K, V]
+	root             PageID // page of the root node, 0 if tree is empty
+	size             int    // Total number of keys in the tree
+	m                int    // order (maximum number of children)
+	modCount         int    // number of committed modifications, checked by iterators
+	failFastDisabled bool   // iterators do not check for modifications
+}
+
+// Entry represents the key-value pair contained within nodes
+type Entry[K comparable, V any] struct {
+	Key   K
+	Value V
+}
+
+// node is the decoded contents of a page.
+// Nodes held by the cache are shared and must be cloned before modification.
+type node[K comparable, V any] struct {
+	id       PageID // page the node was read from or written to, 0 for new nodes
+	entries  []Entry[K, V]
+	children []PageID
+}
+
+// New opens a B-tree with the order (maximum number of children) and the built-in comparator for K in the given store.
+// If the store already holds a tree, it must have been created with the same order.
+func New[K cmp.Ordered, V any](store PageStore, order int, keyCodec Codec[K], valueCodec Codec[V]) (*Tree[K, V], error) {
+	return NewWith[K, V](store, order, cmp.Compare[K], keyCodec, valueCodec)
+}
+
+// NewWith opens a B-tree with the order (maximum number of children) and a custom key comparator in the given store.
+// If the store already holds a tree, it must have been created with the same order and a compatible comparator.
+func NewWith[K comparable, V any](store PageStore, order int, comparator utils.Comparator[K], keyCodec Codec[K], valueCodec Codec[V]) (*Tree[K, V], error) {
+	if order < 3 {
+		panic("Invalid order, should be at least 3")
+	}
+	tree := &Tree[K, V]{
+		Comparator: comparator,
+		store:      store,
+		keyCodec:   keyCodec,
+		valueCodec: valueCodec,
+		cache:      newNodeCache[K, V](DefaultCacheSize),
+		m:          order,
+	}
+	meta, err := store.Meta()
+	if err != nil {
+		return nil, err
+	}
+	if meta == nil {
+		return tree, nil
+	}
+	version, order2, root, size, err := decodeMeta(meta)
+	if err != nil {
+		return nil, err
+	}
+	if version != metaVersion {
+		return nil, fmt.Errorf("%w: unsupported version %d", ErrCorrupted, version)
+	}
+	if order2 != order {
+		return nil, fmt.Errorf("order mismatch: store uses %d, requested %d", order2, order)
+	}
+	tree.root = root
+	tree.size = size
+	return tree, nil
+}
+
+// Put inserts key-value pair into the tree and commits the change.
+// If key already exists, then its value is updated with the new value.
+// On error the tree remains as it was before the call.
+func (tree *Tree[K, V]) Put(key K, value V) error {
+	entry := Entry[K, V]{Key: key, Value: value}
+
+	if tree.root == 0 {
+		root, err := tree.write(&node[K, V]{entries: []Entry[K, V]{entry}})
+		if err != nil {
+			return tree.rollback(err)
+		}
+		return tree.commit(root, 1)
+	}
+
+	root, err := tree.load(tree.root)
+	if err != nil {
+		return err
+	}
+	left, middle, right, inserted, err := tree.insert(root, entry)
+	if err != nil {
+		return tree.rollback(err)
+	}
+	if right != 0 {
+		left, err = tree.write(&node[K, V]{entries: []Entry[K, V]{middle}, children: []PageID{left, right}})
+		if err != nil {
+			return tree.rollback(err)
+		}
+	}
+	size := tree.size
+	if inserted {
+		size++
+	}
+	return tree.commit(left, size)
+}
+
+// Get searches the tree by key and returns its value or the zero value if key is not found in tree.
+// Second return parameter is true if key was found, otherwise false.
+func (tree *Tree[K, V]) Get(key K) (value V, found bool, err error) {
+	for id := tree.root; id != 0; {
+		n, err := tree.load(id)
+		if err != nil {
+			return value, false, err
+		}
+		index, found := tree.search(n, key)
+		if found {
+			return n.entries[index].Value, true, nil
+		}
+		if n.isLeaf() {
+			break
+		}
+		id = n.children[index]
+	}
+	return value, false, nil
+}
+
+// Remove removes the key from the tree and commits the change.
+// On error the tree remains as it was before the call.
+func (tree *Tree[K, V]) Remove(key K) error {
+	if tree.root == 0 {
+		return nil
+	}
+	root, err := tree.load(tree.root)
+	if err != nil {
+		return err
+	}
+	root = root.clone()
+	removed, err := tree.remove(root, key)
+	if err != nil {
+		return tree.rollback(err)
+	}
+	if !removed {
+		return nil
+	}
+	var id PageID
+	switch {
+	case len(root.entries) > 0:
+		id, err = tree.write(root)
+	case len(root.children) > 0: // root emptied by a merge of its only two children
+		id, err = root.children[0], tree.store.Free(root.id)
+	default:
+		err = tree.store.Free(root.id)
+	}
+	if err != nil {
+		return tree.rollback(err)
+	}
+	return tree.commit(id, tree.size-1)
+}
+
+// Empty returns true if tree does not contain any keys
+func (tree *Tree[K, V]) Empty() bool {
+	return tree.size == 0
+}
+
+// Size returns number of keys in the tree.
+func (tree *Tree[K, V]) Size() int {
+	return tree.size
+}
+
+// Keys returns all keys in-order
+func (tree *Tree[K, V]) Keys() ([]K, error) {
+	keys := make([]K, 0, tree.size)
+	it := tree.Iterator()
+	for it.Next() {
+		keys = append(keys, it.Key())
+	}
+	return keys, it.Err()
+}
+
+// Values returns all values in-order based on the key.
+func (tree *Tree[K, V]) Values() ([]V, error) {
+	values := make([]V, 0, tree.size)
+	it := tree.Iterator()
+	for it.Next() {
+		values = append(values, it.Value())
+	}
+	return values, it.Err()
+}
+
+// Clear removes all keys from the tree, releases their pages and commits the change.
+func (tree *Tree[K, V]) Clear() error {
+	if tree.root == 0 {
+		return nil
+	}
+	if err := tree.free(tree.root); err != nil {
+		return tree.rollback(err)
+	}
+	return tree.commit(0, 0)
+}
+
+// Height returns the height of the tree.
+func (tree *Tree[K, V]) Height() (int, error) {
+	height := 0
+	for id := tree.root; id != 0; height++ {
+		n, err := tree.load(id)
+		if err != nil {
+			return 0, err
+		}
+		if n.isLeaf() {
+			id = 0
+		} else {
+			id = n.children[0]
+		}
+	}
+	return height, nil
+}
+
+// Left returns the left-most (min) entry or nil if tree is empty.
+func (tree *Tree[K, V]) Left() (*Entry[K, V], error) {
+	it := tree.Iterator()
+	if !it.First() {
+		return nil, it.Err()
+	}
+	return &Entry[K, V]{Key: it.Key(), Value: it.Value()}, nil
+}
+
+// Right returns the right-most (max) entry or nil if tree is empty.
+func (tree *Tree[K, V]) Right() (*Entry[K, V], error) {
+	it := tree.Iterator()
+	if !it.Last() {
+		return nil, it.Err()
+	}
+	return &Entry[K, V]{Key: it.Key(), Value: it.Value()}, nil
+}
+
+// SetCacheSize sets the maximum number of decoded nodes kept in memory.
+// Zero disables caching, so every access reads from the page store.
+func (tree *Tree[K, V]) SetCacheSize(size int) {
+	tree.cache.resize(size)
+}
+
+// Close closes the underlying page store.
+func (tree *Tree[K, V]) Close() error {
+	tree.cache.clear()
+	return tree.store.Close()
+}
+
+// SetFailFast enables or disables the checks of iterators for modifications of the tree done while iterating,
+// see containers.ConcurrentModificationError. Checks are enabled by default.
+//
+// Every committed change may rewrite pages along the path of an iterator, so iterating on after a modification
+// with checks disabled can observe entries of pages that were since freed and reused.
+func (tree *Tree[K, V]) SetFailFast(enabled bool) {
+	tree.failFastDisabled = !enabled
+}
+
+// String returns a string representation of container (for debugging purposes)
+func (tree *Tree[K, V]) String() string {
+	var buffer bytes.Buffer
+	buffer.WriteString("DiskBTree\n")
+	if !tree.Empty() {
+		if err := tree.output(&buffer, tree.root, 0); err != nil {
+			buffer.WriteString(err.Error() + "\n")
+		}
+	}
+	return buffer.String()
+}
+
+func (entry *Entry[K, V]) String() string {
+	return fmt.Sprintf("%v", entry.Key)
+}
+
+func (tree *Tree[K, V]) output(buffer *bytes.Buffer, id PageID, level int) error {
+	n, err := tree.load(id)
+	if err != nil {
+		return err
+	}
+	for e := 0; e < len(n.entries)+1; e++ {
+		if e < len(n.children) {
+			if err := tree.output(buffer, n.children[e], level+1); err != nil {
+				return err
+			}
+		}
+		if e < len(n.entries) {
+			buffer.WriteString(strings.Repeat("    ", level))
+			buffer.WriteString(fmt.Sprintf("%v", n.entries[e].Key) + "\n")
+		}
+	}
+	return nil
+}
+
+func (n *node[K, V]) isLeaf() bool {
+	return len(n.children) == 0
+}
+
+func (n *node[K, V]) clone() *node[K, V] {
+	c := &node[K, V]{id: n.id, entries: append([]Entry[K, V](nil), n.entries...)}
+	if !n.isLeaf() {
+		c.children = append([]PageID(nil), n.children...)
+	}
+	return c
+}
+
+func (tree *Tree[K, V]) maxEntries() int {
+	return tree.m - 1
+}
+
+func (tree *Tree[K, V]) minEntries() int {
+	return (tree.m+1)/2 - 1 // ceil(m/2)-1
+}
+
+func (tree *Tree[K, V]) middle() int {
+	return (tree.m - 1) / 2 // "-1" to favor right nodes to have more keys when splitting
+}
+
+// search searches only within the single node among its entries
+func (tree *Tree[K, V]) search(n *node[K, V], key K) (index int, found bool) {
+	low, high := 0, len(n.entries)-1
+	var mid int
+	for low <= high {
+		mid = (high + low) / 2
+		compare := tree.Comparator(key, n.entries[mid].Key)
+		switch {
+		case compare > 0:
+			low = mid + 1
+		case compare < 0:
+			high = mid - 1
+		case compare == 0:
+			return mid, true
+		}
+	}
+	return low, false
+}
+
+// insert inserts the entry into the subtree of n, writing all modified nodes to new pages.
+// Returns the page of the rewritten node and, if the node had to be split, the middle entry and the page of the right half.
+func (tree *Tree[K, V]) insert(n *node[K, V], entry Entry[K, V]) (left PageID, middle Entry[K, V], right PageID, inserted bool, err error) {
+	n = n.clone()
+	index, found := tree.search(n, entry.Key)
+	switch {
+	case found:
+		n.entries[index] = entry
+	case n.isLeaf():
+		n.entries = insertAt(n.entries, index, entry)
+		inserted = true
+	default:
+		child, err := tree.load(n.children[index])
+		if err != nil {
+			return 0, middle, 0, false, err
+		}
+		childLeft, childMiddle, childRight, childInserted, err := tree.insert(child, entry)
+		if err != nil {
+			return 0, middle, 0, false, err
+		}
+		n.children[index] = childLeft
+		if childRight != 0 {
+			n.entries = insertAt(n.entries, index, childMiddle)
+			n.children = insertAt(n.children, index+1, childRight)
+		}
+		inserted = childInserted
+	}
+
+	if len(n.entries) <= tree.maxEntries() {
+		left, err = tree.write(n)
+		return left, middle, 0, inserted, err
+	}
+
+	// split
+	m := tree.middle()
+	leftNode := &node[K, V]{id: n.id, entries: append([]Entry[K, V](nil), n.entries[:m]...)}
+	rightNode := &node[K, V]{entries: append([]Entry[K, V](nil), n.entries[m+1:]...)}
+	if !n.isLeaf() {
+		leftNode.children = append([]PageID(nil), n.children[:m+1]...)
+		rightNode.children = append([]PageID(nil), n.children[m+1:]...)
+	}
+	if left, err = tree.write(leftNode); err != nil {
+		return 0, middle, 0, false, err
+	}
+	if right, err = tree.write(rightNode); err != nil {
+		return 0, middle, 0, false, err
+	}
+	return left, n.entries[m], right, inserted, nil
+}
+
+// remove removes the key from the subtree of n, where n is a private copy which is modified but not written.
+// Returns false if the key was not found, in which case nothing was modified.
+// ref.: https://en.wikipedia.org/wiki/B-tree#Deletion
+func (tree *Tree[K, V]) remove(n *node[K, V], key K) (bool, error) {
+	index, found := tree.search(n, key)
+	if n.isLeaf() {
+		if !found {
+			return false, nil
+		}
+		n.entries = deleteAt(n.entries, index)
+		return true, nil
+	}
+
+	child, err := tree.load(n.children[index])
+	if err != nil {
+		return false, err
+	}
+	child = child.clone()
+
+	if found {
+		// replace with the largest entry in the left sub-tree
+		largest, err := tree.removeLargest(child)
+		if err != nil {
+			return false, err
+		}
+		n.entries[index] = largest
+		return true, tree.rebalance(n, index, child)
+	}
+
+	removed, err := tree.remove(child, key)
+	if err != nil || !removed {
+		return false, err
+	}
+	return true, tree.rebalance(n, index, child)
+}
+
+// removeLargest removes and returns the largest entry in the subtree of n (modified but not written).
+func (tree *Tree[K, V]) removeLargest(n *node[K, V]) (Entry[K, V], error) {
+	if n.isLeaf() {
+		largest := n.entries[len(n.entries)-1]
+		n.entries = n.entries[:len(n.entries)-1]
+		return largest, nil
+	}
+	index := len(n.children) - 1
+	child, err := tree.load(n.children[index])
+	if err != nil {
+		return Entry[K, V]{}, err
+	}
+	child = child.clone()
+	largest, err := tree.removeLargest(child)
+	if err != nil {
+		return largest, err
+	}
+	return largest, tree.rebalance(n, index, child)
+}
+
+// rebalance writes the modified child at the index of the parent (modified but not written),
+// borrowing from or merging with a sibling if the child underflows.
+func (tree *Tree[K, V]) rebalance(parent *node[K, V], index int, child *node[K, V]) (err error) {
+	if len(child.entries) >= tree.minEntries() {
+		parent.children[index], err = tree.write(child)
+		return err
+	}
+
+	var leftSibling, rightSibling *node[K, V]
+
+	// try to borrow from left sibling
+	if index > 0 {
+		if leftSibling, err = tree.load(parent.children[index-1]); err != nil {
+			return err
+		}
+		if len(leftSibling.entries) > tree.minEntries() {
+			// rotate right
+			leftSibling = leftSibling.clone()
+			last := len(leftSibling.entries) - 1
+			child.entries = insertAt(child.entries, 0, parent.entries[index-1])
+			parent.entries[index-1] = leftSibling.entries[last]
+			leftSibling.entries = leftSibling.entries[:last]
+			if !leftSibling.isLeaf() {
+				last = len(leftSibling.children) - 1
+				child.children = insertAt(child.children, 0, leftSibling.children[last])
+				leftSibling.children = leftSibling.children[:last]
+			}
+			return tree.writePair(parent, index-1, leftSibling, child)
+		}
+	}
+
+	// try to borrow from right sibling
+	if index < len(parent.children)-1 {
+		if rightSibling, err = tree.load(parent.children[index+1]); err != nil {
+			return err
+		}
+		if len(rightSibling.entries) > tree.minEntries() {
+			// rotate left
+			rightSibling = rightSibling.clone()
+			child.entries = append(child.entries, parent.entries[index])
+			parent.entries[index] = rightSibling.entries[0]
+			rightSibling.entries = deleteAt(rightSibling.entries, 0)
+			if !rightSibling.isLeaf() {
+				child.children = append(child.children, rightSibling.children[0])
+				rightSibling.children = deleteAt(rightSibling.children, 0)
+			}
+			return tree.writePair(parent, index, child, rightSibling)
+		}
+	}
+
+	// merge with siblings
+	if rightSibling != nil {
+		// merge with right sibling
+		child.entries = append(child.entries, parent.entries[index])
+		child.entries = append(child.entries, rightSibling.entries...)
+		child.children = append(child.children, rightSibling.children...)
+		if err := tree.store.Free(rightSibling.id); err != nil {
+			return err
+		}
+		parent.entries = deleteAt(parent.entries, index)
+		parent.children = deleteAt(parent.children, index+1)
+		parent.children[index], err = tree.write(child)
+		return err
+	}
+	// merge with left sibling
+	leftSibling = leftSibling.clone()
+	leftSibling.entries = append(leftSibling.entries, parent.entries[index-1])
+	leftSibling.entries = append(leftSibling.entries, child.entries...)
+	leftSibling.children = append(leftSibling.children, child.children...)
+	if err := tree.store.Free(child.id); err != nil {
+		return err
+	}
+	parent.entries = deleteAt(parent.entries, index-1)
+	parent.children = deleteAt(parent.children, index)
+	parent.children[index-1], err = tree.write(leftSibling)
+	return err
+}
+
+func (tree *Tree[K, V]) writePair(parent *node[K, V], index int, left, right *node[K, V]) (err error) {
+	if parent.children[index], err = tree.write(left); err != nil {
+		return err
+	}
+	parent.children[index+1], err = tree.write(right)
+	return err
+}
+
+// free releases the pages of the whole subtree.
+func (tree *Tree[K, V]) free(id PageID) error {
+	n, err := tree.load(id)
+	if err != nil {
+		return err
+	}
+	for _, child := range n.children {
+		if err := tree.free(child); err != nil {
+			return err
+		}
+	}
+	return tree.store.Free(id)
+}
+
+// load returns the node stored in the page, either from the cache or decoded from the page store.
+func (tree *Tree[K, V]) load(id PageID) (*node[K, V], error) {
+	if n, ok := tree.cache.get(id); ok {
+		return n, nil
+	}
+	page, err := tree.store.Read(id)
+	if err != nil {
+		return nil, err
+	}
+	n, err := tree.decode(page)
+	if err != nil {
+		return nil, fmt.Errorf("page %d: %w", id, err)
+	}
+	n.id = id
+	tree.cache.put(n)
+	return n, nil
+}
+
+// write stores the node into a newly allocated page and releases its previous page if any.
+func (tree *Tree[K, V]) write(n *node[K, V]) (PageID, error) {
+	data, err := tree.encode(n)
+	if err != nil {
+		return 0, err
+	}
+	if len(data) > tree.store.PageSize() {
+		return 0, fmt.Errorf("%w: node with %d entries needs %d bytes", ErrPageTooLarge, len(n.entries), len(data))
+	}
+	if n.id != 0 {
+		if err := tree.store.Free(n.id); err != nil {
+			return 0, err
+		}
+	}
+	id, err := tree.store.Allocate()
+	if err != nil {
+		return 0, err
+	}
+	if err := tree.store.Write(id, data); err != nil {
+		return 0, err
+	}
+	n.id = id
+	tree.cache.put(n)
+	return id, nil
+}
+
+func (tree *Tree[K, V]) commit(root PageID, size int) error {
+	meta := binary.AppendUvarint(nil, metaVersion)
+	meta = binary.AppendUvarint(meta, uint64(tree.m))
+	meta = binary.AppendUvarint(meta, uint64(root))
+	meta = binary.AppendUvarint(meta, uint64(size))
+	if err := tree.store.Commit(meta); err != nil {
+		return tree.rollback(err)
+	}
+	tree.root = root
+	tree.size = size
+	tree.modCount++
+	return nil
+}
+
+// rollback discards the uncommitted changes and returns the error that caused it.
+func (tree *Tree[K, V]) rollback(err error) error {
+	// pages written by the failed operation may be reused, so cached nodes might not match them anymore
+	tree.cache.clear()
+	if rollbackErr := tree.store.Rollback(); rollbackErr != nil {
+		return fmt.Errorf("%w (rollback: %v)", err, rollbackErr)
+	}
+	return err
+}
+
+func decodeMeta(meta []byte) (version, order int, root PageID, size int, err error) {
+	var fields [4]uint64
+	for i := range fields {
+		value, n := binary.Uvarint(meta)
+		if n <= 0 {
+			return 0, 0, 0, 0, fmt.Errorf("%w: invalid meta record", ErrCorrupted)
+		}
+		fields[i] = value
+		meta = meta[n:]
+	}
+	return int(fields[0]), int(fields[1]), PageID(fields[2]), int(fields[3]), nil
+}
+
+// Page layout of a node: number of entries, number of children, length-prefixed keys and values, child pages.
+func (tree *Tree[K, V]) encode(n *node[K, V]) ([]byte, error) {
+	data := binary.AppendUvarint(nil, uint64(len(n.entries)))
+	data = binary.AppendUvarint(data, uint64(len(n.children)))
+	var scratch []byte
+	var err error
+	for _, entry := range n.entries {
+		if scratch, err = tree.keyCodec.Encode(scratch[:0], entry.Key); err != nil {
+			return nil, err
+		}
+		data = binary.AppendUvarint(data, uint64(len(scratch)))
+		data = append(data, scratch...)
+		if scratch, err = tree.valueCodec.Encode(scratch[:0], entry.Value); err != nil {
+			return nil, err
+		}
+		data = binary.AppendUvarint(data, uint64(len(scratch)))
+		data = append(data, scratch...)
+	}
+	for _, child := range n.children {
+		data = binary.AppendUvarint(data, uint64(child))
+	}
+	return data, nil
+}
+
+func (tree *Tree[K, V]) decode(page []byte) (*node[K, V], error) {
+	reader := pageReader{data: page}
+	entries, children := reader.uvarint(), reader.uvarint()
+	if reader.err != nil || entries > uint64(tree.m) || (children != 0 && children != entries+1) {
+		return nil, fmt.Errorf("%w: invalid node header", ErrCorrupted)
+	}
+	n := &node[K, V]{entries: make([]Entry[K, V], entries)}
+	for i := range n.entries {
+		key, value := reader.bytes(), reader.bytes()
+		if reader.err != nil {
+			return nil, reader.err
+		}
+		var err error
+		if n.entries[i].Key, err = tree.keyCodec.Decode(key); err != nil {
+			return nil, err
+		}
+		if n.entries[i].Value, err = tree.valueCodec.Decode(value); err != nil {
+			return nil, err
+		}
+	}
+	if children > 0 {
+		n.children = make([]PageID, children)
+		for i := range n.children {
+			n.children[i] = PageID(reader.uvarint())
+		}
+	}
+	return n, reader.err
+}
+
+type pageReader struct {
+	data []byte
+	err  error
+}
+
+func (reader *pageReader) uvarint() uint64 {
+	if reader.err != nil {
+		return 0
+	}
+	value, n := binary.Uvarint(reader.data)
+	if n <= 0 {
+		reader.err = fmt.Errorf("%w: truncated node", ErrCorrupted)
+		return 0
+	}
+	reader.data = reader.data[n:]
+	return value
+}
+
+func (reader *pageReader) bytes() []byte {
+	length := reader.uvarint()
+	if reader.err != nil {
+		return nil
+	}
+	if length > uint64(len(reader.data)) {
+		reader.err = fmt.Errorf("%w: truncated node", ErrCorrupted)
+		return nil
+	}
+	data := reader.data[:length]
+	reader.data = reader.data[length:]
+	return data
+}
+
+func insertAt[T any](slice []T, index int, value T) []T {
+	var zero T
+	slice = append(slice, zero)
+	copy(slice[index+1:], slice[index:])
+	slice[index] = value
+	return slice
+}
+
+func deleteAt[T any](slice []T, index int) []T {
+	return append(slice[:index], slice[index+1:]...)
+}
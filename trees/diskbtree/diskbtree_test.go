@@ -0,0 +1,642 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package diskbtree
+
+import (
+	"encoding/json"
+	"errors"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/emirpasic/gods/v2/containers"
+)
+
+func newTree(t testing.TB, order int) (*Tree[int, string], *MemoryPageStore) {
+	store := NewMemoryPageStore(DefaultPageSize)
+	tree, err := New[int, string](store, order, IntCodec[int]{}, StringCodec{})
+	if err != nil {
+		t.Fatalf("Got error %v", err)
+	}
+	return tree, store
+}
+
+func mustPut[K comparable, V any](t testing.TB, tree *Tree[K, V], key K, value V) {
+	if err := tree.Put(key, value); err != nil {
+		t.Fatalf("Got error %v", err)
+	}
+}
+
+func mustRemove[K comparable, V any](t testing.TB, tree *Tree[K, V], key K) {
+	if err := tree.Remove(key); err != nil {
+		t.Fatalf("Got error %v", err)
+	}
+}
+
+func keysOf[K comparable, V any](t testing.TB, tree *Tree[K, V]) []K {
+	keys, err := tree.Keys()
+	if err != nil {
+		t.Fatalf("Got error %v", err)
+	}
+	return keys
+}
+
+// assertValidTree checks the B-tree properties and returns the number of nodes
+func assertValidTree[K comparable, V any](t *testing.T, tree *Tree[K, V], expectedSize int) int {
+	if actualValue, expectedValue := tree.Size(), expectedSize; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v for tree size", actualValue, expectedValue)
+	}
+	if tree.root == 0 {
+		return 0
+	}
+	leafDepth := -1
+	var nodes, keys int
+	var walk func(id PageID, depth int)
+	walk = func(id PageID, depth int) {
+		n, err := tree.load(id)
+		if err != nil {
+			t.Fatalf("Got error %v", err)
+		}
+		nodes++
+		keys += len(n.entries)
+		if len(n.entries) > tree.maxEntries() || (id != tree.root && len(n.entries) < tree.minEntries()) || len(n.entries) == 0 {
+			t.Errorf("Invalid number of entries %v in node %v", len(n.entries), id)
+		}
+		for i := 1; i < len(n.entries); i++ {
+			if tree.Comparator(n.entries[i-1].Key, n.entries[i].Key) >= 0 {
+				t.Errorf("Entries not sorted in node %v", id)
+			}
+		}
+		if n.isLeaf() {
+			if leafDepth == -1 {
+				leafDepth = depth
+			} else if leafDepth != depth {
+				t.Errorf("Leaves at different depths %v and %v", leafDepth, depth)
+			}
+			return
+		}
+		if len(n.children) != len(n.entries)+1 {
+			t.Errorf("Got %v children expected %v", len(n.children), len(n.entries)+1)
+		}
+		for _, child := range n.children {
+			walk(child, depth+1)
+		}
+	}
+	walk(tree.root, 0)
+	if keys != expectedSize {
+		t.Errorf("Got %v keys in nodes expected %v", keys, expectedSize)
+	}
+	return nodes
+}
+
+func TestDiskBTreePutAndGet(t *testing.T) {
+	tree, _ := newTree(t, 3)
+	mustPut(t, tree, 7, "g")
+	mustPut(t, tree, 9, "i")
+	mustPut(t, tree, 10, "j")
+	mustPut(t, tree, 6, "f")
+	mustPut(t, tree, 3, "c")
+	mustPut(t, tree, 4, "d")
+	mustPut(t, tree, 5, "e")
+	mustPut(t, tree, 8, "h")
+	mustPut(t, tree, 2, "b")
+	mustPut(t, tree, 1, "x")
+	mustPut(t, tree, 1, "a") // overwrite
+
+	assertValidTree(t, tree, 10)
+
+	tests := [][]interface{}{
+		{0, "", false},
+		{1, "a", true},
+		{2, "b", true},
+		{3, "c", true},
+		{4, "d", true},
+		{5, "e", true},
+		{6, "f", true},
+		{7, "g", true},
+		{8, "h", true},
+		{9, "i", true},
+		{10, "j", true},
+		{11, "", false},
+	}
+
+	for _, test := range tests {
+		value, found, err := tree.Get(test[0].(int))
+		if value != test[1] || found != test[2] || err != nil {
+			t.Errorf("Got %v,%v,%v expected %v,%v", value, found, err, test[1], test[2])
+		}
+	}
+
+	if actualValue, expectedValue := keysOf(t, tree), []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	values, err := tree.Values()
+	if actualValue, expectedValue := values, []string{"a", "b", "c", "d", "e", "f", "g", "h", "i", "j"}; !slices.Equal(actualValue, expectedValue) || err != nil {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if height, err := tree.Height(); height != 3 || err != nil {
+		t.Errorf("Got %v expected %v", height, 3)
+	}
+	if left, err := tree.Left(); left == nil || left.Key != 1 || left.Value != "a" || err != nil {
+		t.Errorf("Got %v expected %v", left, 1)
+	}
+	if right, err := tree.Right(); right == nil || right.Key != 10 || right.Value != "j" || err != nil {
+		t.Errorf("Got %v expected %v", right, 10)
+	}
+}
+
+func TestDiskBTreeRemove(t *testing.T) {
+	tree, store := newTree(t, 3)
+	for i := 1; i <= 20; i++ {
+		mustPut(t, tree, i, "")
+	}
+	for _, key := range []int{10, 4, 1, 20, 15, 7, 16, 100} {
+		mustRemove(t, tree, key)
+	}
+	nodes := assertValidTree(t, tree, 13)
+	if actualValue, expectedValue := keysOf(t, tree), []int{2, 3, 5, 6, 8, 9, 11, 12, 13, 14, 17, 18, 19}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := store.Pages(), nodes; actualValue != expectedValue {
+		t.Errorf("Got %v pages expected %v (leaked pages)", actualValue, expectedValue)
+	}
+	for _, key := range []int{2, 3, 5, 6, 8, 9, 11, 12, 13, 14, 17, 18, 19} {
+		mustRemove(t, tree, key)
+	}
+	assertValidTree(t, tree, 0)
+	if actualValue, expectedValue := store.Pages(), 0; actualValue != expectedValue {
+		t.Errorf("Got %v pages expected %v", actualValue, expectedValue)
+	}
+	if left, err := tree.Left(); left != nil || err != nil {
+		t.Errorf("Got %v expected %v", left, nil)
+	}
+}
+
+func TestDiskBTreeRandomOperations(t *testing.T) {
+	for _, order := range []int{3, 4, 5, 8} {
+		tree, store := newTree(t, order)
+		tree.SetCacheSize(8)
+		expected := make(map[int]string)
+		random := rand.New(rand.NewSource(int64(order)))
+		for i := 0; i < 2000; i++ {
+			key := random.Intn(300)
+			if random.Intn(3) == 0 {
+				mustRemove(t, tree, key)
+				delete(expected, key)
+			} else {
+				value := strings.Repeat("v", random.Intn(5))
+				mustPut(t, tree, key, value)
+				expected[key] = value
+			}
+		}
+		nodes := assertValidTree(t, tree, len(expected))
+		if actualValue, expectedValue := store.Pages(), nodes; actualValue != expectedValue {
+			t.Errorf("Got %v pages expected %v (leaked pages)", actualValue, expectedValue)
+		}
+		for key, value := range expected {
+			if actualValue, found, err := tree.Get(key); actualValue != value || !found || err != nil {
+				t.Errorf("Got %v,%v,%v expected %v", actualValue, found, err, value)
+			}
+		}
+		keys := make([]int, 0, len(expected))
+		for key := range expected {
+			keys = append(keys, key)
+		}
+		slices.Sort(keys)
+		if actualValue := keysOf(t, tree); !slices.Equal(actualValue, keys) {
+			t.Errorf("Got %v expected %v", actualValue, keys)
+		}
+	}
+}
+
+func TestDiskBTreeClear(t *testing.T) {
+	tree, store := newTree(t, 3)
+	for i := 0; i < 50; i++ {
+		mustPut(t, tree, i, "a")
+	}
+	if err := tree.Clear(); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	assertValidTree(t, tree, 0)
+	if actualValue, expectedValue := store.Pages(), 0; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := tree.Empty(), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestDiskBTreeIteratorNextAndPrev(t *testing.T) {
+	tree, _ := newTree(t, 3)
+	it := tree.Iterator()
+	if it.Next() || it.Prev() {
+		t.Errorf("Shouldn't iterate on empty tree")
+	}
+
+	for _, key := range []int{5, 6, 7, 3, 4, 1, 2, 8, 9, 10, 11, 12, 13} {
+		mustPut(t, tree, key, "")
+	}
+
+	count := 0
+	for it.Next() {
+		count++
+		if actualValue, expectedValue := it.Key(), count; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+	if actualValue, expectedValue := count, 13; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	for it.Prev() {
+		if actualValue, expectedValue := it.Key(), count; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		count--
+	}
+	if actualValue, expectedValue := count, 0; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// change direction in the middle
+	it.Begin()
+	for i := 0; i < 7; i++ {
+		it.Next()
+	}
+	for _, expected := range []int{6, 5, 4} {
+		if !it.Prev() || it.Key() != expected {
+			t.Errorf("Got %v expected %v", it.Key(), expected)
+		}
+	}
+	for _, expected := range []int{5, 6, 7, 8} {
+		if !it.Next() || it.Key() != expected {
+			t.Errorf("Got %v expected %v", it.Key(), expected)
+		}
+	}
+
+	if !it.First() || it.Key() != 1 {
+		t.Errorf("Got %v expected %v", it.Key(), 1)
+	}
+	if !it.Last() || it.Key() != 13 {
+		t.Errorf("Got %v expected %v", it.Key(), 13)
+	}
+	it.End()
+	if !it.Prev() || it.Key() != 13 {
+		t.Errorf("Got %v expected %v", it.Key(), 13)
+	}
+	if it.Err() != nil {
+		t.Errorf("Got error %v", it.Err())
+	}
+}
+
+func TestDiskBTreeIteratorNextToAndPrevTo(t *testing.T) {
+	tree, _ := newTree(t, 4)
+	for i := 0; i < 30; i++ {
+		mustPut(t, tree, i, "")
+	}
+	divisibleBy7 := func(key int, value string) bool {
+		return key%7 == 0 && key > 0
+	}
+	it := tree.Iterator()
+	var keys []int
+	for it.NextTo(divisibleBy7) {
+		keys = append(keys, it.Key())
+	}
+	if actualValue, expectedValue := keys, []int{7, 14, 21, 28}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	keys = nil
+	for it.PrevTo(divisibleBy7) {
+		keys = append(keys, it.Key())
+	}
+	if actualValue, expectedValue := keys, []int{28, 21, 14, 7}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestDiskBTreeIteratorFailFast(t *testing.T) {
+	tree, _ := newTree(t, 3)
+	for i := 1; i <= 10; i++ {
+		mustPut(t, tree, i, "")
+	}
+	it := tree.Iterator()
+	it.Next()
+	mustPut(t, tree, 11, "")
+	if it.Next() {
+		t.Errorf("Shouldn't iterate after modification")
+	}
+	var modErr *containers.ConcurrentModificationError
+	if !errors.As(it.Err(), &modErr) {
+		t.Errorf("Got error %v expected %T", it.Err(), modErr)
+	}
+
+	// reset iterator is valid again
+	count := 0
+	for it.Begin(); it.Next(); {
+		count++
+	}
+	if actualValue, expectedValue := count, 11; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// every committed change invalidates, even updating a value
+	it = tree.Iterator()
+	it.Last()
+	mustPut(t, tree, 11, "a")
+	if it.Prev() || it.Err() == nil {
+		t.Errorf("Shouldn't iterate after modification")
+	}
+
+	tree.SetFailFast(false)
+	it = tree.Iterator()
+	it.First()
+	mustPut(t, tree, 12, "")
+	if !it.Next() || it.Err() != nil {
+		t.Errorf("Got error %v", it.Err())
+	}
+}
+
+func TestDiskBTreeFileStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "tree.db")
+	store, err := OpenFilePageStore(path, 256)
+	if err != nil {
+		t.Fatalf("Got error %v", err)
+	}
+	tree, err := New[string, int](store, 5, StringCodec{}, IntCodec[int]{})
+	if err != nil {
+		t.Fatalf("Got error %v", err)
+	}
+	key := func(i int) string {
+		return strings.Repeat("k", i%7) + string(rune('a'+i%26)) + string(rune('a'+i/26))
+	}
+	for i := 0; i < 200; i++ {
+		mustPut(t, tree, key(i), i)
+	}
+	for i := 0; i < 200; i += 2 {
+		mustRemove(t, tree, key(i))
+	}
+	keys := keysOf(t, tree)
+	if err := tree.Close(); err != nil {
+		t.Fatalf("Got error %v", err)
+	}
+
+	store, err = OpenFilePageStore(path, 0)
+	if err != nil {
+		t.Fatalf("Got error %v", err)
+	}
+	tree, err = New[string, int](store, 5, StringCodec{}, IntCodec[int]{})
+	if err != nil {
+		t.Fatalf("Got error %v", err)
+	}
+	assertValidTree(t, tree, 100)
+	if actualValue := keysOf(t, tree); !slices.Equal(actualValue, keys) {
+		t.Errorf("Got %v expected %v", actualValue, keys)
+	}
+	if value, found, err := tree.Get(key(56)); value != 0 || found || err != nil {
+		t.Errorf("Got %v,%v,%v expected %v", value, found, err, false)
+	}
+	if value, found, err := tree.Get(key(57)); value != 57 || !found || err != nil {
+		t.Errorf("Got %v,%v,%v expected %v", value, found, err, 57)
+	}
+
+	// freed pages are reused, so rewriting the same keys does not grow the file
+	info, _ := os.Stat(path)
+	for round := 0; round < 3; round++ {
+		for _, key := range keys {
+			mustPut(t, tree, key, round)
+		}
+	}
+	grown, _ := os.Stat(path)
+	if grown.Size() > info.Size()*2 {
+		t.Errorf("File grew from %v to %v", info.Size(), grown.Size())
+	}
+	tree.Close()
+
+	if _, err := New[string, int](mustOpen(t, path), 4, StringCodec{}, IntCodec[int]{}); err == nil {
+		t.Errorf("Expected order mismatch error")
+	}
+	if _, err := OpenFilePageStore(path, 512); err == nil {
+		t.Errorf("Expected page size mismatch error")
+	}
+}
+
+func mustOpen(t *testing.T, path string) *FilePageStore {
+	store, err := OpenFilePageStore(path, 0)
+	if err != nil {
+		t.Fatalf("Got error %v", err)
+	}
+	return store
+}
+
+func TestDiskBTreeFileStoreTornMeta(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "tree.db")
+	store := mustOpen(t, path)
+	tree, _ := New[int, string](store, 3, IntCodec[int]{}, StringCodec{})
+	for i := 0; i < 10; i++ {
+		mustPut(t, tree, i, "a")
+	}
+	mustPut(t, tree, 10, "b")
+	seq := store.seq
+	tree.Close()
+
+	// simulate a crash while writing the meta record of the last commit
+	file, _ := os.OpenFile(path, os.O_RDWR, 0644)
+	file.WriteAt([]byte("garbage"), int64(seq%2)*metaSlotSize+20)
+	file.Close()
+
+	tree, err := New[int, string](mustOpen(t, path), 3, IntCodec[int]{}, StringCodec{})
+	if err != nil {
+		t.Fatalf("Got error %v", err)
+	}
+	assertValidTree(t, tree, 10)
+	if _, found, _ := tree.Get(10); found {
+		t.Errorf("Uncommitted key should not be found")
+	}
+	mustPut(t, tree, 10, "c")
+	assertValidTree(t, tree, 11)
+	tree.Close()
+
+	if err := os.WriteFile(path, []byte("not a tree"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := OpenFilePageStore(path, 0); !errors.Is(err, ErrCorrupted) {
+		t.Errorf("Got %v expected %v", err, ErrCorrupted)
+	}
+}
+
+// failingStore fails the write with the given number
+type failingStore struct {
+	*MemoryPageStore
+	writes int
+	failAt int
+}
+
+var errInjected = errors.New("injected failure")
+
+func (store *failingStore) Write(id PageID, data []byte) error {
+	store.writes++
+	if store.writes == store.failAt {
+		return errInjected
+	}
+	return store.MemoryPageStore.Write(id, data)
+}
+
+func TestDiskBTreeFailedWriteLeavesTreeIntact(t *testing.T) {
+	store := &failingStore{MemoryPageStore: NewMemoryPageStore(MinPageSize)}
+	tree, _ := New[int, string](store, 3, IntCodec[int]{}, StringCodec{})
+	expected := make(map[int]bool)
+	random := rand.New(rand.NewSource(1))
+	failures := 0
+	for i := 0; i < 1000; i++ {
+		store.failAt = store.writes + 1 + random.Intn(4)
+		key := random.Intn(100)
+		if random.Intn(3) == 0 {
+			if err := tree.Remove(key); err == nil {
+				delete(expected, key)
+			} else if errors.Is(err, errInjected) {
+				failures++
+			} else {
+				t.Fatalf("Got error %v", err)
+			}
+		} else {
+			if err := tree.Put(key, "value"); err == nil {
+				expected[key] = true
+			} else if errors.Is(err, errInjected) {
+				failures++
+			} else {
+				t.Fatalf("Got error %v", err)
+			}
+		}
+	}
+	if failures == 0 {
+		t.Errorf("Expected some injected failures")
+	}
+	store.failAt = 0
+	nodes := assertValidTree(t, tree, len(expected))
+	if actualValue, expectedValue := store.Pages(), nodes; actualValue != expectedValue {
+		t.Errorf("Got %v pages expected %v (leaked pages)", actualValue, expectedValue)
+	}
+	for key := range expected {
+		if _, found, err := tree.Get(key); !found || err != nil {
+			t.Errorf("Got %v,%v expected %v", found, err, true)
+		}
+	}
+
+	// reopening from the committed state gives the same tree
+	reopened, err := New[int, string](store, 3, IntCodec[int]{}, StringCodec{})
+	if err != nil {
+		t.Fatalf("Got error %v", err)
+	}
+	assertValidTree(t, reopened, len(expected))
+}
+
+func TestDiskBTreeNodeTooLarge(t *testing.T) {
+	store := NewMemoryPageStore(MinPageSize)
+	tree, _ := New[int, string](store, 3, IntCodec[int]{}, StringCodec{})
+	mustPut(t, tree, 1, "a")
+	if err := tree.Put(2, strings.Repeat("x", MinPageSize)); !errors.Is(err, ErrPageTooLarge) {
+		t.Errorf("Got %v expected %v", err, ErrPageTooLarge)
+	}
+	assertValidTree(t, tree, 1)
+	if actualValue, expectedValue := store.Pages(), 1; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestDiskBTreeCache(t *testing.T) {
+	for _, size := range []int{0, 1, 3} {
+		tree, _ := newTree(t, 3)
+		tree.SetCacheSize(size)
+		for i := 0; i < 100; i++ {
+			mustPut(t, tree, i, "a")
+		}
+		if actualValue := tree.cache.len(); actualValue > size {
+			t.Errorf("Got %v expected at most %v", actualValue, size)
+		}
+		assertValidTree(t, tree, 100)
+	}
+}
+
+func TestDiskBTreeCodecs(t *testing.T) {
+	if data, _ := (IntCodec[int8]{}).Encode(nil, -5); len(data) != 1 {
+		t.Errorf("Got %v expected %v", len(data), 1)
+	}
+	if value, err := (IntCodec[int8]{}).Decode([]byte{9}); value != -5 || err != nil {
+		t.Errorf("Got %v expected %v", value, -5)
+	}
+	data, _ := (IntCodec[uint64]{}).Encode(nil, 1<<63)
+	if value, err := (IntCodec[uint64]{}).Decode(data); value != 1<<63 || err != nil {
+		t.Errorf("Got %v expected %v", value, uint64(1<<63))
+	}
+	data, _ = (Float64Codec{}).Encode(nil, 3.5)
+	if value, err := (Float64Codec{}).Decode(data); value != 3.5 || err != nil {
+		t.Errorf("Got %v expected %v", value, 3.5)
+	}
+	type point struct{ X, Y int }
+	data, _ = (JSONCodec[point]{}).Encode(nil, point{1, 2})
+	if value, err := (JSONCodec[point]{}).Decode(data); value != (point{1, 2}) || err != nil {
+		t.Errorf("Got %v expected %v", value, point{1, 2})
+	}
+	if _, err := (IntCodec[int]{}).Decode(nil); !errors.Is(err, ErrCorrupted) {
+		t.Errorf("Got %v expected %v", err, ErrCorrupted)
+	}
+}
+
+func TestDiskBTreeSerialization(t *testing.T) {
+	store := NewMemoryPageStore(DefaultPageSize)
+	tree, _ := New[string, string](store, 3, StringCodec{}, StringCodec{})
+	mustPut(t, tree, "c", "3")
+	mustPut(t, tree, "b", "2")
+	mustPut(t, tree, "a", "1")
+
+	bytes, err := tree.ToJSON()
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := string(bytes), `{"a":"1","b":"2","c":"3"}`; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	err = json.Unmarshal([]byte(`{"x":"9","y":"8"}`), tree)
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := keysOf(t, tree), []string{"x", "y"}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestDiskBTreeString(t *testing.T) {
+	tree, _ := newTree(t, 3)
+	mustPut(t, tree, 1, "a")
+	if !strings.HasPrefix(tree.String(), "DiskBTree") {
+		t.Errorf("String should start with container name")
+	}
+}
+
+func BenchmarkDiskBTreePut1000(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		tree, _ := newTree(b, 32)
+		for n := 0; n < 1000; n++ {
+			mustPut(b, tree, n, "")
+		}
+	}
+}
+
+func BenchmarkDiskBTreeGet1000(b *testing.B) {
+	b.StopTimer()
+	tree, _ := newTree(b, 32)
+	for n := 0; n < 1000; n++ {
+		mustPut(b, tree, n, "")
+	}
+	b.StartTimer()
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < 1000; n++ {
+			tree.Get(n)
+		}
+	}
+}
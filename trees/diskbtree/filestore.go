@@ -0,0 +1,342 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package diskbtree
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"os"
+)
+
+// File layout:
+//
+//	[meta slot 0][meta slot 1][page 1][page 2]...
+//
+// Both meta slots have a fixed size, so they can be located before the page size is known.
+// Commits alternate between the slots, each record carries a sequence number and a checksum,
+// so a torn meta write is detected on open and the previous commit is used instead.
+// The list of free pages is persisted on every commit as a chain of pages referenced by the meta record.
+const (
+	metaSlotSize   = 512
+	metaHeaderSize = 48
+	metaMaxPayload = metaSlotSize - metaHeaderSize - 4
+	dataOffset     = 2 * metaSlotSize
+	freePageHeader = 12
+)
+
+var fileMagic = []byte("GODSBTRE")
+
+// FilePageStore is a page store backed by a single file.
+type FilePageStore struct {
+	file     *os.File
+	pageSize int
+	seq      uint64
+	meta     []byte
+	chain    []PageID // pages holding the persisted free list
+	tracker  pageTracker
+}
+
+// Assert PageStore implementation
+var _ PageStore = (*FilePageStore)(nil)
+
+// OpenFilePageStore opens the page store in the named file, creating the file if it does not exist.
+// The page size of an existing store is read from the file, in which case pageSize must either match it or be 0.
+// A pageSize of 0 for a new store means DefaultPageSize.
+func OpenFilePageStore(path string, pageSize int) (*FilePageStore, error) {
+	file, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0644)
+	if err != nil {
+		return nil, err
+	}
+	store, err := openFilePageStore(file, pageSize)
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+	return store, nil
+}
+
+func openFilePageStore(file *os.File, pageSize int) (*FilePageStore, error) {
+	store := &FilePageStore{file: file, tracker: pageTracker{next: 1}}
+
+	var best *metaRecord
+	magic := false
+	for slot := 0; slot < 2; slot++ {
+		buffer := make([]byte, metaSlotSize)
+		n, _ := file.ReadAt(buffer, int64(slot*metaSlotSize))
+		buffer = buffer[:n]
+		if bytes.HasPrefix(buffer, fileMagic) {
+			magic = true
+		}
+		if record, ok := decodeMetaRecord(buffer); ok && (best == nil || record.seq > best.seq) {
+			best = record
+		}
+	}
+
+	if best == nil {
+		info, err := file.Stat()
+		if err != nil {
+			return nil, err
+		}
+		// a file with a torn first commit is considered empty, anything else is not ours
+		if info.Size() > 0 && !magic {
+			return nil, fmt.Errorf("%w: not a page store file", ErrCorrupted)
+		}
+		if pageSize == 0 {
+			pageSize = DefaultPageSize
+		}
+		if pageSize < MinPageSize {
+			return nil, fmt.Errorf("invalid page size %d, should be at least %d", pageSize, MinPageSize)
+		}
+		store.pageSize = pageSize
+		return store, nil
+	}
+
+	if pageSize != 0 && pageSize != best.pageSize {
+		return nil, fmt.Errorf("page size mismatch: file uses %d, requested %d", best.pageSize, pageSize)
+	}
+	store.pageSize = best.pageSize
+	store.seq = best.seq
+	store.meta = best.payload
+	store.tracker.next = best.next
+
+	for id := best.freeHead; id != 0; {
+		page, err := store.Read(id)
+		if err != nil {
+			return nil, err
+		}
+		next := PageID(binary.LittleEndian.Uint64(page[0:8]))
+		count := int(binary.LittleEndian.Uint32(page[8:12]))
+		if count > (store.pageSize-freePageHeader)/8 {
+			return nil, fmt.Errorf("%w: free list page %d", ErrCorrupted, id)
+		}
+		for i := 0; i < count; i++ {
+			offset := freePageHeader + i*8
+			store.tracker.free = append(store.tracker.free, PageID(binary.LittleEndian.Uint64(page[offset:offset+8])))
+		}
+		store.chain = append(store.chain, id)
+		id = next
+	}
+	if uint64(len(store.tracker.free)) != best.freeCount {
+		return nil, fmt.Errorf("%w: free list size", ErrCorrupted)
+	}
+
+	return store, nil
+}
+
+// PageSize returns the size of a single page in bytes.
+func (store *FilePageStore) PageSize() int {
+	return store.pageSize
+}
+
+// Read returns the contents of the page.
+func (store *FilePageStore) Read(id PageID) ([]byte, error) {
+	if store.file == nil {
+		return nil, ErrClosed
+	}
+	if id == 0 || id >= store.tracker.next {
+		return nil, fmt.Errorf("%w: %d", ErrPageNotFound, id)
+	}
+	page := make([]byte, store.pageSize)
+	if _, err := store.file.ReadAt(page, store.offset(id)); err != nil {
+		return nil, err
+	}
+	return page, nil
+}
+
+// Write stores data into a page.
+func (store *FilePageStore) Write(id PageID, data []byte) error {
+	if store.file == nil {
+		return ErrClosed
+	}
+	if len(data) > store.pageSize {
+		return ErrPageTooLarge
+	}
+	if id == 0 || id >= store.tracker.next {
+		return fmt.Errorf("%w: %d", ErrPageNotFound, id)
+	}
+	page := make([]byte, store.pageSize)
+	copy(page, data)
+	_, err := store.file.WriteAt(page, store.offset(id))
+	return err
+}
+
+// Allocate reserves a page that is not referenced by the last committed state.
+func (store *FilePageStore) Allocate() (PageID, error) {
+	if store.file == nil {
+		return 0, ErrClosed
+	}
+	return store.tracker.allocate(), nil
+}
+
+// Free releases a page. The page becomes reusable after the next successful Commit.
+func (store *FilePageStore) Free(id PageID) error {
+	if store.file == nil {
+		return ErrClosed
+	}
+	return store.tracker.release(id)
+}
+
+// Meta returns the meta record of the last successful Commit or nil if nothing was committed yet.
+func (store *FilePageStore) Meta() ([]byte, error) {
+	if store.file == nil {
+		return nil, ErrClosed
+	}
+	return store.meta, nil
+}
+
+// Commit flushes all written pages and the free list to disk and then atomically switches to the new meta record.
+func (store *FilePageStore) Commit(meta []byte) error {
+	if store.file == nil {
+		return ErrClosed
+	}
+	if len(meta) > metaMaxPayload {
+		return ErrPageTooLarge
+	}
+
+	// Pages holding the new free list are taken from the pages that are free already,
+	// since pending pages (and the old chain) are still referenced by the last committed state.
+	free := store.tracker.free
+	perPage := (store.pageSize - freePageHeader) / 8
+	taken := 0
+	for {
+		count := len(free) - min(taken, len(free)) + len(store.tracker.pending) + len(store.chain)
+		needed := (count + perPage - 1) / perPage
+		if needed <= taken {
+			break
+		}
+		taken = needed
+	}
+	next := store.tracker.next
+	chain := make([]PageID, 0, taken)
+	fromFree := min(taken, len(free))
+	chain = append(chain, free[len(free)-fromFree:]...)
+	for len(chain) < taken {
+		chain = append(chain, next)
+		next++
+	}
+	stored := make([]PageID, 0, len(free)-fromFree+len(store.tracker.pending)+len(store.chain))
+	stored = append(stored, free[:len(free)-fromFree]...)
+	stored = append(stored, store.tracker.pending...)
+	stored = append(stored, store.chain...)
+
+	for i, id := range chain {
+		page := make([]byte, store.pageSize)
+		if i+1 < len(chain) {
+			binary.LittleEndian.PutUint64(page[0:8], uint64(chain[i+1]))
+		}
+		ids := stored[min(i*perPage, len(stored)):min((i+1)*perPage, len(stored))]
+		binary.LittleEndian.PutUint32(page[8:12], uint32(len(ids)))
+		for j, free := range ids {
+			offset := freePageHeader + j*8
+			binary.LittleEndian.PutUint64(page[offset:offset+8], uint64(free))
+		}
+		if _, err := store.file.WriteAt(page, store.offset(id)); err != nil {
+			return err
+		}
+	}
+	if err := store.file.Sync(); err != nil {
+		return err
+	}
+
+	record := &metaRecord{
+		seq:       store.seq + 1,
+		pageSize:  store.pageSize,
+		next:      next,
+		freeCount: uint64(len(stored)),
+		payload:   meta,
+	}
+	if len(chain) > 0 {
+		record.freeHead = chain[0]
+	}
+	if _, err := store.file.WriteAt(record.encode(), int64(record.seq%2)*metaSlotSize); err != nil {
+		return err
+	}
+	if err := store.file.Sync(); err != nil {
+		return err
+	}
+
+	store.seq = record.seq
+	store.meta = append([]byte(nil), meta...)
+	store.chain = chain
+	store.tracker.next = next
+	store.tracker.free = stored
+	store.tracker.pending = nil
+	store.tracker.allocated = nil
+	return nil
+}
+
+// Rollback discards all allocations and frees since the last commit.
+func (store *FilePageStore) Rollback() error {
+	if store.file == nil {
+		return ErrClosed
+	}
+	store.tracker.rollback()
+	return nil
+}
+
+// Close closes the underlying file. Uncommitted changes are lost.
+func (store *FilePageStore) Close() error {
+	if store.file == nil {
+		return ErrClosed
+	}
+	err := store.file.Close()
+	store.file = nil
+	return err
+}
+
+func (store *FilePageStore) offset(id PageID) int64 {
+	return dataOffset + int64(id-1)*int64(store.pageSize)
+}
+
+type metaRecord struct {
+	seq       uint64
+	pageSize  int
+	next      PageID
+	freeHead  PageID
+	freeCount uint64
+	payload   []byte
+}
+
+func (record *metaRecord) encode() []byte {
+	buffer := make([]byte, metaSlotSize)
+	copy(buffer[0:8], fileMagic)
+	binary.LittleEndian.PutUint64(buffer[8:16], record.seq)
+	binary.LittleEndian.PutUint32(buffer[16:20], uint32(record.pageSize))
+	binary.LittleEndian.PutUint64(buffer[20:28], uint64(record.next))
+	binary.LittleEndian.PutUint64(buffer[28:36], uint64(record.freeHead))
+	binary.LittleEndian.PutUint64(buffer[36:44], record.freeCount)
+	binary.LittleEndian.PutUint32(buffer[44:48], uint32(len(record.payload)))
+	end := metaHeaderSize + copy(buffer[metaHeaderSize:], record.payload)
+	binary.LittleEndian.PutUint32(buffer[end:end+4], crc32.ChecksumIEEE(buffer[:end]))
+	return buffer
+}
+
+func decodeMetaRecord(buffer []byte) (*metaRecord, bool) {
+	if len(buffer) < metaHeaderSize+4 || !bytes.HasPrefix(buffer, fileMagic) {
+		return nil, false
+	}
+	length := int(binary.LittleEndian.Uint32(buffer[44:48]))
+	if length > metaMaxPayload {
+		return nil, false
+	}
+	end := metaHeaderSize + length
+	if crc32.ChecksumIEEE(buffer[:end]) != binary.LittleEndian.Uint32(buffer[end:end+4]) {
+		return nil, false
+	}
+	record := &metaRecord{
+		seq:       binary.LittleEndian.Uint64(buffer[8:16]),
+		pageSize:  int(binary.LittleEndian.Uint32(buffer[16:20])),
+		next:      PageID(binary.LittleEndian.Uint64(buffer[20:28])),
+		freeHead:  PageID(binary.LittleEndian.Uint64(buffer[28:36])),
+		freeCount: binary.LittleEndian.Uint64(buffer[36:44]),
+		payload:   append([]byte(nil), buffer[metaHeaderSize:end]...),
+	}
+	if record.pageSize < MinPageSize || record.next == 0 {
+		return nil, false
+	}
+	return record, true
+}
@@ -0,0 +1,266 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package diskbtree
+
+import "github.com/emirpasic/gods/v2/containers"
+
+// Assert Iterator implementation
+var _ containers.ReverseIteratorWithKey[string, int] = (*Iterator[string, int])(nil)
+
+// Iterator holding the iterator's state.
+//
+// Nodes have no parent references on disk, so the iterator keeps the path from the root to the current entry.
+// Reading a page may fail, in which case the iterator stops as if it reached the end and Err reports the error.
+// Modifying the tree invalidates the iterator, which then stops and reports a *containers.ConcurrentModificationError
+// through Err, unless fail-fast iteration is disabled for the tree.
+type Iterator[K comparable, V any] struct {
+	tree     *Tree[K, V]
+	path     []frame[K, V]
+	entry    Entry[K, V]
+	position position
+	err      error
+	modCount int // tree's modification count the iterator is in sync with
+}
+
+// frame is a node on the path to the current entry.
+// For the last frame index is the current entry, for the others it is the child that was descended into.
+type frame[K comparable, V any] struct {
+	node  *node[K, V]
+	index int
+}
+
+type position byte
+
+const (
+	begin, between, end position = 0, 1, 2
+)
+
+// Iterator returns a stateful iterator whose elements are key/value pairs.
+func (tree *Tree[K, V]) Iterator() *Iterator[K, V] {
+	return &Iterator[K, V]{tree: tree, position: begin, modCount: tree.modCount}
+}
+
+// Next moves the iterator to the next element and returns true if there was a next element in the container.
+// If Next() returns true, then next element's key and value can be retrieved by Key() and Value().
+// If Next() was called for the first time, then it will point the iterator to the first element if it exists.
+// Modifies the state of the iterator.
+func (iterator *Iterator[K, V]) Next() bool {
+	if !iterator.checkModification() {
+		goto end
+	}
+	switch iterator.position {
+	case end:
+		goto end
+	case begin:
+		if iterator.tree.root == 0 || !iterator.descend(iterator.tree.root, true) {
+			goto end
+		}
+		goto between
+	}
+	{
+		top := &iterator.path[len(iterator.path)-1]
+		// Try to go down to the child right of the current entry
+		if !top.node.isLeaf() {
+			top.index++
+			if !iterator.descend(top.node.children[top.index], true) {
+				goto end
+			}
+			goto between
+		}
+		// Return the next entry in current leaf (if any)
+		if top.index+1 < len(top.node.entries) {
+			top.index++
+			goto between
+		}
+	}
+	// Reached the last entry of a leaf, so go up to the first ancestor with an entry to the right
+	iterator.path = iterator.path[:len(iterator.path)-1]
+	for len(iterator.path) > 0 {
+		top := iterator.path[len(iterator.path)-1]
+		if top.index < len(top.node.entries) {
+			goto between
+		}
+		iterator.path = iterator.path[:len(iterator.path)-1]
+	}
+
+end:
+	iterator.End()
+	return false
+
+between:
+	iterator.position = between
+	iterator.setEntry()
+	return true
+}
+
+// Prev moves the iterator to the previous element and returns true if there was a previous element in the container.
+// If Prev() returns true, then previous element's key and value can be retrieved by Key() and Value().
+// Modifies the state of the iterator.
+func (iterator *Iterator[K, V]) Prev() bool {
+	if !iterator.checkModification() {
+		goto begin
+	}
+	switch iterator.position {
+	case begin:
+		goto begin
+	case end:
+		if iterator.tree.root == 0 || !iterator.descend(iterator.tree.root, false) {
+			goto begin
+		}
+		goto between
+	}
+	{
+		top := &iterator.path[len(iterator.path)-1]
+		// Try to go down to the child left of the current entry
+		if !top.node.isLeaf() {
+			if !iterator.descend(top.node.children[top.index], false) {
+				goto begin
+			}
+			goto between
+		}
+		// Return the previous entry in current leaf (if any)
+		if top.index > 0 {
+			top.index--
+			goto between
+		}
+	}
+	// Reached the first entry of a leaf, so go up to the first ancestor with an entry to the left
+	iterator.path = iterator.path[:len(iterator.path)-1]
+	for len(iterator.path) > 0 {
+		top := &iterator.path[len(iterator.path)-1]
+		if top.index > 0 {
+			top.index--
+			goto between
+		}
+		iterator.path = iterator.path[:len(iterator.path)-1]
+	}
+
+begin:
+	iterator.Begin()
+	return false
+
+between:
+	iterator.position = between
+	iterator.setEntry()
+	return true
+}
+
+// Value returns the current element's value.
+// Does not modify the state of the iterator.
+func (iterator *Iterator[K, V]) Value() V {
+	return iterator.entry.Value
+}
+
+// Key returns the current element's key.
+// Does not modify the state of the iterator.
+func (iterator *Iterator[K, V]) Key() K {
+	return iterator.entry.Key
+}
+
+// Err returns the first error encountered while reading pages, if any.
+func (iterator *Iterator[K, V]) Err() error {
+	return iterator.err
+}
+
+// Begin resets the iterator to its initial state (one-before-first)
+// Call Next() to fetch the first element if any.
+func (iterator *Iterator[K, V]) Begin() {
+	iterator.path = iterator.path[:0]
+	iterator.position = begin
+	iterator.entry = Entry[K, V]{}
+}
+
+// End moves the iterator past the last element (one-past-the-end).
+// Call Prev() to fetch the last element if any.
+func (iterator *Iterator[K, V]) End() {
+	iterator.path = iterator.path[:0]
+	iterator.position = end
+	iterator.entry = Entry[K, V]{}
+}
+
+// First moves the iterator to the first element and returns true if there was a first element in the container.
+// If First() returns true, then first element's key and value can be retrieved by Key() and Value().
+// Modifies the state of the iterator
+func (iterator *Iterator[K, V]) First() bool {
+	iterator.Begin()
+	return iterator.Next()
+}
+
+// Last moves the iterator to the last element and returns true if there was a last element in the container.
+// If Last() returns true, then last element's key and value can be retrieved by Key() and Value().
+// Modifies the state of the iterator.
+func (iterator *Iterator[K, V]) Last() bool {
+	iterator.End()
+	return iterator.Prev()
+}
+
+// NextTo moves the iterator to the next element from current position that satisfies the condition given by the
+// passed function, and returns true if there was a next element in the container.
+// If NextTo() returns true, then next element's key and value can be retrieved by Key() and Value().
+// Modifies the state of the iterator.
+func (iterator *Iterator[K, V]) NextTo(f func(key K, value V) bool) bool {
+	for iterator.Next() {
+		key, value := iterator.Key(), iterator.Value()
+		if f(key, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// PrevTo moves the iterator to the previous element from current position that satisfies the condition given by the
+// passed function, and returns true if there was a next element in the container.
+// If PrevTo() returns true, then next element's key and value can be retrieved by Key() and Value().
+// Modifies the state of the iterator.
+func (iterator *Iterator[K, V]) PrevTo(f func(key K, value V) bool) bool {
+	for iterator.Prev() {
+		key, value := iterator.Key(), iterator.Value()
+		if f(key, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// descend pushes the path from the page down to its left-most (or right-most) entry.
+func (iterator *Iterator[K, V]) descend(id PageID, leftmost bool) bool {
+	for {
+		n, err := iterator.tree.load(id)
+		if err != nil {
+			iterator.err = err
+			return false
+		}
+		index := 0
+		if !leftmost {
+			index = len(n.children) - 1
+			if n.isLeaf() {
+				index = len(n.entries) - 1
+			}
+		}
+		iterator.path = append(iterator.path, frame[K, V]{node: n, index: index})
+		if n.isLeaf() {
+			return true
+		}
+		id = n.children[index]
+	}
+}
+
+func (iterator *Iterator[K, V]) setEntry() {
+	top := iterator.path[len(iterator.path)-1]
+	iterator.entry = top.node.entries[top.index]
+}
+
+// checkModification records a *containers.ConcurrentModificationError as the iterator's error and returns false
+// if the tree was modified since the iterator moved to its current element, unless fail-fast iteration is disabled.
+func (iterator *Iterator[K, V]) checkModification() bool {
+	if iterator.position == between && iterator.modCount != iterator.tree.modCount && !iterator.tree.failFastDisabled {
+		if iterator.err == nil {
+			iterator.err = &containers.ConcurrentModificationError{Container: "DiskBTree"}
+		}
+		return false
+	}
+	iterator.modCount = iterator.tree.modCount
+	return true
+}
@@ -0,0 +1,220 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package diskbtree
+
+import (
+	"errors"
+	"fmt"
+)
+
+// PageID identifies a page within a page store. Zero is never a valid page.
+type PageID uint64
+
+const (
+	// DefaultPageSize is the page size used when none is given.
+	DefaultPageSize = 4096
+	// MinPageSize is the smallest supported page size.
+	MinPageSize = 128
+)
+
+// Errors returned by page stores and the tree.
+var (
+	ErrPageNotFound = errors.New("diskbtree: page not found")
+	ErrPageTooLarge = errors.New("diskbtree: data does not fit into a page")
+	ErrClosed       = errors.New("diskbtree: page store is closed")
+	ErrCorrupted    = errors.New("diskbtree: corrupted data")
+)
+
+// PageStore is the storage abstraction under the tree.
+//
+// Pages are never overwritten while they are reachable from the last committed state:
+// the tree writes modified nodes to freshly allocated pages (copy-on-write) and then calls Commit,
+// which must atomically publish all written pages together with the new meta record.
+// Pages released through Free only become reusable after the next successful Commit,
+// so a crash at any point leaves the previously committed tree intact.
+type PageStore interface {
+	// PageSize returns the size of a single page in bytes.
+	PageSize() int
+
+	// Read returns the contents of the page. The returned slice must not be modified.
+	Read(id PageID) ([]byte, error)
+
+	// Write stores data (at most PageSize bytes) into a page previously returned by Allocate.
+	Write(id PageID, data []byte) error
+
+	// Allocate reserves a page that is not referenced by the last committed state.
+	Allocate() (PageID, error)
+
+	// Free releases a page. The page becomes reusable after the next successful Commit.
+	Free(id PageID) error
+
+	// Meta returns the meta record of the last successful Commit or nil if nothing was committed yet.
+	Meta() ([]byte, error)
+
+	// Commit durably and atomically publishes all pages written since the last commit with the given meta record.
+	Commit(meta []byte) error
+
+	// Rollback discards all allocations and frees since the last commit.
+	Rollback() error
+
+	// Close releases the resources held by the store.
+	Close() error
+}
+
+// pageTracker keeps track of free, pending and uncommitted pages and is shared by the page stores.
+type pageTracker struct {
+	next      PageID   // next never used page
+	free      []PageID // pages reusable right away
+	pending   []PageID // pages freed since the last commit
+	allocated []PageID // pages allocated since the last commit
+}
+
+func (tracker *pageTracker) allocate() PageID {
+	var id PageID
+	if n := len(tracker.free); n > 0 {
+		id = tracker.free[n-1]
+		tracker.free = tracker.free[:n-1]
+	} else {
+		id = tracker.next
+		tracker.next++
+	}
+	tracker.allocated = append(tracker.allocated, id)
+	return id
+}
+
+func (tracker *pageTracker) release(id PageID) error {
+	if id == 0 || id >= tracker.next {
+		return fmt.Errorf("%w: %d", ErrPageNotFound, id)
+	}
+	tracker.pending = append(tracker.pending, id)
+	return nil
+}
+
+func (tracker *pageTracker) commit() {
+	tracker.free = append(tracker.free, tracker.pending...)
+	tracker.pending = nil
+	tracker.allocated = nil
+}
+
+func (tracker *pageTracker) rollback() {
+	tracker.free = append(tracker.free, tracker.allocated...)
+	tracker.pending = nil
+	tracker.allocated = nil
+}
+
+// MemoryPageStore is an in-memory page store, mostly useful for tests and ephemeral trees.
+type MemoryPageStore struct {
+	pageSize int
+	pages    map[PageID][]byte
+	meta     []byte
+	tracker  pageTracker
+	closed   bool
+}
+
+// Assert PageStore implementation
+var _ PageStore = (*MemoryPageStore)(nil)
+
+// NewMemoryPageStore instantiates an empty in-memory page store with pages of the given size.
+func NewMemoryPageStore(pageSize int) *MemoryPageStore {
+	if pageSize < MinPageSize {
+		panic(fmt.Sprintf("Invalid page size, should be at least %d", MinPageSize))
+	}
+	return &MemoryPageStore{pageSize: pageSize, pages: make(map[PageID][]byte), tracker: pageTracker{next: 1}}
+}
+
+// PageSize returns the size of a single page in bytes.
+func (store *MemoryPageStore) PageSize() int {
+	return store.pageSize
+}
+
+// Read returns the contents of the page.
+func (store *MemoryPageStore) Read(id PageID) ([]byte, error) {
+	if store.closed {
+		return nil, ErrClosed
+	}
+	page, ok := store.pages[id]
+	if !ok {
+		return nil, fmt.Errorf("%w: %d", ErrPageNotFound, id)
+	}
+	return page, nil
+}
+
+// Write stores data into a page.
+func (store *MemoryPageStore) Write(id PageID, data []byte) error {
+	if store.closed {
+		return ErrClosed
+	}
+	if len(data) > store.pageSize {
+		return ErrPageTooLarge
+	}
+	if id == 0 || id >= store.tracker.next {
+		return fmt.Errorf("%w: %d", ErrPageNotFound, id)
+	}
+	page := make([]byte, store.pageSize)
+	copy(page, data)
+	store.pages[id] = page
+	return nil
+}
+
+// Allocate reserves a page that is not referenced by the last committed state.
+func (store *MemoryPageStore) Allocate() (PageID, error) {
+	if store.closed {
+		return 0, ErrClosed
+	}
+	return store.tracker.allocate(), nil
+}
+
+// Free releases a page. The page becomes reusable after the next successful Commit.
+func (store *MemoryPageStore) Free(id PageID) error {
+	if store.closed {
+		return ErrClosed
+	}
+	return store.tracker.release(id)
+}
+
+// Meta returns the meta record of the last successful Commit or nil if nothing was committed yet.
+func (store *MemoryPageStore) Meta() ([]byte, error) {
+	if store.closed {
+		return nil, ErrClosed
+	}
+	return store.meta, nil
+}
+
+// Commit publishes all written pages with the given meta record.
+func (store *MemoryPageStore) Commit(meta []byte) error {
+	if store.closed {
+		return ErrClosed
+	}
+	for _, id := range store.tracker.pending {
+		delete(store.pages, id)
+	}
+	store.meta = append([]byte(nil), meta...)
+	store.tracker.commit()
+	return nil
+}
+
+// Rollback discards all allocations and frees since the last commit.
+func (store *MemoryPageStore) Rollback() error {
+	if store.closed {
+		return ErrClosed
+	}
+	for _, id := range store.tracker.allocated {
+		delete(store.pages, id)
+	}
+	store.tracker.rollback()
+	return nil
+}
+
+// Close releases the pages held by the store.
+func (store *MemoryPageStore) Close() error {
+	store.closed = true
+	store.pages = nil
+	return nil
+}
+
+// Pages returns the number of pages currently holding data (for testing purposes).
+func (store *MemoryPageStore) Pages() int {
+	return len(store.pages)
+}
@@ -0,0 +1,375 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package fibonacciheap implements a Fibonacci heap, a meldable heap with cheap decrease-key.
+//
+// A Fibonacci heap is a collection of heap-ordered trees whose roots are kept in a circular list. Push, Peek, Meld and
+// DecreaseKey run in O(1) amortized time, Pop and Delete in O(log n) amortized time. Elements are addressed by the
+// node handles returned by Insert, which stay valid until the element is removed from the heap.
+//
+// Comparator defines this heap as either min or max heap, the least element with respect to it is on top.
+//
+// Structure is not thread safe.
+//
+// References: https://en.wikipedia.org/wiki/Fibonacci_heap
+package fibonacciheap
+
+import (
+	"cmp"
+	"fmt"
+	"strings"
+
+	"github.com/emirpasic/gods/v2/containers"
+	"github.com/emirpasic/gods/v2/trees"
+	"github.com/emirpasic/gods/v2/utils"
+)
+
+// Assert Heap implementation
+var _ trees.Heap[int] = (*Heap[int])(nil)
+var _ containers.FailFast = (*Heap[int])(nil)
+
+// Heap holds elements in a list of trees of nodes
+type Heap[T comparable] struct {
+	min              *Node[T] // top element, one of the roots
+	size             int
+	owner            *owner
+	Comparator       utils.Comparator[T]
+	modCount         int  // number of modifications, checked by iterators
+	failFastDisabled bool // iterators do not check for modifications
+}
+
+// Node is a handle of a single element in the heap
+type Node[T comparable] struct {
+	value  T
+	parent *Node[T]
+	child  *Node[T] // any of the children
+	left   *Node[T] // previous sibling in the circular list
+	right  *Node[T] // next sibling in the circular list
+	degree int      // number of children
+	marked bool     // lost a child since it became a child itself
+	owner  *owner   // nil once removed from the heap
+}
+
+// owner identifies the heap a node belongs to. Owners of melded heaps are linked to the owner of the heap they were
+// melded into, so handles stay valid without visiting the nodes.
+type owner struct {
+	parent *owner
+}
+
+// New instantiates a new empty heap with the built-in comparator for T
+func New[T cmp.Ordered]() *Heap[T] {
+	return NewWith[T](cmp.Compare[T])
+}
+
+// NewWith instantiates a new empty heap with the custom comparator.
+func NewWith[T comparable](comparator utils.Comparator[T]) *Heap[T] {
+	return &Heap[T]{owner: &owner{}, Comparator: comparator}
+}
+
+// Value returns the element of the node.
+func (node *Node[T]) Value() T {
+	return node.value
+}
+
+// Push adds values (one or more) onto the heap.
+func (heap *Heap[T]) Push(values ...T) {
+	for _, value := range values {
+		heap.Insert(value)
+	}
+}
+
+// Insert adds the value onto the heap and returns its node, a handle for DecreaseKey and Delete.
+func (heap *Heap[T]) Insert(value T) *Node[T] {
+	node := &Node[T]{value: value, owner: heap.owner}
+	heap.addRoot(node)
+	heap.size++
+	heap.modCount++
+	return node
+}
+
+// Pop removes top element on heap and returns it, or nil if heap is empty.
+// Second return parameter is true, unless the heap was empty and there was nothing to pop.
+func (heap *Heap[T]) Pop() (value T, ok bool) {
+	if heap.min == nil {
+		return value, false
+	}
+	node := heap.min
+	heap.removeMin()
+	return node.value, true
+}
+
+// Peek returns top element on the heap without removing it, or nil if heap is empty.
+// Second return parameter is true, unless the heap was empty and there was nothing to peek.
+func (heap *Heap[T]) Peek() (value T, ok bool) {
+	if heap.min == nil {
+		return value, false
+	}
+	return heap.min.value, true
+}
+
+// PeekNode returns the node on top of the heap without removing it, or nil if heap is empty.
+func (heap *Heap[T]) PeekNode() *Node[T] {
+	return heap.min
+}
+
+// Contains returns true if the node is a handle of an element in the heap.
+func (heap *Heap[T]) Contains(node *Node[T]) bool {
+	return node != nil && node.owner != nil && node.owner.find() == heap.owner
+}
+
+// DecreaseKey replaces the element of the node by the value, which must not be greater than the element.
+// Returns false, without changing anything, if the node is not in the heap or the value is greater.
+func (heap *Heap[T]) DecreaseKey(node *Node[T], value T) bool {
+	if !heap.Contains(node) || heap.Comparator(value, node.value) > 0 {
+		return false
+	}
+	node.value = value
+	if parent := node.parent; parent != nil && heap.Comparator(node.value, parent.value) < 0 {
+		heap.cut(node)
+		heap.cascadingCut(parent)
+	}
+	if heap.Comparator(node.value, heap.min.value) < 0 {
+		heap.min = node
+	}
+	heap.modCount++
+	return true
+}
+
+// Delete removes the element of the node from the heap.
+// Returns false, without changing anything, if the node is not in the heap.
+func (heap *Heap[T]) Delete(node *Node[T]) bool {
+	if !heap.Contains(node) {
+		return false
+	}
+	if parent := node.parent; parent != nil {
+		heap.cut(node)
+		heap.cascadingCut(parent)
+	}
+	// the node is a root now, remove it as if it was the top element
+	heap.min = node
+	heap.removeMin()
+	return true
+}
+
+// Meld moves all elements of the other heap into this heap in O(1), leaving the other heap empty.
+// Nodes of the other heap become nodes of this heap. The elements are ordered by the comparator of this heap.
+func (heap *Heap[T]) Meld(other *Heap[T]) {
+	if other == heap || other.min == nil {
+		return
+	}
+	if heap.min == nil {
+		heap.min = other.min
+	} else {
+		splice(heap.min, other.min)
+		if heap.Comparator(other.min.value, heap.min.value) < 0 {
+			heap.min = other.min
+		}
+	}
+	heap.size += other.size
+	heap.modCount++
+	other.owner.parent = heap.owner
+	other.min, other.size, other.owner = nil, 0, &owner{}
+	other.modCount++
+}
+
+// Empty returns true if heap does not contain any elements.
+func (heap *Heap[T]) Empty() bool {
+	return heap.size == 0
+}
+
+// Size returns number of elements within the heap.
+func (heap *Heap[T]) Size() int {
+	return heap.size
+}
+
+// Clear removes all elements from the heap.
+// Nodes of the removed elements are no longer handles of elements in the heap.
+func (heap *Heap[T]) Clear() {
+	heap.min = nil
+	heap.size = 0
+	heap.owner = &owner{}
+	heap.modCount++
+}
+
+// Values returns all elements in the heap, the top element comes first, but the elements are not sorted.
+func (heap *Heap[T]) Values() []T {
+	values := make([]T, 0, heap.size)
+	if heap.min == nil {
+		return values
+	}
+	stack := []*Node[T]{heap.min}
+	for len(stack) > 0 {
+		first := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		node := first
+		for {
+			values = append(values, node.value)
+			if node.child != nil {
+				stack = append(stack, node.child)
+			}
+			node = node.right
+			if node == first {
+				break
+			}
+		}
+	}
+	return values
+}
+
+// SetFailFast enables or disables the checks of iterators for modifications of the heap done while iterating,
+// see containers.ConcurrentModificationError. Checks are enabled by default.
+func (heap *Heap[T]) SetFailFast(enabled bool) {
+	heap.failFastDisabled = !enabled
+}
+
+// String returns a string representation of container
+func (heap *Heap[T]) String() string {
+	str := "FibonacciHeap\n"
+	values := []string{}
+	for _, value := range heap.Values() {
+		values = append(values, fmt.Sprintf("%v", value))
+	}
+	str += strings.Join(values, ", ")
+	return str
+}
+
+// addRoot adds the single node to the list of roots.
+func (heap *Heap[T]) addRoot(node *Node[T]) {
+	node.parent = nil
+	node.marked = false
+	node.left, node.right = node, node
+	if heap.min == nil {
+		heap.min = node
+		return
+	}
+	splice(heap.min, node)
+	if heap.Comparator(node.value, heap.min.value) < 0 {
+		heap.min = node
+	}
+}
+
+// removeMin removes the top node, moves its children to the list of roots and consolidates the roots.
+func (heap *Heap[T]) removeMin() {
+	node := heap.min
+	if child := node.child; child != nil {
+		for c := child; ; {
+			c.parent = nil
+			c = c.right
+			if c == child {
+				break
+			}
+		}
+		splice(node, child)
+	}
+	if node.right == node {
+		heap.min = nil
+	} else {
+		heap.min = node.right
+		unlink(node)
+		heap.consolidate()
+	}
+	heap.size--
+	heap.modCount++
+	node.child, node.left, node.right, node.owner = nil, nil, nil, nil
+}
+
+// consolidate links roots of equal degree until all roots have distinct degrees and finds the new top node.
+func (heap *Heap[T]) consolidate() {
+	var roots []*Node[T]
+	for node := heap.min; ; {
+		roots = append(roots, node)
+		node = node.right
+		if node == heap.min {
+			break
+		}
+	}
+	var degrees []*Node[T] // root of each degree
+	for _, node := range roots {
+		node.left, node.right = node, node
+		for {
+			for node.degree >= len(degrees) {
+				degrees = append(degrees, nil)
+			}
+			other := degrees[node.degree]
+			if other == nil {
+				break
+			}
+			degrees[node.degree] = nil
+			if heap.Comparator(other.value, node.value) < 0 {
+				node, other = other, node
+			}
+			heap.link(other, node)
+		}
+		degrees[node.degree] = node
+	}
+	heap.min = nil
+	for _, node := range degrees {
+		if node != nil {
+			heap.addRoot(node)
+		}
+	}
+}
+
+// link makes the single node a child of the parent.
+func (heap *Heap[T]) link(node, parent *Node[T]) {
+	node.parent = parent
+	node.marked = false
+	node.left, node.right = node, node
+	if parent.child == nil {
+		parent.child = node
+	} else {
+		splice(parent.child, node)
+	}
+	parent.degree++
+}
+
+// cut moves the node from its parent's children to the list of roots.
+func (heap *Heap[T]) cut(node *Node[T]) {
+	parent := node.parent
+	if node.right == node {
+		parent.child = nil
+	} else {
+		if parent.child == node {
+			parent.child = node.right
+		}
+		unlink(node)
+	}
+	parent.degree--
+	heap.addRoot(node)
+}
+
+// cascadingCut cuts the node from its parent if it lost a child before, and so on up the tree.
+func (heap *Heap[T]) cascadingCut(node *Node[T]) {
+	for parent := node.parent; parent != nil; node, parent = parent, parent.parent {
+		if !node.marked {
+			node.marked = true
+			return
+		}
+		heap.cut(node)
+	}
+}
+
+// splice concatenates the circular lists containing the nodes a and b.
+func splice[T comparable](a, b *Node[T]) {
+	aRight, bLeft := a.right, b.left
+	a.right, b.left = b, a
+	bLeft.right, aRight.left = aRight, bLeft
+}
+
+// unlink removes the node from its circular list, the node itself is not modified.
+func unlink[T comparable](node *Node[T]) {
+	node.left.right = node.right
+	node.right.left = node.left
+}
+
+// find returns the owner of the heap the owner was melded into, compressing the path on the way.
+func (o *owner) find() *owner {
+	for o.parent != nil {
+		if o.parent.parent != nil {
+			o.parent = o.parent.parent
+		}
+		o = o.parent
+	}
+	return o
+}
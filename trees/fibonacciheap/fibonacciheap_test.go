@@ -0,0 +1,419 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fibonacciheap
+
+import (
+	"math/bits"
+	"math/rand"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/emirpasic/gods/v2/testutils"
+	"github.com/emirpasic/gods/v2/trees"
+)
+
+// assertValidFibonacciHeap checks the circular lists, the degrees and the heap order of the trees, and that a node of
+// degree k has at least F(k+2) nodes in its subtree, which cascading cuts guarantee.
+func assertValidFibonacciHeap(t *testing.T, heap *Heap[int]) {
+	t.Helper()
+	if heap.min == nil {
+		if heap.size != 0 {
+			t.Fatalf("Got no roots for size %v", heap.size)
+		}
+		return
+	}
+	count := 0
+	var subtree func(node *Node[int]) int
+	subtree = func(node *Node[int]) int {
+		count++
+		if !heap.Contains(node) {
+			t.Fatalf("Node %v is not a handle of the heap", node.value)
+		}
+		size, degree := 1, 0
+		for _, child := range siblings(node.child) {
+			if child.parent != node || child.right.left != child {
+				t.Fatalf("Child %v of %v is not linked", child.value, node.value)
+			}
+			if heap.Comparator(child.value, node.value) < 0 {
+				t.Fatalf("Heap order invalidated: %v is a child of %v", child.value, node.value)
+			}
+			size += subtree(child)
+			degree++
+		}
+		if degree != node.degree {
+			t.Fatalf("Got degree %v expected %v for %v", node.degree, degree, node.value)
+		}
+		if minSize := fibonacci(degree + 2); size < minSize {
+			t.Fatalf("Got %v nodes expected at least %v under %v of degree %v", size, minSize, node.value, degree)
+		}
+		return size
+	}
+	for _, root := range siblings(heap.min) {
+		if root.parent != nil || root.marked || root.right.left != root {
+			t.Fatalf("Root %v is not linked", root.value)
+		}
+		if heap.Comparator(root.value, heap.min.value) < 0 {
+			t.Fatalf("Got top %v expected %v", heap.min.value, root.value)
+		}
+		subtree(root)
+	}
+	if count != heap.size {
+		t.Fatalf("Got %v nodes expected %v", count, heap.size)
+	}
+}
+
+// siblings returns the nodes of the circular list containing the node.
+func siblings(node *Node[int]) []*Node[int] {
+	var nodes []*Node[int]
+	for n := node; n != nil; {
+		nodes = append(nodes, n)
+		if n = n.right; n == node {
+			break
+		}
+	}
+	return nodes
+}
+
+// degrees returns the degrees of the roots of the heap.
+func degrees(heap *Heap[int]) []int {
+	var degrees []int
+	for _, root := range siblings(heap.min) {
+		degrees = append(degrees, root.degree)
+	}
+	slices.Sort(degrees)
+	return degrees
+}
+
+func fibonacci(n int) int {
+	a, b := 0, 1
+	for ; n > 0; n-- {
+		a, b = b, a+b
+	}
+	return a
+}
+
+func TestFibonacciHeapContract(t *testing.T) {
+	testutils.HeapContract(t, func() trees.Heap[int] { return New[int]() })
+}
+
+func TestFibonacciHeapConsolidate(t *testing.T) {
+	heap := New[int]()
+	for i := 0; i < 16; i++ {
+		heap.Insert(i)
+	}
+	// insertion is lazy, every element is a root of its own
+	if actualValue, expectedValue := len(siblings(heap.min)), 16; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// popping links roots of equal degree, which leaves one binomial tree per bit of the size
+	for size := 15; size > 0; size-- {
+		heap.Pop()
+		assertValidFibonacciHeap(t, heap)
+		var expected []int
+		for degree := 0; degree < bits.Len(uint(size)); degree++ {
+			if size&(1<<degree) != 0 {
+				expected = append(expected, degree)
+			}
+		}
+		if actualValue := degrees(heap); !slices.Equal(actualValue, expected) {
+			t.Errorf("Got %v expected %v for size %v", actualValue, expected, size)
+		}
+	}
+}
+
+func TestFibonacciHeapCascadingCut(t *testing.T) {
+	heap := New[int]()
+	for i := 0; i < 9; i++ {
+		heap.Insert(i)
+	}
+	heap.Pop()
+	if actualValue, expectedValue := degrees(heap), []int{3}; !slices.Equal(actualValue, expectedValue) {
+		t.Fatalf("Got %v expected %v", actualValue, expectedValue)
+	}
+	root := heap.min
+	var parent *Node[int] // child of the root with two children
+	for _, child := range siblings(root.child) {
+		if child.degree == 2 {
+			parent = child
+		}
+	}
+	first, second := siblings(parent.child)[0], siblings(parent.child)[1]
+
+	// the first cut only marks the parent
+	heap.DecreaseKey(first, -1)
+	assertValidFibonacciHeap(t, heap)
+	if first.parent != nil || parent.parent != root || !parent.marked || parent.degree != 1 {
+		t.Errorf("Got %v, %v, %v expected %v, %v, %v", first.parent, parent.marked, parent.degree, nil, true, 1)
+	}
+	if actualValue := heap.PeekNode(); actualValue != first {
+		t.Errorf("Got %v expected %v", actualValue.value, first.value)
+	}
+
+	// the second cut cuts the marked parent as well
+	heap.DecreaseKey(second, -2)
+	assertValidFibonacciHeap(t, heap)
+	if second.parent != nil || parent.parent != nil || parent.marked || parent.degree != 0 {
+		t.Errorf("Got %v, %v, %v expected %v, %v, %v", parent.parent, parent.marked, parent.degree, nil, false, 0)
+	}
+	if actualValue, expectedValue := len(siblings(heap.min)), 4; actualValue != expectedValue || root.degree != 2 {
+		t.Errorf("Got %v, %v expected %v, %v", actualValue, root.degree, expectedValue, 2)
+	}
+
+	// decreasing a root or a child that stays in order does not cut anything
+	heap.DecreaseKey(parent, -3)
+	heap.DecreaseKey(siblings(root.child)[0], 1)
+	assertValidFibonacciHeap(t, heap)
+	if actualValue, expectedValue := len(siblings(heap.min)), 4; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, ok := heap.Peek(); actualValue != -3 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, -3)
+	}
+}
+
+func TestFibonacciHeapDecreaseKey(t *testing.T) {
+	heap := New[int]()
+	nodes := make([]*Node[int], 0, 10)
+	for i := 0; i < 10; i++ {
+		nodes = append(nodes, heap.Insert(10+i))
+	}
+	heap.Pop() // consolidate into trees
+
+	if actualValue := heap.DecreaseKey(nodes[5], 20); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if actualValue := heap.DecreaseKey(nodes[0], 1); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	for i, value := range map[int]int{5: 12, 7: 8, 9: 5} {
+		if actualValue := heap.DecreaseKey(nodes[i], value); actualValue != true {
+			t.Errorf("Got %v expected %v", actualValue, true)
+		}
+		assertValidFibonacciHeap(t, heap)
+	}
+	if actualValue := heap.PeekNode(); actualValue != nodes[9] {
+		t.Errorf("Got %v expected %v", actualValue.value, nodes[9].value)
+	}
+
+	var values []int
+	for !heap.Empty() {
+		value, _ := heap.Pop()
+		values = append(values, value)
+	}
+	if actualValue, expectedValue := values, []int{5, 8, 11, 12, 12, 13, 14, 16, 18}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue := heap.Contains(nodes[9]); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+}
+
+func TestFibonacciHeapDelete(t *testing.T) {
+	heap := New[int]()
+	nodes := make([]*Node[int], 0, 10)
+	for i := 0; i < 10; i++ {
+		nodes = append(nodes, heap.Insert(i))
+	}
+	heap.Pop() // consolidate into trees
+
+	for _, i := range []int{5, 1, 9} {
+		if actualValue := heap.Delete(nodes[i]); actualValue != true {
+			t.Errorf("Got %v expected %v", actualValue, true)
+		}
+		assertValidFibonacciHeap(t, heap)
+	}
+	if actualValue := heap.Delete(nodes[5]); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if actualValue := heap.Delete(nil); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+
+	var values []int
+	for !heap.Empty() {
+		value, _ := heap.Pop()
+		values = append(values, value)
+	}
+	if actualValue, expectedValue := values, []int{2, 3, 4, 6, 7, 8}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestFibonacciHeapMeld(t *testing.T) {
+	heap := New[int]()
+	other := New[int]()
+	heap.Push(5, 1, 3)
+	node := other.Insert(6)
+	other.Push(4, 2)
+
+	// the lists of roots are concatenated without consolidating them
+	heap.Meld(other)
+	heap.Meld(heap)
+	heap.Meld(New[int]())
+	if actualValue, expectedValue := len(siblings(heap.min)), 6; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	assertValidFibonacciHeap(t, heap)
+
+	if actualValue := other.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := heap.Contains(node); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := other.Contains(node); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if actualValue := other.DecreaseKey(node, 0); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if actualValue := heap.DecreaseKey(node, 0); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	for _, expectedValue := range []int{0, 1, 2, 3, 4, 5} {
+		if actualValue, ok := heap.Pop(); actualValue != expectedValue || !ok {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+
+	// melded heap can be reused
+	other.Push(7)
+	heap.Meld(other)
+	if actualValue, ok := heap.Pop(); actualValue != 7 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 7)
+	}
+}
+
+func TestFibonacciHeapIterator(t *testing.T) {
+	heap := New[int]()
+	heap.Push(3, 1, 4, 2)
+	heap.Pop()
+
+	var values []int
+	for it := heap.Iterator(); it.Next(); {
+		if actualValue, expectedValue := it.Index(), len(values); actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		values = append(values, it.Value())
+	}
+	if actualValue, expectedValue := values, heap.Values(); !slices.Equal(actualValue, expectedValue) || values[0] != 2 {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	it := heap.Iterator()
+	if actualValue := it.Last(); actualValue != true || it.Index() != 2 {
+		t.Errorf("Got %v, %v expected %v, %v", actualValue, it.Index(), true, 2)
+	}
+	if actualValue := it.PrevTo(func(index int, value int) bool { return value == 2 }); actualValue != true || it.Index() != 0 {
+		t.Errorf("Got %v, %v expected %v, %v", actualValue, it.Index(), true, 0)
+	}
+	for it := New[int]().Iterator(); it.Next(); {
+		t.Errorf("Shouldn't iterate on empty heap")
+	}
+}
+
+func TestFibonacciHeapIteratorFailFast(t *testing.T) {
+	heap := New[int]()
+	var node *Node[int]
+	for _, modify := range []func(){
+		func() { heap.Push(4) },
+		func() { heap.Pop() },
+		func() { heap.DecreaseKey(node, 1) },
+		func() { heap.Delete(node) },
+		func() {
+			other := New[int]()
+			other.Push(5)
+			heap.Meld(other)
+		},
+		func() { heap.Clear() },
+	} {
+		heap.Clear()
+		node = heap.Insert(2)
+		heap.Push(3)
+		it := heap.Iterator()
+		it.Next()
+		modify()
+		testutils.ConcurrentModificationPanic(t, func() { it.Next() })
+	}
+
+	// melding an empty heap and failed handle operations do not modify the heap
+	it := heap.Iterator()
+	it.Next()
+	heap.Meld(New[int]())
+	heap.DecreaseKey(node, 10)
+	heap.Delete(nil)
+	it.Next() // no panic
+
+	heap.SetFailFast(false)
+	it.First()
+	heap.Push(1)
+	it.Next() // no panic
+}
+
+func TestFibonacciHeapRandom(t *testing.T) {
+	heap := New[int]()
+	nodes := map[*Node[int]]struct{}{}
+
+	random := rand.New(rand.NewSource(3))
+	for i := 0; i < 10000; i++ {
+		switch r := random.Intn(10); {
+		case r < 5 || heap.Empty():
+			nodes[heap.Insert(random.Intn(1000))] = struct{}{}
+		case r < 7:
+			for node := range nodes {
+				heap.DecreaseKey(node, node.Value()-random.Intn(100))
+				break
+			}
+		case r < 8:
+			for node := range nodes {
+				heap.Delete(node)
+				delete(nodes, node)
+				break
+			}
+		default:
+			node := heap.PeekNode()
+			value, _ := heap.Pop()
+			if value != node.Value() {
+				t.Fatalf("Got %v expected %v", value, node.Value())
+			}
+			delete(nodes, node)
+		}
+		if i%100 == 0 {
+			assertValidFibonacciHeap(t, heap)
+		}
+		if actualValue, expectedValue := heap.Size(), len(nodes); actualValue != expectedValue {
+			t.Fatalf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+}
+
+func TestFibonacciHeapString(t *testing.T) {
+	c := New[int]()
+	c.Push(1)
+	if !strings.HasPrefix(c.String(), "FibonacciHeap") {
+		t.Errorf("String should start with container name")
+	}
+}
+
+func benchmarkPushPop(b *testing.B, heap *Heap[int], size int) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			heap.Push(n)
+		}
+		for n := 0; n < size; n++ {
+			heap.Pop()
+		}
+	}
+}
+
+func BenchmarkFibonacciHeapPushPop1000(b *testing.B) {
+	benchmarkPushPop(b, New[int](), 1000)
+}
+
+func BenchmarkFibonacciHeapPushPop100000(b *testing.B) {
+	benchmarkPushPop(b, New[int](), 100000)
+}
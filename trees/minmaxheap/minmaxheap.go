@@ -0,0 +1,299 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package minmaxheap implements a min-max heap, a double-ended heap backed by a slice.
+//
+// Levels of the heap alternate between min levels and max levels, starting with a min level at the root.
+// Every element on a min level is smaller than or equal to all its descendants and every element on a max level
+// is larger than or equal to all its descendants, so both the minimum and the maximum are found in O(1) and
+// removed in O(log n). The order is defined by the comparator.
+//
+// Structure is not thread safe.
+//
+// References: https://en.wikipedia.org/wiki/Min-max_heap
+package minmaxheap
+
+import (
+	"cmp"
+	"fmt"
+	"math/bits"
+	"slices"
+	"strings"
+
+	"github.com/emirpasic/gods/v2/containers"
+	"github.com/emirpasic/gods/v2/trees"
+	"github.com/emirpasic/gods/v2/utils"
+)
+
+// Assert Tree implementation
+var _ trees.Tree[int] = (*Heap[int])(nil)
+var _ containers.FailFast = (*Heap[int])(nil)
+
+// Heap holds elements in a slice
+type Heap[T comparable] struct {
+	values           []T
+	Comparator       utils.Comparator[T]
+	modCount         int  // number of structural modifications, checked by iterators
+	failFastDisabled bool // iterators do not check for modifications
+}
+
+// New instantiates a new empty heap with the built-in comparator for T
+func New[T cmp.Ordered]() *Heap[T] {
+	return NewWith[T](cmp.Compare[T])
+}
+
+// NewWith instantiates a new empty heap with the custom comparator.
+func NewWith[T comparable](comparator utils.Comparator[T]) *Heap[T] {
+	return &Heap[T]{Comparator: comparator}
+}
+
+// NewFromSlice instantiates a new heap of the values with the custom comparator in O(n).
+// The values are copied, the slice is not modified.
+func NewFromSlice[T comparable](values []T, comparator utils.Comparator[T]) *Heap[T] {
+	heap := &Heap[T]{values: slices.Clone(values), Comparator: comparator}
+	heap.heapify()
+	return heap
+}
+
+// Push adds values (one or more) onto the heap.
+// A single value is bubbled up in O(log n), many values are added by rebuilding the heap in O(n).
+func (heap *Heap[T]) Push(values ...T) {
+	if len(values) == 1 {
+		heap.values = append(heap.values, values[0])
+		heap.bubbleUp(len(heap.values) - 1)
+	} else {
+		heap.values = append(heap.values, values...)
+		heap.heapify()
+	}
+	heap.modCount++
+}
+
+// PeekMin returns the smallest element of the heap without removing it.
+// Second return parameter is true, unless the heap was empty and there was nothing to peek.
+func (heap *Heap[T]) PeekMin() (value T, ok bool) {
+	if len(heap.values) == 0 {
+		return value, false
+	}
+	return heap.values[0], true
+}
+
+// PeekMax returns the largest element of the heap without removing it.
+// Second return parameter is true, unless the heap was empty and there was nothing to peek.
+func (heap *Heap[T]) PeekMax() (value T, ok bool) {
+	if len(heap.values) == 0 {
+		return value, false
+	}
+	return heap.values[heap.maxIndex()], true
+}
+
+// PopMin removes the smallest element of the heap and returns it.
+// Second return parameter is true, unless the heap was empty and there was nothing to pop.
+func (heap *Heap[T]) PopMin() (value T, ok bool) {
+	if len(heap.values) == 0 {
+		return value, false
+	}
+	return heap.removeAt(0), true
+}
+
+// PopMax removes the largest element of the heap and returns it.
+// Second return parameter is true, unless the heap was empty and there was nothing to pop.
+func (heap *Heap[T]) PopMax() (value T, ok bool) {
+	if len(heap.values) == 0 {
+		return value, false
+	}
+	return heap.removeAt(heap.maxIndex()), true
+}
+
+// Empty returns true if heap does not contain any elements.
+func (heap *Heap[T]) Empty() bool {
+	return len(heap.values) == 0
+}
+
+// Size returns number of elements within the heap.
+func (heap *Heap[T]) Size() int {
+	return len(heap.values)
+}
+
+// Clear removes all elements from the heap.
+func (heap *Heap[T]) Clear() {
+	clear(heap.values)
+	heap.values = heap.values[:0]
+	heap.modCount++
+}
+
+// Values returns all elements in the heap level by level with the elements of each level sorted, like a binary heap
+// does, i.e. the smallest element comes first, but the elements are not sorted as a whole.
+func (heap *Heap[T]) Values() []T {
+	values := make([]T, 0, len(heap.values))
+	for start := 0; start < len(heap.values); start = 2*start + 1 {
+		values = append(values, heap.level(start)...)
+	}
+	return values
+}
+
+// SetFailFast enables or disables the checks of iterators for modifications of the heap done while iterating,
+// see containers.ConcurrentModificationError. Checks are enabled by default.
+func (heap *Heap[T]) SetFailFast(enabled bool) {
+	heap.failFastDisabled = !enabled
+}
+
+// String returns a string representation of container
+func (heap *Heap[T]) String() string {
+	str := "MinMaxHeap\n"
+	values := make([]string, 0, len(heap.values))
+	for _, value := range heap.Values() {
+		values = append(values, fmt.Sprintf("%v", value))
+	}
+	str += strings.Join(values, ", ")
+	return str
+}
+
+// maxIndex returns the index of the largest element of the non-empty heap, which is one of the root's children
+// unless the root is the only element.
+func (heap *Heap[T]) maxIndex() int {
+	switch {
+	case len(heap.values) == 1:
+		return 0
+	case len(heap.values) == 2 || heap.less(heap.values[2], heap.values[1]):
+		return 1
+	default:
+		return 2
+	}
+}
+
+// removeAt removes the element at the index, which has to be the root or one of its children, and returns it.
+func (heap *Heap[T]) removeAt(index int) T {
+	value := heap.values[index]
+	last := len(heap.values) - 1
+	heap.values[index] = heap.values[last]
+	var t T
+	heap.values[last] = t
+	heap.values = heap.values[:last]
+	if index < last {
+		heap.trickleDown(index)
+	}
+	heap.modCount++
+	return value
+}
+
+// heapify restores the heap order of all elements in O(n) by trickling down the inner nodes bottom-up
+// (Floyd's method).
+func (heap *Heap[T]) heapify() {
+	for i := len(heap.values)/2 - 1; i >= 0; i-- {
+		heap.trickleDown(i)
+	}
+}
+
+// bubbleUp moves the element at the index up to its place.
+func (heap *Heap[T]) bubbleUp(index int) {
+	if index == 0 {
+		return
+	}
+	parent := (index - 1) / 2
+	if isMinLevel(index) {
+		if heap.less(heap.values[parent], heap.values[index]) {
+			heap.swap(index, parent)
+			heap.bubbleUpLevels(parent, false)
+		} else {
+			heap.bubbleUpLevels(index, true)
+		}
+	} else {
+		if heap.less(heap.values[index], heap.values[parent]) {
+			heap.swap(index, parent)
+			heap.bubbleUpLevels(parent, true)
+		} else {
+			heap.bubbleUpLevels(index, false)
+		}
+	}
+}
+
+// bubbleUpLevels moves the element at the index up along the min levels (or max levels) to its place.
+func (heap *Heap[T]) bubbleUpLevels(index int, minLevel bool) {
+	for index > 2 {
+		grandparent := ((index-1)/2 - 1) / 2
+		if !heap.before(heap.values[index], heap.values[grandparent], minLevel) {
+			return
+		}
+		heap.swap(index, grandparent)
+		index = grandparent
+	}
+}
+
+// trickleDown moves the element at the index down to its place.
+func (heap *Heap[T]) trickleDown(index int) {
+	minLevel := isMinLevel(index)
+	for {
+		// m is the smallest (or largest) of the children and grandchildren
+		m, grandchild := heap.extremeDescendant(index, minLevel)
+		if m < 0 || !heap.before(heap.values[m], heap.values[index], minLevel) {
+			return
+		}
+		heap.swap(m, index)
+		if !grandchild {
+			return
+		}
+		if parent := (m - 1) / 2; heap.before(heap.values[parent], heap.values[m], minLevel) {
+			heap.swap(m, parent)
+		}
+		index = m
+	}
+}
+
+// extremeDescendant returns the index of the smallest (or largest) of the children and grandchildren of the element
+// at the index and whether it is a grandchild, or -1 if there are no children.
+func (heap *Heap[T]) extremeDescendant(index int, minLevel bool) (int, bool) {
+	size := len(heap.values)
+	first := 2*index + 1
+	if first >= size {
+		return -1, false
+	}
+	m, grandchild := first, false
+	if first+1 < size && heap.before(heap.values[first+1], heap.values[m], minLevel) {
+		m = first + 1
+	}
+	for i := 2*first + 1; i < min(2*first+5, size); i++ {
+		if heap.before(heap.values[i], heap.values[m], minLevel) {
+			m, grandchild = i, true
+		}
+	}
+	return m, grandchild
+}
+
+// before returns true if a comes strictly before b on a min level (a < b) or max level (a > b).
+func (heap *Heap[T]) before(a, b T, minLevel bool) bool {
+	if minLevel {
+		return heap.less(a, b)
+	}
+	return heap.less(b, a)
+}
+
+func (heap *Heap[T]) less(a, b T) bool {
+	return heap.Comparator(a, b) < 0
+}
+
+func (heap *Heap[T]) swap(i, j int) {
+	heap.values[i], heap.values[j] = heap.values[j], heap.values[i]
+}
+
+// Check that the index is within bounds of the heap
+func (heap *Heap[T]) withinRange(index int) bool {
+	return index >= 0 && index < len(heap.values)
+}
+
+// isMinLevel returns true if the index is on a min level, i.e. on an even level counted from the root at level 0.
+// level returns a sorted copy of the elements on the level starting at index "start".
+func (heap *Heap[T]) level(start int) []T {
+	level := slices.Clone(heap.values[start:min(2*start+1, len(heap.values))])
+	slices.SortFunc(level, heap.Comparator)
+	return level
+}
+
+// levelStart returns the index of the first element on the level of the element at index.
+func levelStart(index int) int {
+	return 1<<(bits.Len(uint(index+1))-1) - 1
+}
+
+func isMinLevel(index int) bool {
+	return bits.Len(uint(index+1))%2 == 1
+}
@@ -0,0 +1,360 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package minmaxheap
+
+import (
+	"cmp"
+	"encoding/json"
+	"math/rand"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/emirpasic/gods/v2/testutils"
+)
+
+// assertHeapOrder checks that each element on a min (max) level is smaller (larger) than or equal to its descendants.
+func assertHeapOrder(t *testing.T, heap *Heap[int]) {
+	t.Helper()
+	for i := 1; i < len(heap.values); i++ {
+		for ancestor := (i - 1) / 2; ; ancestor = (ancestor - 1) / 2 {
+			if isMinLevel(ancestor) && heap.values[ancestor] > heap.values[i] ||
+				!isMinLevel(ancestor) && heap.values[ancestor] < heap.values[i] {
+				t.Fatalf("Heap order invalidated at %v (ancestor %v): %v", i, ancestor, heap.values)
+			}
+			if ancestor == 0 {
+				break
+			}
+		}
+	}
+}
+
+func TestMinMaxHeapPush(t *testing.T) {
+	heap := New[int]()
+
+	if actualValue := heap.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+
+	heap.Push(3)
+	heap.Push(2)
+	heap.Push(1)
+	heap.Push(5)
+	heap.Push(4)
+
+	assertHeapOrder(t, heap)
+	if actualValue := heap.Empty(); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if actualValue := heap.Size(); actualValue != 5 {
+		t.Errorf("Got %v expected %v", actualValue, 5)
+	}
+	if actualValue, ok := heap.PeekMin(); actualValue != 1 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+	if actualValue, ok := heap.PeekMax(); actualValue != 5 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 5)
+	}
+}
+
+func TestMinMaxHeapPushBulk(t *testing.T) {
+	heap := New[int]()
+
+	heap.Push(15, 20, 3, 1, 2)
+	assertHeapOrder(t, heap)
+
+	if actualValue, ok := heap.PopMin(); actualValue != 1 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+	if actualValue, ok := heap.PopMax(); actualValue != 20 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 20)
+	}
+	if actualValue, expectedValue := heap.Size(), 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMinMaxHeapPop(t *testing.T) {
+	heap := New[int]()
+
+	if actualValue, ok := heap.PopMin(); actualValue != 0 || ok {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+	if actualValue, ok := heap.PopMax(); actualValue != 0 || ok {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+	if actualValue, ok := heap.PeekMin(); actualValue != 0 || ok {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+	if actualValue, ok := heap.PeekMax(); actualValue != 0 || ok {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+
+	heap.Push(3)
+	heap.Push(2)
+	heap.Push(1)
+
+	tests := []struct {
+		max   bool
+		value int
+	}{
+		{true, 3},
+		{false, 1},
+		{true, 2},
+	}
+	for _, test := range tests {
+		var actualValue int
+		var ok bool
+		if test.max {
+			actualValue, ok = heap.PopMax()
+		} else {
+			actualValue, ok = heap.PopMin()
+		}
+		if actualValue != test.value || !ok {
+			t.Errorf("Got %v expected %v", actualValue, test.value)
+		}
+	}
+	if actualValue := heap.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := heap.Values(); len(actualValue) != 0 {
+		t.Errorf("Got %v expected %v", actualValue, "[]")
+	}
+}
+
+func TestMinMaxHeapNewFromSlice(t *testing.T) {
+	values := []int{5, 9, 1, 7, 3, 8, 2, 6, 4, 0}
+	heap := NewFromSlice(values, cmp.Compare[int])
+	assertHeapOrder(t, heap)
+
+	if actualValue, expectedValue := values, []int{5, 9, 1, 7, 3, 8, 2, 6, 4, 0}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	for i := 0; i < 5; i++ {
+		if actualValue, ok := heap.PopMin(); actualValue != i || !ok {
+			t.Errorf("Got %v expected %v", actualValue, i)
+		}
+		if actualValue, ok := heap.PopMax(); actualValue != 9-i || !ok {
+			t.Errorf("Got %v expected %v", actualValue, 9-i)
+		}
+	}
+	if actualValue := heap.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+}
+
+func TestMinMaxHeapRandom(t *testing.T) {
+	heap := New[int]()
+	var expected []int
+
+	rand.Seed(3)
+	for i := 0; i < 10000; i++ {
+		switch r := rand.Intn(10); {
+		case r < 6 || heap.Empty():
+			value := rand.Intn(100)
+			heap.Push(value)
+			expected = append(expected, value)
+			slices.Sort(expected)
+		case r < 8:
+			actualValue, _ := heap.PopMin()
+			if actualValue != expected[0] {
+				t.Fatalf("Got %v expected %v", actualValue, expected[0])
+			}
+			expected = expected[1:]
+		default:
+			actualValue, _ := heap.PopMax()
+			if actualValue != expected[len(expected)-1] {
+				t.Fatalf("Got %v expected %v", actualValue, expected[len(expected)-1])
+			}
+			expected = expected[:len(expected)-1]
+		}
+		if i%100 == 0 {
+			assertHeapOrder(t, heap)
+		}
+	}
+	if actualValue, expectedValue := heap.Size(), len(expected); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMinMaxHeapIteratorOnEmpty(t *testing.T) {
+	heap := New[int]()
+	it := heap.Iterator()
+	for it.Next() {
+		t.Errorf("Shouldn't iterate on empty heap")
+	}
+}
+
+func TestMinMaxHeapIterator(t *testing.T) {
+	heap := New[int]()
+	heap.Push(3, 1, 4, 5, 2)
+
+	// levels are sorted, like in a binary heap
+	if actualValue, expectedValue := heap.Values(), []int{1, 4, 5, 2, 3}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	var values []int
+	for it := heap.Iterator(); it.Next(); {
+		if actualValue, expectedValue := it.Index(), len(values); actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		values = append(values, it.Value())
+	}
+	if actualValue, expectedValue := values, heap.Values(); !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	values = values[:0]
+	it := heap.Iterator()
+	for it.End(); it.Prev(); {
+		values = append(values, it.Value())
+	}
+	slices.Reverse(values)
+	if actualValue, expectedValue := values, heap.Values(); !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	if actualValue, expectedValue := it.First(), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := it.Value(), 1; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := it.NextTo(func(index int, value int) bool { return value == 5 }), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := it.Index(), 2; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMinMaxHeapIteratorFailFast(t *testing.T) {
+	heap := New[int]()
+	heap.Push(1)
+	heap.Push(2)
+	it := heap.Iterator()
+	it.Next()
+	heap.Push(3)
+	testutils.ConcurrentModificationPanic(t, func() { it.Next() })
+	testutils.ConcurrentModificationPanic(t, func() { it.Prev() })
+
+	// reset iterator is valid again
+	count := 0
+	for it.Begin(); it.Next(); {
+		count++
+	}
+	if actualValue, expectedValue := count, 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	it.Begin()
+	it.Next()
+	heap.PopMax()
+	testutils.ConcurrentModificationPanic(t, func() { it.Next() })
+
+	heap.SetFailFast(false)
+	it.Begin()
+	it.Next()
+	heap.Push(4)
+	it.Next() // no panic
+}
+
+func TestMinMaxHeapSerialization(t *testing.T) {
+	heap := New[string]()
+
+	heap.Push("c")
+	heap.Push("b")
+	heap.Push("a")
+
+	var err error
+	assert := func() {
+		if actualValue := heap.Size(); actualValue != 3 {
+			t.Errorf("Got %v expected %v", actualValue, 3)
+		}
+		if actualValue, ok := heap.PeekMin(); actualValue != "a" || !ok {
+			t.Errorf("Got %v expected %v", actualValue, "a")
+		}
+		if actualValue, ok := heap.PeekMax(); actualValue != "c" || !ok {
+			t.Errorf("Got %v expected %v", actualValue, "c")
+		}
+		if err != nil {
+			t.Errorf("Got error %v", err)
+		}
+	}
+
+	assert()
+
+	bytes, err := heap.ToJSON()
+	assert()
+
+	err = heap.FromJSON(bytes)
+	assert()
+
+	bytes, err = json.Marshal([]interface{}{"a", "b", "c", heap})
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+
+	intHeap := New[int]()
+	err = json.Unmarshal([]byte(`[5,1,4,2,3]`), &intHeap)
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	assertHeapOrder(t, intHeap)
+	if actualValue, ok := intHeap.PeekMin(); actualValue != 1 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+	if actualValue, ok := intHeap.PeekMax(); actualValue != 5 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 5)
+	}
+}
+
+func TestMinMaxHeapString(t *testing.T) {
+	c := New[int]()
+	c.Push(1)
+	if !strings.HasPrefix(c.String(), "MinMaxHeap") {
+		t.Errorf("String should start with container name")
+	}
+}
+
+func benchmarkPush(b *testing.B, heap *Heap[int], size int) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			heap.Push(n)
+		}
+	}
+}
+
+func benchmarkPop(b *testing.B, heap *Heap[int], size int) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			if n%2 == 0 {
+				heap.PopMin()
+			} else {
+				heap.PopMax()
+			}
+		}
+	}
+}
+
+func BenchmarkMinMaxHeapPop1000(b *testing.B) {
+	b.StopTimer()
+	size := 1000
+	heap := New[int]()
+	for n := 0; n < size; n++ {
+		heap.Push(n)
+	}
+	b.StartTimer()
+	benchmarkPop(b, heap, size)
+}
+
+func BenchmarkMinMaxHeapPush1000(b *testing.B) {
+	b.StopTimer()
+	size := 1000
+	heap := New[int]()
+	b.StartTimer()
+	benchmarkPush(b, heap, size)
+}
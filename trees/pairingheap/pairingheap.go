@@ -0,0 +1,299 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package pairingheap implements a pairing heap, a meldable heap with cheap decrease-key.
+//
+// A pairing heap is a heap-ordered multiway tree. Push, Peek, Meld and DecreaseKey run in O(1), Pop and Delete in
+// O(log n) amortized time. Elements are addressed by the node handles returned by Insert, which stay valid until the
+// element is removed from the heap.
+//
+// Comparator defines this heap as either min or max heap, the least element with respect to it is on top.
+//
+// Structure is not thread safe.
+//
+// References: https://en.wikipedia.org/wiki/Pairing_heap
+package pairingheap
+
+import (
+	"cmp"
+	"fmt"
+	"strings"
+
+	"github.com/emirpasic/gods/v2/containers"
+	"github.com/emirpasic/gods/v2/trees"
+	"github.com/emirpasic/gods/v2/utils"
+)
+
+// Assert Heap implementation
+var _ trees.Heap[int] = (*Heap[int])(nil)
+var _ containers.FailFast = (*Heap[int])(nil)
+
+// Heap holds elements in a tree of nodes
+type Heap[T comparable] struct {
+	root             *Node[T]
+	size             int
+	owner            *owner
+	Comparator       utils.Comparator[T]
+	modCount         int  // number of modifications, checked by iterators
+	failFastDisabled bool // iterators do not check for modifications
+}
+
+// Node is a handle of a single element in the heap
+type Node[T comparable] struct {
+	value T
+	child *Node[T] // leftmost child
+	next  *Node[T] // right sibling
+	prev  *Node[T] // left sibling, or parent if leftmost child
+	owner *owner   // nil once removed from the heap
+}
+
+// owner identifies the heap a node belongs to. Owners of melded heaps are linked to the owner of the heap they were
+// melded into, so handles stay valid without visiting the nodes.
+type owner struct {
+	parent *owner
+}
+
+// New instantiates a new empty heap with the built-in comparator for T
+func New[T cmp.Ordered]() *Heap[T] {
+	return NewWith[T](cmp.Compare[T])
+}
+
+// NewWith instantiates a new empty heap with the custom comparator.
+func NewWith[T comparable](comparator utils.Comparator[T]) *Heap[T] {
+	return &Heap[T]{owner: &owner{}, Comparator: comparator}
+}
+
+// Value returns the element of the node.
+func (node *Node[T]) Value() T {
+	return node.value
+}
+
+// Push adds values (one or more) onto the heap.
+func (heap *Heap[T]) Push(values ...T) {
+	for _, value := range values {
+		heap.Insert(value)
+	}
+}
+
+// Insert adds the value onto the heap and returns its node, a handle for DecreaseKey and Delete.
+func (heap *Heap[T]) Insert(value T) *Node[T] {
+	node := &Node[T]{value: value, owner: heap.owner}
+	heap.root = heap.link(heap.root, node)
+	heap.size++
+	heap.modCount++
+	return node
+}
+
+// Pop removes top element on heap and returns it, or nil if heap is empty.
+// Second return parameter is true, unless the heap was empty and there was nothing to pop.
+func (heap *Heap[T]) Pop() (value T, ok bool) {
+	if heap.root == nil {
+		return value, false
+	}
+	node := heap.root
+	heap.root = heap.mergePairs(node.child)
+	heap.size--
+	heap.modCount++
+	node.child, node.owner = nil, nil
+	return node.value, true
+}
+
+// Peek returns top element on the heap without removing it, or nil if heap is empty.
+// Second return parameter is true, unless the heap was empty and there was nothing to peek.
+func (heap *Heap[T]) Peek() (value T, ok bool) {
+	if heap.root == nil {
+		return value, false
+	}
+	return heap.root.value, true
+}
+
+// PeekNode returns the node on top of the heap without removing it, or nil if heap is empty.
+func (heap *Heap[T]) PeekNode() *Node[T] {
+	return heap.root
+}
+
+// Contains returns true if the node is a handle of an element in the heap.
+func (heap *Heap[T]) Contains(node *Node[T]) bool {
+	return node != nil && node.owner != nil && node.owner.find() == heap.owner
+}
+
+// DecreaseKey replaces the element of the node by the value, which must not be greater than the element.
+// Returns false, without changing anything, if the node is not in the heap or the value is greater.
+func (heap *Heap[T]) DecreaseKey(node *Node[T], value T) bool {
+	if !heap.Contains(node) || heap.Comparator(value, node.value) > 0 {
+		return false
+	}
+	node.value = value
+	if node != heap.root {
+		node.cut()
+		heap.root = heap.link(heap.root, node)
+	}
+	heap.modCount++
+	return true
+}
+
+// Delete removes the element of the node from the heap.
+// Returns false, without changing anything, if the node is not in the heap.
+func (heap *Heap[T]) Delete(node *Node[T]) bool {
+	if !heap.Contains(node) {
+		return false
+	}
+	if node == heap.root {
+		heap.Pop()
+		return true
+	}
+	node.cut()
+	heap.root = heap.link(heap.root, heap.mergePairs(node.child))
+	heap.size--
+	heap.modCount++
+	node.child, node.owner = nil, nil
+	return true
+}
+
+// Meld moves all elements of the other heap into this heap in O(1), leaving the other heap empty.
+// Nodes of the other heap become nodes of this heap. The elements are ordered by the comparator of this heap.
+func (heap *Heap[T]) Meld(other *Heap[T]) {
+	if other == heap || other.root == nil {
+		return
+	}
+	heap.root = heap.link(heap.root, other.root)
+	heap.size += other.size
+	heap.modCount++
+	other.owner.parent = heap.owner
+	other.root, other.size, other.owner = nil, 0, &owner{}
+	other.modCount++
+}
+
+// Empty returns true if heap does not contain any elements.
+func (heap *Heap[T]) Empty() bool {
+	return heap.size == 0
+}
+
+// Size returns number of elements within the heap.
+func (heap *Heap[T]) Size() int {
+	return heap.size
+}
+
+// Clear removes all elements from the heap.
+// Nodes of the removed elements are no longer handles of elements in the heap.
+func (heap *Heap[T]) Clear() {
+	heap.root = nil
+	heap.size = 0
+	heap.owner = &owner{}
+	heap.modCount++
+}
+
+// Values returns all elements in the heap in pre-order, i.e. the top element comes first, but the elements are not
+// sorted.
+func (heap *Heap[T]) Values() []T {
+	values := make([]T, 0, heap.size)
+	stack := []*Node[T]{}
+	if heap.root != nil {
+		stack = append(stack, heap.root)
+	}
+	for len(stack) > 0 {
+		node := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		values = append(values, node.value)
+		if node.next != nil {
+			stack = append(stack, node.next)
+		}
+		if node.child != nil {
+			stack = append(stack, node.child)
+		}
+	}
+	return values
+}
+
+// SetFailFast enables or disables the checks of iterators for modifications of the heap done while iterating,
+// see containers.ConcurrentModificationError. Checks are enabled by default.
+func (heap *Heap[T]) SetFailFast(enabled bool) {
+	heap.failFastDisabled = !enabled
+}
+
+// String returns a string representation of container
+func (heap *Heap[T]) String() string {
+	str := "PairingHeap\n"
+	values := []string{}
+	for _, value := range heap.Values() {
+		values = append(values, fmt.Sprintf("%v", value))
+	}
+	str += strings.Join(values, ", ")
+	return str
+}
+
+// link makes the root with the greater element the leftmost child of the other root and returns the new root.
+// Either root may be nil.
+func (heap *Heap[T]) link(a, b *Node[T]) *Node[T] {
+	switch {
+	case a == nil:
+		return b
+	case b == nil:
+		return a
+	case heap.Comparator(b.value, a.value) < 0:
+		a, b = b, a
+	}
+	b.prev = a
+	b.next = a.child
+	if a.child != nil {
+		a.child.prev = b
+	}
+	a.child = b
+	a.prev, a.next = nil, nil
+	return a
+}
+
+// mergePairs links the siblings starting with the node into a single tree and returns its root (two-pass pairing).
+func (heap *Heap[T]) mergePairs(node *Node[T]) *Node[T] {
+	// first pass: link pairs from left to right, collecting the results in reverse order via their next links
+	var pairs *Node[T]
+	for node != nil {
+		a, b := node, node.next
+		if b == nil {
+			node = nil
+		} else {
+			node = b.next
+		}
+		a.prev, a.next = nil, nil
+		if b != nil {
+			b.prev, b.next = nil, nil
+		}
+		pair := heap.link(a, b)
+		pair.next = pairs
+		pairs = pair
+	}
+	// second pass: link the pairs from right to left
+	var root *Node[T]
+	for pairs != nil {
+		pair := pairs
+		pairs = pairs.next
+		pair.next = nil
+		root = heap.link(root, pair)
+	}
+	return root
+}
+
+// cut detaches the node, together with its children, from its parent and siblings.
+func (node *Node[T]) cut() {
+	if node.prev.child == node {
+		node.prev.child = node.next
+	} else {
+		node.prev.next = node.next
+	}
+	if node.next != nil {
+		node.next.prev = node.prev
+	}
+	node.prev, node.next = nil, nil
+}
+
+// find returns the owner of the heap the owner was melded into, compressing the path on the way.
+func (o *owner) find() *owner {
+	for o.parent != nil {
+		if o.parent.parent != nil {
+			o.parent = o.parent.parent
+		}
+		o = o.parent
+	}
+	return o
+}
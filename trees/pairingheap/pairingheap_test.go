@@ -0,0 +1,387 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pairingheap
+
+import (
+	"math/rand"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/emirpasic/gods/v2/testutils"
+	"github.com/emirpasic/gods/v2/trees"
+)
+
+// assertValidPairingHeap checks the links of the nodes and the heap order of every child and its parent.
+func assertValidPairingHeap(t *testing.T, heap *Heap[int]) {
+	t.Helper()
+	if heap.root == nil {
+		if heap.size != 0 {
+			t.Fatalf("Got empty tree of size %v", heap.size)
+		}
+		return
+	}
+	if heap.root.prev != nil || heap.root.next != nil {
+		t.Fatalf("Root %v has siblings", heap.root.value)
+	}
+	count := 0
+	stack := []*Node[int]{heap.root}
+	for len(stack) > 0 {
+		node := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		count++
+		if !heap.Contains(node) {
+			t.Fatalf("Node %v is not a handle of the heap", node.value)
+		}
+		prev := node
+		for child := node.child; child != nil; prev, child = child, child.next {
+			if child.prev != prev {
+				t.Fatalf("Child %v of %v is not linked to its left sibling", child.value, node.value)
+			}
+			if heap.Comparator(child.value, node.value) < 0 {
+				t.Fatalf("Heap order invalidated: %v is a child of %v", child.value, node.value)
+			}
+			stack = append(stack, child)
+		}
+	}
+	if count != heap.size {
+		t.Fatalf("Got %v nodes expected %v", count, heap.size)
+	}
+}
+
+// children returns the values of the children of the node, leftmost first.
+func children(node *Node[int]) []int {
+	var values []int
+	for child := node.child; child != nil; child = child.next {
+		values = append(values, child.value)
+	}
+	return values
+}
+
+func TestPairingHeapContract(t *testing.T) {
+	testutils.HeapContract(t, func() trees.Heap[int] { return New[int]() })
+}
+
+func TestPairingHeapInsert(t *testing.T) {
+	heap := New[int]()
+	for i := 0; i < 9; i++ {
+		heap.Insert(i)
+	}
+	assertValidPairingHeap(t, heap)
+
+	// a larger value is linked as the leftmost child of the root
+	if actualValue, expectedValue := children(heap.root), []int{8, 7, 6, 5, 4, 3, 2, 1}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	// a smaller value becomes the root
+	node := heap.Insert(-1)
+	if actualValue, expectedValue := children(heap.root), []int{0}; heap.root != node || !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestPairingHeapPopTwoPass(t *testing.T) {
+	heap := New[int]()
+	for i := 0; i < 9; i++ {
+		heap.Insert(i)
+	}
+	if actualValue, ok := heap.Pop(); actualValue != 0 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+	assertValidPairingHeap(t, heap)
+
+	// first pass links (8,7), (6,5), (4,3) and (2,1) from left to right,
+	// second pass links the winners 1, 3, 5 and 7 from right to left
+	if actualValue, expectedValue := children(heap.root), []int{7, 5, 3, 2}; heap.root.value != 1 || !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := heap.Values(), []int{1, 7, 8, 5, 6, 3, 4, 2}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	for i := 1; i <= 8; i++ {
+		if actualValue, ok := heap.Pop(); actualValue != i || !ok {
+			t.Errorf("Got %v expected %v", actualValue, i)
+		}
+		assertValidPairingHeap(t, heap)
+	}
+}
+
+func TestPairingHeapDecreaseKey(t *testing.T) {
+	heap := New[int]()
+	nodes := make([]*Node[int], 0, 10)
+	for i := 0; i < 10; i++ {
+		nodes = append(nodes, heap.Insert(10+i))
+	}
+	heap.Pop() // restructure into a tree
+
+	if actualValue := heap.DecreaseKey(nodes[5], 20); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if actualValue := heap.DecreaseKey(nodes[0], 1); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+
+	// the node is cut with its subtree and linked with the root
+	if actualValue := heap.DecreaseKey(nodes[9], 12); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := nodes[9].Value(); actualValue != 12 {
+		t.Errorf("Got %v expected %v", actualValue, 12)
+	}
+	if actualValue := heap.root.child; actualValue != nodes[9] {
+		t.Errorf("Got %v expected %v", actualValue.value, nodes[9].value)
+	}
+	assertValidPairingHeap(t, heap)
+	if actualValue := heap.DecreaseKey(nodes[7], 5); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := heap.PeekNode(); actualValue != nodes[7] {
+		t.Errorf("Got %v expected %v", actualValue, nodes[7])
+	}
+	if actualValue, expectedValue := children(heap.root)[0], 11; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	assertValidPairingHeap(t, heap)
+
+	var values []int
+	for !heap.Empty() {
+		value, _ := heap.Pop()
+		values = append(values, value)
+	}
+	if actualValue, expectedValue := values, []int{5, 11, 12, 12, 13, 14, 15, 16, 18}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue := heap.Contains(nodes[9]); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+}
+
+func TestPairingHeapDelete(t *testing.T) {
+	heap := New[int]()
+	nodes := make([]*Node[int], 0, 10)
+	for i := 0; i < 10; i++ {
+		nodes = append(nodes, heap.Insert(i))
+	}
+	heap.Pop() // restructure into a tree
+
+	for _, i := range []int{5, 1, 9} {
+		if actualValue := heap.Delete(nodes[i]); actualValue != true {
+			t.Errorf("Got %v expected %v", actualValue, true)
+		}
+		assertValidPairingHeap(t, heap)
+	}
+	if actualValue := heap.Delete(nodes[5]); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if actualValue := heap.Delete(nil); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if actualValue := heap.Size(); actualValue != 6 {
+		t.Errorf("Got %v expected %v", actualValue, 6)
+	}
+
+	var values []int
+	for !heap.Empty() {
+		value, _ := heap.Pop()
+		values = append(values, value)
+	}
+	if actualValue, expectedValue := values, []int{2, 3, 4, 6, 7, 8}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestPairingHeapMeld(t *testing.T) {
+	heap := New[int]()
+	other := New[int]()
+	heap.Push(5, 1, 3)
+	node := other.Insert(6)
+	other.Push(4, 2)
+
+	// the root with the greater value becomes the leftmost child of the other root
+	heap.Meld(other)
+	heap.Meld(heap)
+	heap.Meld(New[int]())
+	if actualValue, expectedValue := children(heap.root), []int{2, 3, 5}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	assertValidPairingHeap(t, heap)
+
+	if actualValue := other.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := heap.Size(); actualValue != 6 {
+		t.Errorf("Got %v expected %v", actualValue, 6)
+	}
+	if actualValue := heap.Contains(node); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := other.Contains(node); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if actualValue := other.DecreaseKey(node, 0); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if actualValue := heap.DecreaseKey(node, 0); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	for _, expectedValue := range []int{0, 1, 2, 3, 4, 5} {
+		if actualValue, ok := heap.Pop(); actualValue != expectedValue || !ok {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+
+	// melded heap can be reused
+	other.Push(7)
+	heap.Meld(other)
+	if actualValue, ok := heap.Pop(); actualValue != 7 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 7)
+	}
+}
+
+func TestPairingHeapClear(t *testing.T) {
+	heap := New[int]()
+	node := heap.Insert(1)
+	heap.Clear()
+
+	if actualValue := heap.Contains(node); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if actualValue := heap.DecreaseKey(node, 0); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+}
+
+func TestPairingHeapIterator(t *testing.T) {
+	heap := New[int]()
+	heap.Push(3, 1, 4, 2)
+
+	var values []int
+	for it := heap.Iterator(); it.Next(); {
+		if actualValue, expectedValue := it.Index(), len(values); actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		values = append(values, it.Value())
+	}
+	if actualValue, expectedValue := values, heap.Values(); !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	it := heap.Iterator()
+	if actualValue := it.Last(); actualValue != true || it.Index() != 3 {
+		t.Errorf("Got %v, %v expected %v, %v", actualValue, it.Index(), true, 3)
+	}
+	if actualValue := it.PrevTo(func(index int, value int) bool { return value == 1 }); actualValue != true || it.Index() != 0 {
+		t.Errorf("Got %v, %v expected %v, %v", actualValue, it.Index(), true, 0)
+	}
+	if actualValue := it.Prev(); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	for it := New[int]().Iterator(); it.Next(); {
+		t.Errorf("Shouldn't iterate on empty heap")
+	}
+}
+
+func TestPairingHeapIteratorFailFast(t *testing.T) {
+	heap := New[int]()
+	var node *Node[int]
+	for _, modify := range []func(){
+		func() { heap.Push(4) },
+		func() { heap.Pop() },
+		func() { heap.DecreaseKey(node, 1) },
+		func() {
+			other := New[int]()
+			other.Push(5)
+			heap.Meld(other)
+		},
+		func() { heap.Clear() },
+	} {
+		heap.Clear()
+		node = heap.Insert(2)
+		heap.Push(3)
+		it := heap.Iterator()
+		it.Next()
+		modify()
+		testutils.ConcurrentModificationPanic(t, func() { it.Next() })
+	}
+
+	// melding an empty heap and failed handle operations do not modify the heap
+	heap.Push(3, 4)
+	it := heap.Iterator()
+	it.Next()
+	heap.Meld(New[int]())
+	heap.DecreaseKey(heap.PeekNode(), 10)
+	heap.Delete(nil)
+	it.Next() // no panic
+
+	heap.SetFailFast(false)
+	it.First()
+	heap.Push(1)
+	it.Next() // no panic
+}
+
+func TestPairingHeapRandom(t *testing.T) {
+	heap := New[int]()
+	nodes := map[*Node[int]]struct{}{}
+
+	random := rand.New(rand.NewSource(3))
+	for i := 0; i < 10000; i++ {
+		switch r := random.Intn(10); {
+		case r < 5 || heap.Empty():
+			nodes[heap.Insert(random.Intn(1000))] = struct{}{}
+		case r < 7:
+			for node := range nodes {
+				heap.DecreaseKey(node, node.Value()-random.Intn(100))
+				break
+			}
+		case r < 8:
+			for node := range nodes {
+				heap.Delete(node)
+				delete(nodes, node)
+				break
+			}
+		default:
+			node := heap.PeekNode()
+			value, _ := heap.Pop()
+			if value != node.Value() {
+				t.Fatalf("Got %v expected %v", value, node.Value())
+			}
+			delete(nodes, node)
+		}
+		if i%100 == 0 {
+			assertValidPairingHeap(t, heap)
+		}
+		if actualValue, expectedValue := heap.Size(), len(nodes); actualValue != expectedValue {
+			t.Fatalf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+}
+
+func TestPairingHeapString(t *testing.T) {
+	c := New[int]()
+	c.Push(1)
+	if !strings.HasPrefix(c.String(), "PairingHeap") {
+		t.Errorf("String should start with container name")
+	}
+}
+
+func benchmarkPushPop(b *testing.B, heap *Heap[int], size int) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			heap.Push(n)
+		}
+		for n := 0; n < size; n++ {
+			heap.Pop()
+		}
+	}
+}
+
+func BenchmarkPairingHeapPushPop1000(b *testing.B) {
+	benchmarkPushPop(b, New[int](), 1000)
+}
+
+func BenchmarkPairingHeapPushPop100000(b *testing.B) {
+	benchmarkPushPop(b, New[int](), 100000)
+}
@@ -21,6 +21,7 @@ import (
 
 // Assert Tree implementation
 var _ trees.Tree[int] = (*Tree[string, int])(nil)
+var _ trees.OrderedTree[string, int] = (*Tree[string, int])(nil)
 
 type color bool
 
@@ -30,9 +31,11 @@ const (
 
 // Tree holds elements of the red-black tree
 type Tree[K comparable, V any] struct {
-	Root       *Node[K, V]
-	size       int
-	Comparator utils.Comparator[K]
+	Root             *Node[K, V]
+	size             int
+	Comparator       utils.Comparator[K]
+	modCount         int  // number of structural modifications, checked by iterators
+	failFastDisabled bool // iterators do not check for modifications
 }
 
 // Node is a single element within the tree
@@ -96,6 +99,7 @@ func (tree *Tree[K, V]) Put(key K, value V) {
 	}
 	tree.insertCase1(insertedNode)
 	tree.size++
+	tree.modCount++
 }
 
 // Get searches the node in the tree by key and returns its value or nil if key is not found in tree.
@@ -145,6 +149,7 @@ func (tree *Tree[K, V]) Remove(key K) {
 		}
 	}
 	tree.size--
+	tree.modCount++
 }
 
 // Empty returns true if tree does not contain any nodes
@@ -273,10 +278,117 @@ func (tree *Tree[K, V]) Ceiling(key K) (ceiling *Node[K, V], found bool) {
 	return nil, false
 }
 
+// Lower finds the lower node of the input key, return the lower node or nil if no lower is found.
+// Second return parameter is true if lower was found, otherwise false.
+//
+// Lower node is defined as the largest node that is strictly smaller than the given key.
+//
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (tree *Tree[K, V]) Lower(key K) (lower *Node[K, V], found bool) {
+	for node := tree.Root; node != nil; {
+		if tree.Comparator(key, node.Key) > 0 {
+			lower = node
+			node = node.Right
+		} else {
+			node = node.Left
+		}
+	}
+	return lower, lower != nil
+}
+
+// Higher finds the higher node of the input key, return the higher node or nil if no higher is found.
+// Second return parameter is true if higher was found, otherwise false.
+//
+// Higher node is defined as the smallest node that is strictly larger than the given key.
+//
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (tree *Tree[K, V]) Higher(key K) (higher *Node[K, V], found bool) {
+	for node := tree.Root; node != nil; {
+		if tree.Comparator(key, node.Key) < 0 {
+			higher = node
+			node = node.Left
+		} else {
+			node = node.Right
+		}
+	}
+	return higher, higher != nil
+}
+
+// LeftEntry returns the minimum key and its value.
+// Third return parameter is false if the tree is empty.
+func (tree *Tree[K, V]) LeftEntry() (key K, value V, found bool) {
+	if node := tree.Left(); node != nil {
+		return node.Key, node.Value, true
+	}
+	return key, value, false
+}
+
+// RightEntry returns the maximum key and its value.
+// Third return parameter is false if the tree is empty.
+func (tree *Tree[K, V]) RightEntry() (key K, value V, found bool) {
+	if node := tree.Right(); node != nil {
+		return node.Key, node.Value, true
+	}
+	return key, value, false
+}
+
+// FloorEntry returns the floor key and its value, see Floor.
+// Third return parameter is false if no floor is found.
+func (tree *Tree[K, V]) FloorEntry(key K) (floorKey K, floorValue V, found bool) {
+	if node, found := tree.Floor(key); found {
+		return node.Key, node.Value, true
+	}
+	return floorKey, floorValue, false
+}
+
+// CeilingEntry returns the ceiling key and its value, see Ceiling.
+// Third return parameter is false if no ceiling is found.
+func (tree *Tree[K, V]) CeilingEntry(key K) (ceilingKey K, ceilingValue V, found bool) {
+	if node, found := tree.Ceiling(key); found {
+		return node.Key, node.Value, true
+	}
+	return ceilingKey, ceilingValue, false
+}
+
+// LowerEntry returns the lower key and its value, see Lower.
+// Third return parameter is false if no lower is found.
+func (tree *Tree[K, V]) LowerEntry(key K) (lowerKey K, lowerValue V, found bool) {
+	if node, found := tree.Lower(key); found {
+		return node.Key, node.Value, true
+	}
+	return lowerKey, lowerValue, false
+}
+
+// HigherEntry returns the higher key and its value, see Higher.
+// Third return parameter is false if no higher is found.
+func (tree *Tree[K, V]) HigherEntry(key K) (higherKey K, higherValue V, found bool) {
+	if node, found := tree.Higher(key); found {
+		return node.Key, node.Value, true
+	}
+	return higherKey, higherValue, false
+}
+
+// KeyComparator returns the comparator that orders the keys.
+func (tree *Tree[K, V]) KeyComparator() utils.Comparator[K] {
+	return tree.Comparator
+}
+
+// NewEmpty returns a new empty tree with the same comparator.
+func (tree *Tree[K, V]) NewEmpty() trees.OrderedTree[K, V] {
+	return NewWith[K, V](tree.Comparator)
+}
+
 // Clear removes all nodes from the tree.
 func (tree *Tree[K, V]) Clear() {
 	tree.Root = nil
 	tree.size = 0
+	tree.modCount++
+}
+
+// SetFailFast enables or disables the checks of iterators for modifications of the tree done while iterating,
+// see containers.ConcurrentModificationError. Checks are enabled by default.
+func (tree *Tree[K, V]) SetFailFast(enabled bool) {
+	tree.failFastDisabled = !enabled
 }
 
 // String returns a string representation of container
@@ -10,6 +10,8 @@ import (
 	"slices"
 	"strings"
 	"testing"
+
+	"github.com/emirpasic/gods/v2/testutils"
 )
 
 func TestRedBlackTreeGet(t *testing.T) {
@@ -230,6 +232,47 @@ func TestRedBlackTreeCeilingAndFloor(t *testing.T) {
 	}
 }
 
+func TestRedBlackTreeLowerAndHigher(t *testing.T) {
+	tree := New[int, int]()
+	if _, found := tree.Lower(10); found {
+		t.Errorf("Got %v expected %v", found, false)
+	}
+	if _, found := tree.Higher(10); found {
+		t.Errorf("Got %v expected %v", found, false)
+	}
+
+	for i := 2; i <= 40; i += 2 {
+		tree.Put(i, i*10)
+	}
+	for key := 0; key <= 42; key++ {
+		expectedLower, expectedHigher := -1, -1
+		for i := 2; i <= 40; i += 2 {
+			if i < key {
+				expectedLower = i
+			}
+			if i > key && expectedHigher == -1 {
+				expectedHigher = i
+			}
+		}
+
+		lowerKey, lowerValue, found := tree.LowerEntry(key)
+		if actualValue, expectedValue := found, expectedLower != -1; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v for lower of %v", actualValue, expectedValue, key)
+		}
+		if found && (lowerKey != expectedLower || lowerValue != expectedLower*10) {
+			t.Errorf("Got %v expected %v for lower of %v", lowerKey, expectedLower, key)
+		}
+
+		higherKey, higherValue, found := tree.HigherEntry(key)
+		if actualValue, expectedValue := found, expectedHigher != -1; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v for higher of %v", actualValue, expectedValue, key)
+		}
+		if found && (higherKey != expectedHigher || higherValue != expectedHigher*10) {
+			t.Errorf("Got %v expected %v for higher of %v", higherKey, expectedHigher, key)
+		}
+	}
+}
+
 func TestRedBlackTreeIteratorNextOnEmpty(t *testing.T) {
 	tree := New[int, string]()
 	it := tree.Iterator()
@@ -653,6 +696,53 @@ func TestRedBlackTreeIteratorPrevTo(t *testing.T) {
 	}
 }
 
+func TestRedBlackTreeIteratorFailFast(t *testing.T) {
+	tree := New[int, string]()
+	tree.Put(1, "a")
+	tree.Put(2, "b")
+	tree.Put(3, "c")
+	it := tree.Iterator()
+	it.Next()
+	tree.Put(4, "d")
+	testutils.ConcurrentModificationPanic(t, func() { it.Next() })
+	testutils.ConcurrentModificationPanic(t, func() { it.Prev() })
+
+	// reset iterator is valid again
+	count := 0
+	for it.Begin(); it.Next(); {
+		count++
+	}
+	if actualValue, expectedValue := count, 4; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// replacing a value is not a structural modification
+	it.First()
+	tree.Put(1, "z")
+	tree.Get(3)
+	if actualValue := it.Next(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+
+	for _, modify := range []func(){
+		func() { tree.Remove(2) },
+		func() { tree.Put(5, "e") },
+		func() { tree.Clear() },
+	} {
+		tree.Put(1, "a")
+		tree.Put(2, "b")
+		it.First()
+		modify()
+		testutils.ConcurrentModificationPanic(t, func() { it.Next() })
+	}
+
+	tree.SetFailFast(false)
+	tree.Put(1, "a")
+	it.First()
+	tree.Put(6, "f")
+	it.Next() // no panic
+}
+
 func TestRedBlackTreeSerialization(t *testing.T) {
 	tree := New[string, string]()
 	tree.Put("c", "3")
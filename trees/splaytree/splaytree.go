@@ -0,0 +1,545 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package splaytree implements a splay tree, a self-adjusting binary search tree.
+//
+// Every access by key (Put, Get, GetNode, Remove, Floor, Ceiling) moves the accessed node to the root through
+// a series of rotations, so recently accessed keys are quick to access again. All operations run in O(log n)
+// amortized time, and skewed access patterns run considerably faster than that.
+//
+// Since lookups restructure the tree, reading from it is a modification as well. Splaying keeps the in-order
+// sequence of nodes intact, so existing iterators and nodes stay valid across lookups.
+//
+// Structure is not thread safe.
+//
+// References: https://en.wikipedia.org/wiki/Splay_tree
+package splaytree
+
+import (
+	"cmp"
+	"fmt"
+
+	"github.com/emirpasic/gods/v2/trees"
+	"github.com/emirpasic/gods/v2/utils"
+)
+
+// Assert Tree implementation
+var _ trees.Tree[int] = (*Tree[string, int])(nil)
+var _ trees.OrderedTree[string, int] = (*Tree[string, int])(nil)
+
+// Tree holds elements of the splay tree.
+type Tree[K comparable, V any] struct {
+	Root             *Node[K, V]         // Root node
+	Comparator       utils.Comparator[K] // Key comparator
+	size             int                 // Total number of keys in the tree
+	modCount         int                 // number of structural modifications, checked by iterators
+	failFastDisabled bool                // iterators do not check for modifications
+}
+
+// Node is a single element within the tree
+type Node[K comparable, V any] struct {
+	Key      K
+	Value    V
+	Parent   *Node[K, V]    // Parent node
+	Children [2]*Node[K, V] // Children nodes
+}
+
+// New instantiates a splay tree with the built-in comparator for K
+func New[K cmp.Ordered, V any]() *Tree[K, V] {
+	return &Tree[K, V]{Comparator: cmp.Compare[K]}
+}
+
+// NewWith instantiates a splay tree with the custom comparator.
+func NewWith[K comparable, V any](comparator utils.Comparator[K]) *Tree[K, V] {
+	return &Tree[K, V]{Comparator: comparator}
+}
+
+// Put inserts node into the tree and splays it to the root.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (tree *Tree[K, V]) Put(key K, value V) {
+	n, c := tree.lookup(key)
+	if n != nil && c == 0 {
+		n.Key = key
+		n.Value = value
+		tree.splay(n)
+		return
+	}
+	node := &Node[K, V]{Key: key, Value: value, Parent: n}
+	if n == nil {
+		tree.Root = node
+	} else {
+		n.Children[direction(c)] = node
+	}
+	tree.size++
+	tree.modCount++
+	tree.splay(node)
+}
+
+// Get searches the node in the tree by key and returns its value or nil if key is not found in tree.
+// Second return parameter is true if key was found, otherwise false.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (tree *Tree[K, V]) Get(key K) (value V, found bool) {
+	n := tree.GetNode(key)
+	if n != nil {
+		return n.Value, true
+	}
+	return value, false
+}
+
+// GetNode searches the node in the tree by key and returns its node or nil if key is not found in tree.
+// The found node (or the last visited one if key is not found) is splayed to the root.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (tree *Tree[K, V]) GetNode(key K) *Node[K, V] {
+	n, c := tree.lookup(key)
+	if n == nil {
+		return nil
+	}
+	tree.splay(n)
+	if c != 0 {
+		return nil
+	}
+	return n
+}
+
+// Remove remove the node from the tree by key.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (tree *Tree[K, V]) Remove(key K) {
+	n := tree.GetNode(key)
+	if n == nil {
+		return
+	}
+	// n is the root now, join its subtrees
+	left, right := n.Children[0], n.Children[1]
+	tree.size--
+	tree.modCount++
+	if left == nil {
+		tree.Root = right
+		setParent(right, nil)
+		return
+	}
+	left.Parent = nil
+	tree.Root = left
+	largest := left
+	for largest.Children[1] != nil {
+		largest = largest.Children[1]
+	}
+	tree.splay(largest)
+	largest.Children[1] = right
+	setParent(right, largest)
+}
+
+// Empty returns true if tree does not contain any nodes.
+func (tree *Tree[K, V]) Empty() bool {
+	return tree.size == 0
+}
+
+// Size returns the number of elements stored in the tree.
+func (tree *Tree[K, V]) Size() int {
+	return tree.size
+}
+
+// Size returns the number of elements stored in the subtree.
+// Computed dynamically on each call, i.e. the subtree is traversed to count the number of the nodes.
+func (n *Node[K, V]) Size() int {
+	if n == nil {
+		return 0
+	}
+	size := 1
+	if n.Children[0] != nil {
+		size += n.Children[0].Size()
+	}
+	if n.Children[1] != nil {
+		size += n.Children[1].Size()
+	}
+	return size
+}
+
+// Keys returns all keys in-order
+func (tree *Tree[K, V]) Keys() []K {
+	keys := make([]K, tree.size)
+	it := tree.Iterator()
+	for i := 0; it.Next(); i++ {
+		keys[i] = it.Key()
+	}
+	return keys
+}
+
+// Values returns all values in-order based on the key.
+func (tree *Tree[K, V]) Values() []V {
+	values := make([]V, tree.size)
+	it := tree.Iterator()
+	for i := 0; it.Next(); i++ {
+		values[i] = it.Value()
+	}
+	return values
+}
+
+// Left returns the minimum element of the splay tree
+// or nil if the tree is empty. Does not splay.
+func (tree *Tree[K, V]) Left() *Node[K, V] {
+	return tree.bottom(0)
+}
+
+// Right returns the maximum element of the splay tree
+// or nil if the tree is empty. Does not splay.
+func (tree *Tree[K, V]) Right() *Node[K, V] {
+	return tree.bottom(1)
+}
+
+// Floor Finds floor node of the input key, return the floor node or nil if no floor is found.
+// Second return parameter is true if floor was found, otherwise false.
+//
+// Floor node is defined as the largest node that is smaller than or equal to the given node.
+// A floor node may not be found, either because the tree is empty, or because
+// all nodes in the tree is larger than the given node.
+//
+// The floor node (or the last visited one if floor is not found) is splayed to the root.
+//
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (tree *Tree[K, V]) Floor(key K) (floor *Node[K, V], found bool) {
+	var last *Node[K, V]
+	n := tree.Root
+	for n != nil {
+		last = n
+		c := tree.Comparator(key, n.Key)
+		switch {
+		case c == 0:
+			tree.splay(n)
+			return n, true
+		case c < 0:
+			n = n.Children[0]
+		case c > 0:
+			floor, found = n, true
+			n = n.Children[1]
+		}
+	}
+	if found {
+		tree.splay(floor)
+		return floor, true
+	}
+	if last != nil {
+		tree.splay(last)
+	}
+	return nil, false
+}
+
+// Ceiling finds ceiling node of the input key, return the ceiling node or nil if no ceiling is found.
+// Second return parameter is true if ceiling was found, otherwise false.
+//
+// Ceiling node is defined as the smallest node that is larger than or equal to the given node.
+// A ceiling node may not be found, either because the tree is empty, or because
+// all nodes in the tree is smaller than the given node.
+//
+// The ceiling node (or the last visited one if ceiling is not found) is splayed to the root.
+//
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (tree *Tree[K, V]) Ceiling(key K) (ceiling *Node[K, V], found bool) {
+	var last *Node[K, V]
+	n := tree.Root
+	for n != nil {
+		last = n
+		c := tree.Comparator(key, n.Key)
+		switch {
+		case c == 0:
+			tree.splay(n)
+			return n, true
+		case c < 0:
+			ceiling, found = n, true
+			n = n.Children[0]
+		case c > 0:
+			n = n.Children[1]
+		}
+	}
+	if found {
+		tree.splay(ceiling)
+		return ceiling, true
+	}
+	if last != nil {
+		tree.splay(last)
+	}
+	return nil, false
+}
+
+// Lower finds the lower node of the input key, return the lower node or nil if no lower is found.
+// Second return parameter is true if lower was found, otherwise false.
+//
+// Lower node is defined as the largest node that is strictly smaller than the given key.
+//
+// The lower node (or the last visited one if lower is not found) is splayed to the root.
+//
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (tree *Tree[K, V]) Lower(key K) (lower *Node[K, V], found bool) {
+	var last *Node[K, V]
+	for node := tree.Root; node != nil; {
+		last = node
+		if tree.Comparator(key, node.Key) > 0 {
+			lower = node
+			node = node.Children[1]
+		} else {
+			node = node.Children[0]
+		}
+	}
+	if lower != nil {
+		tree.splay(lower)
+	} else if last != nil {
+		tree.splay(last)
+	}
+	return lower, lower != nil
+}
+
+// Higher finds the higher node of the input key, return the higher node or nil if no higher is found.
+// Second return parameter is true if higher was found, otherwise false.
+//
+// Higher node is defined as the smallest node that is strictly larger than the given key.
+//
+// The higher node (or the last visited one if higher is not found) is splayed to the root.
+//
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (tree *Tree[K, V]) Higher(key K) (higher *Node[K, V], found bool) {
+	var last *Node[K, V]
+	for node := tree.Root; node != nil; {
+		last = node
+		if tree.Comparator(key, node.Key) < 0 {
+			higher = node
+			node = node.Children[0]
+		} else {
+			node = node.Children[1]
+		}
+	}
+	if higher != nil {
+		tree.splay(higher)
+	} else if last != nil {
+		tree.splay(last)
+	}
+	return higher, higher != nil
+}
+
+// LeftEntry returns the minimum key and its value.
+// Third return parameter is false if the tree is empty.
+func (tree *Tree[K, V]) LeftEntry() (key K, value V, found bool) {
+	if node := tree.Left(); node != nil {
+		return node.Key, node.Value, true
+	}
+	return key, value, false
+}
+
+// RightEntry returns the maximum key and its value.
+// Third return parameter is false if the tree is empty.
+func (tree *Tree[K, V]) RightEntry() (key K, value V, found bool) {
+	if node := tree.Right(); node != nil {
+		return node.Key, node.Value, true
+	}
+	return key, value, false
+}
+
+// FloorEntry returns the floor key and its value, see Floor.
+// Third return parameter is false if no floor is found.
+func (tree *Tree[K, V]) FloorEntry(key K) (floorKey K, floorValue V, found bool) {
+	if node, found := tree.Floor(key); found {
+		return node.Key, node.Value, true
+	}
+	return floorKey, floorValue, false
+}
+
+// CeilingEntry returns the ceiling key and its value, see Ceiling.
+// Third return parameter is false if no ceiling is found.
+func (tree *Tree[K, V]) CeilingEntry(key K) (ceilingKey K, ceilingValue V, found bool) {
+	if node, found := tree.Ceiling(key); found {
+		return node.Key, node.Value, true
+	}
+	return ceilingKey, ceilingValue, false
+}
+
+// LowerEntry returns the lower key and its value, see Lower.
+// Third return parameter is false if no lower is found.
+func (tree *Tree[K, V]) LowerEntry(key K) (lowerKey K, lowerValue V, found bool) {
+	if node, found := tree.Lower(key); found {
+		return node.Key, node.Value, true
+	}
+	return lowerKey, lowerValue, false
+}
+
+// HigherEntry returns the higher key and its value, see Higher.
+// Third return parameter is false if no higher is found.
+func (tree *Tree[K, V]) HigherEntry(key K) (higherKey K, higherValue V, found bool) {
+	if node, found := tree.Higher(key); found {
+		return node.Key, node.Value, true
+	}
+	return higherKey, higherValue, false
+}
+
+// KeyComparator returns the comparator that orders the keys.
+func (tree *Tree[K, V]) KeyComparator() utils.Comparator[K] {
+	return tree.Comparator
+}
+
+// NewEmpty returns a new empty tree with the same comparator.
+func (tree *Tree[K, V]) NewEmpty() trees.OrderedTree[K, V] {
+	return NewWith[K, V](tree.Comparator)
+}
+
+// Clear removes all nodes from the tree.
+func (tree *Tree[K, V]) Clear() {
+	tree.Root = nil
+	tree.size = 0
+	tree.modCount++
+}
+
+// SetFailFast enables or disables the checks of iterators for modifications of the tree done while iterating,
+// see containers.ConcurrentModificationError. Checks are enabled by default.
+func (tree *Tree[K, V]) SetFailFast(enabled bool) {
+	tree.failFastDisabled = !enabled
+}
+
+// String returns a string representation of container
+func (tree *Tree[K, V]) String() string {
+	str := "SplayTree\n"
+	if !tree.Empty() {
+		output(tree.Root, "", true, &str)
+	}
+	return str
+}
+
+func (n *Node[K, V]) String() string {
+	return fmt.Sprintf("%v", n.Key)
+}
+
+// lookup returns the node with the key (c == 0) or the last visited node and the direction to go from it.
+func (tree *Tree[K, V]) lookup(key K) (n *Node[K, V], c int) {
+	for next := tree.Root; next != nil; next = next.Children[direction(c)] {
+		n = next
+		c = tree.Comparator(key, n.Key)
+		if c == 0 {
+			return n, 0
+		}
+	}
+	return n, c
+}
+
+// splay moves the node to the root.
+func (tree *Tree[K, V]) splay(n *Node[K, V]) {
+	for n.Parent != nil {
+		p := n.Parent
+		g := p.Parent
+		switch {
+		case g == nil: // zig
+			tree.rotateUp(n)
+		case (g.Children[0] == p) == (p.Children[0] == n): // zig-zig
+			tree.rotateUp(p)
+			tree.rotateUp(n)
+		default: // zig-zag
+			tree.rotateUp(n)
+			tree.rotateUp(n)
+		}
+	}
+}
+
+// rotateUp rotates the node above its parent.
+func (tree *Tree[K, V]) rotateUp(n *Node[K, V]) {
+	p := n.Parent
+	a := 0
+	if p.Children[1] == n {
+		a = 1
+	}
+	p.Children[a] = n.Children[a^1]
+	setParent(p.Children[a], p)
+	g := p.Parent
+	n.Parent = g
+	switch {
+	case g == nil:
+		tree.Root = n
+	case g.Children[0] == p:
+		g.Children[0] = n
+	default:
+		g.Children[1] = n
+	}
+	n.Children[a^1] = p
+	p.Parent = n
+}
+
+func setParent[K comparable, V any](n *Node[K, V], parent *Node[K, V]) {
+	if n != nil {
+		n.Parent = parent
+	}
+}
+
+func direction(c int) int {
+	if c < 0 {
+		return 0
+	}
+	return 1
+}
+
+func (tree *Tree[K, V]) bottom(d int) *Node[K, V] {
+	n := tree.Root
+	if n == nil {
+		return nil
+	}
+
+	for c := n.Children[d]; c != nil; c = n.Children[d] {
+		n = c
+	}
+	return n
+}
+
+// Prev returns the previous element in an inorder
+// walk of the splay tree.
+func (n *Node[K, V]) Prev() *Node[K, V] {
+	return n.walk1(0)
+}
+
+// Next returns the next element in an inorder
+// walk of the splay tree.
+func (n *Node[K, V]) Next() *Node[K, V] {
+	return n.walk1(1)
+}
+
+func (n *Node[K, V]) walk1(a int) *Node[K, V] {
+	if n == nil {
+		return nil
+	}
+
+	if n.Children[a] != nil {
+		n = n.Children[a]
+		for n.Children[a^1] != nil {
+			n = n.Children[a^1]
+		}
+		return n
+	}
+
+	p := n.Parent
+	for p != nil && p.Children[a] == n {
+		n = p
+		p = p.Parent
+	}
+	return p
+}
+
+func output[K comparable, V any](node *Node[K, V], prefix string, isTail bool, str *string) {
+	if node.Children[1] != nil {
+		newPrefix := prefix
+		if isTail {
+			newPrefix += "│   "
+		} else {
+			newPrefix += "    "
+		}
+		output(node.Children[1], newPrefix, false, str)
+	}
+	*str += prefix
+	if isTail {
+		*str += "└── "
+	} else {
+		*str += "┌── "
+	}
+	*str += node.String() + "\n"
+	if node.Children[0] != nil {
+		newPrefix := prefix
+		if isTail {
+			newPrefix += "    "
+		} else {
+			newPrefix += "│   "
+		}
+		output(node.Children[0], newPrefix, true, str)
+	}
+}
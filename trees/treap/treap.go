@@ -0,0 +1,553 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package treap implements a treap, a randomized binary search tree.
+//
+// Every node is assigned a random priority and the tree is kept in heap order with respect to the priorities,
+// which keeps the tree balanced with high probability, i.e. all operations are O(log n) expected.
+// Nodes also track the size of their subtrees, so the tree can be split by key and two trees can be merged
+// in O(log n) expected time.
+//
+// Structure is not thread safe.
+//
+// References: https://en.wikipedia.org/wiki/Treap
+package treap
+
+import (
+	"cmp"
+	"fmt"
+	"math/rand"
+
+	"github.com/emirpasic/gods/v2/trees"
+	"github.com/emirpasic/gods/v2/utils"
+)
+
+// Assert Tree implementation
+var _ trees.Tree[int] = (*Tree[string, int])(nil)
+var _ trees.OrderedTree[string, int] = (*Tree[string, int])(nil)
+
+// Tree holds elements of the treap.
+type Tree[K comparable, V any] struct {
+	Root             *Node[K, V]         // Root node
+	Comparator       utils.Comparator[K] // Key comparator
+	modCount         int                 // number of structural modifications, checked by iterators
+	failFastDisabled bool                // iterators do not check for modifications
+}
+
+// Node is a single element within the tree
+type Node[K comparable, V any] struct {
+	Key      K
+	Value    V
+	Parent   *Node[K, V]    // Parent node
+	Children [2]*Node[K, V] // Children nodes
+	priority uint64
+	size     int // number of nodes in the subtree
+}
+
+// New instantiates a treap with the built-in comparator for K
+func New[K cmp.Ordered, V any]() *Tree[K, V] {
+	return &Tree[K, V]{Comparator: cmp.Compare[K]}
+}
+
+// NewWith instantiates a treap with the custom comparator.
+func NewWith[K comparable, V any](comparator utils.Comparator[K]) *Tree[K, V] {
+	return &Tree[K, V]{Comparator: comparator}
+}
+
+// Put inserts node into the tree.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (tree *Tree[K, V]) Put(key K, value V) {
+	var parent *Node[K, V]
+	var a int
+	for n := tree.Root; n != nil; n = n.Children[a] {
+		c := tree.Comparator(key, n.Key)
+		if c == 0 {
+			n.Key = key
+			n.Value = value
+			return
+		}
+		parent = n
+		a = direction(c)
+	}
+
+	n := &Node[K, V]{Key: key, Value: value, Parent: parent, priority: rand.Uint64(), size: 1}
+	tree.modCount++
+	if parent == nil {
+		tree.Root = n
+		return
+	}
+	parent.Children[a] = n
+	for p := parent; p != nil; p = p.Parent {
+		p.size++
+	}
+	// restore heap order
+	for n.Parent != nil && n.Parent.priority < n.priority {
+		tree.rotateUp(n)
+	}
+}
+
+// Get searches the node in the tree by key and returns its value or nil if key is not found in tree.
+// Second return parameter is true if key was found, otherwise false.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (tree *Tree[K, V]) Get(key K) (value V, found bool) {
+	n := tree.GetNode(key)
+	if n != nil {
+		return n.Value, true
+	}
+	return value, false
+}
+
+// GetNode searches the node in the tree by key and returns its node or nil if key is not found in tree.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (tree *Tree[K, V]) GetNode(key K) *Node[K, V] {
+	n := tree.Root
+	for n != nil {
+		c := tree.Comparator(key, n.Key)
+		if c == 0 {
+			return n
+		}
+		n = n.Children[direction(c)]
+	}
+	return nil
+}
+
+// Remove remove the node from the tree by key.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (tree *Tree[K, V]) Remove(key K) {
+	n := tree.GetNode(key)
+	if n == nil {
+		return
+	}
+	// rotate the node down until it has at most one child
+	for n.Children[0] != nil && n.Children[1] != nil {
+		if n.Children[0].priority > n.Children[1].priority {
+			tree.rotateUp(n.Children[0])
+		} else {
+			tree.rotateUp(n.Children[1])
+		}
+	}
+	child := n.Children[0]
+	if child == nil {
+		child = n.Children[1]
+	}
+	tree.replace(n, child)
+	tree.modCount++
+	for p := n.Parent; p != nil; p = p.Parent {
+		p.size--
+	}
+}
+
+// Empty returns true if tree does not contain any nodes.
+func (tree *Tree[K, V]) Empty() bool {
+	return tree.Root == nil
+}
+
+// Size returns the number of elements stored in the tree.
+func (tree *Tree[K, V]) Size() int {
+	return tree.Root.Size()
+}
+
+// Size returns the number of elements stored in the subtree.
+func (n *Node[K, V]) Size() int {
+	if n == nil {
+		return 0
+	}
+	return n.size
+}
+
+// Keys returns all keys in-order
+func (tree *Tree[K, V]) Keys() []K {
+	keys := make([]K, tree.Size())
+	it := tree.Iterator()
+	for i := 0; it.Next(); i++ {
+		keys[i] = it.Key()
+	}
+	return keys
+}
+
+// Values returns all values in-order based on the key.
+func (tree *Tree[K, V]) Values() []V {
+	values := make([]V, tree.Size())
+	it := tree.Iterator()
+	for i := 0; it.Next(); i++ {
+		values[i] = it.Value()
+	}
+	return values
+}
+
+// Left returns the minimum element of the treap
+// or nil if the tree is empty.
+func (tree *Tree[K, V]) Left() *Node[K, V] {
+	return tree.bottom(0)
+}
+
+// Right returns the maximum element of the treap
+// or nil if the tree is empty.
+func (tree *Tree[K, V]) Right() *Node[K, V] {
+	return tree.bottom(1)
+}
+
+// Floor Finds floor node of the input key, return the floor node or nil if no floor is found.
+// Second return parameter is true if floor was found, otherwise false.
+//
+// Floor node is defined as the largest node that is smaller than or equal to the given node.
+// A floor node may not be found, either because the tree is empty, or because
+// all nodes in the tree is larger than the given node.
+//
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (tree *Tree[K, V]) Floor(key K) (floor *Node[K, V], found bool) {
+	n := tree.Root
+	for n != nil {
+		c := tree.Comparator(key, n.Key)
+		switch {
+		case c == 0:
+			return n, true
+		case c < 0:
+			n = n.Children[0]
+		case c > 0:
+			floor, found = n, true
+			n = n.Children[1]
+		}
+	}
+	return floor, found
+}
+
+// Ceiling finds ceiling node of the input key, return the ceiling node or nil if no ceiling is found.
+// Second return parameter is true if ceiling was found, otherwise false.
+//
+// Ceiling node is defined as the smallest node that is larger than or equal to the given node.
+// A ceiling node may not be found, either because the tree is empty, or because
+// all nodes in the tree is smaller than the given node.
+//
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (tree *Tree[K, V]) Ceiling(key K) (ceiling *Node[K, V], found bool) {
+	n := tree.Root
+	for n != nil {
+		c := tree.Comparator(key, n.Key)
+		switch {
+		case c == 0:
+			return n, true
+		case c < 0:
+			ceiling, found = n, true
+			n = n.Children[0]
+		case c > 0:
+			n = n.Children[1]
+		}
+	}
+	return ceiling, found
+}
+
+// Split moves all elements with keys smaller than the given key into the first returned tree
+// and all others into the second one. The tree itself is left empty.
+// Both trees use the comparator and the fail-fast setting of the tree.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (tree *Tree[K, V]) Split(key K) (*Tree[K, V], *Tree[K, V]) {
+	left, right := tree.split(tree.Root, key)
+	setParent(left, nil)
+	setParent(right, nil)
+	tree.Root = nil
+	tree.modCount++
+	return &Tree[K, V]{Root: left, Comparator: tree.Comparator, failFastDisabled: tree.failFastDisabled},
+		&Tree[K, V]{Root: right, Comparator: tree.Comparator, failFastDisabled: tree.failFastDisabled}
+}
+
+// Merge moves all elements of the other tree into this tree, leaving the other tree empty.
+// All keys in the other tree must be larger than all keys in this tree, otherwise method panics.
+func (tree *Tree[K, V]) Merge(other *Tree[K, V]) {
+	if tree == other || other.Root == nil {
+		return
+	}
+	if tree.Root != nil && tree.Comparator(tree.Right().Key, other.Left().Key) >= 0 {
+		panic("Invalid merge, keys of the other tree should be larger than all keys of the tree")
+	}
+	tree.Root = merge(tree.Root, other.Root)
+	setParent(tree.Root, nil)
+	other.Root = nil
+	tree.modCount++
+	other.modCount++
+}
+
+// Lower finds the lower node of the input key, return the lower node or nil if no lower is found.
+// Second return parameter is true if lower was found, otherwise false.
+//
+// Lower node is defined as the largest node that is strictly smaller than the given key.
+//
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (tree *Tree[K, V]) Lower(key K) (lower *Node[K, V], found bool) {
+	for node := tree.Root; node != nil; {
+		if tree.Comparator(key, node.Key) > 0 {
+			lower = node
+			node = node.Children[1]
+		} else {
+			node = node.Children[0]
+		}
+	}
+	return lower, lower != nil
+}
+
+// Higher finds the higher node of the input key, return the higher node or nil if no higher is found.
+// Second return parameter is true if higher was found, otherwise false.
+//
+// Higher node is defined as the smallest node that is strictly larger than the given key.
+//
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (tree *Tree[K, V]) Higher(key K) (higher *Node[K, V], found bool) {
+	for node := tree.Root; node != nil; {
+		if tree.Comparator(key, node.Key) < 0 {
+			higher = node
+			node = node.Children[0]
+		} else {
+			node = node.Children[1]
+		}
+	}
+	return higher, higher != nil
+}
+
+// LeftEntry returns the minimum key and its value.
+// Third return parameter is false if the tree is empty.
+func (tree *Tree[K, V]) LeftEntry() (key K, value V, found bool) {
+	if node := tree.Left(); node != nil {
+		return node.Key, node.Value, true
+	}
+	return key, value, false
+}
+
+// RightEntry returns the maximum key and its value.
+// Third return parameter is false if the tree is empty.
+func (tree *Tree[K, V]) RightEntry() (key K, value V, found bool) {
+	if node := tree.Right(); node != nil {
+		return node.Key, node.Value, true
+	}
+	return key, value, false
+}
+
+// FloorEntry returns the floor key and its value, see Floor.
+// Third return parameter is false if no floor is found.
+func (tree *Tree[K, V]) FloorEntry(key K) (floorKey K, floorValue V, found bool) {
+	if node, found := tree.Floor(key); found {
+		return node.Key, node.Value, true
+	}
+	return floorKey, floorValue, false
+}
+
+// CeilingEntry returns the ceiling key and its value, see Ceiling.
+// Third return parameter is false if no ceiling is found.
+func (tree *Tree[K, V]) CeilingEntry(key K) (ceilingKey K, ceilingValue V, found bool) {
+	if node, found := tree.Ceiling(key); found {
+		return node.Key, node.Value, true
+	}
+	return ceilingKey, ceilingValue, false
+}
+
+// LowerEntry returns the lower key and its value, see Lower.
+// Third return parameter is false if no lower is found.
+func (tree *Tree[K, V]) LowerEntry(key K) (lowerKey K, lowerValue V, found bool) {
+	if node, found := tree.Lower(key); found {
+		return node.Key, node.Value, true
+	}
+	return lowerKey, lowerValue, false
+}
+
+// HigherEntry returns the higher key and its value, see Higher.
+// Third return parameter is false if no higher is found.
+func (tree *Tree[K, V]) HigherEntry(key K) (higherKey K, higherValue V, found bool) {
+	if node, found := tree.Higher(key); found {
+		return node.Key, node.Value, true
+	}
+	return higherKey, higherValue, false
+}
+
+// KeyComparator returns the comparator that orders the keys.
+func (tree *Tree[K, V]) KeyComparator() utils.Comparator[K] {
+	return tree.Comparator
+}
+
+// NewEmpty returns a new empty tree with the same comparator.
+func (tree *Tree[K, V]) NewEmpty() trees.OrderedTree[K, V] {
+	return NewWith[K, V](tree.Comparator)
+}
+
+// Clear removes all nodes from the tree.
+func (tree *Tree[K, V]) Clear() {
+	tree.Root = nil
+	tree.modCount++
+}
+
+// SetFailFast enables or disables the checks of iterators for modifications of the tree done while iterating,
+// see containers.ConcurrentModificationError. Checks are enabled by default.
+func (tree *Tree[K, V]) SetFailFast(enabled bool) {
+	tree.failFastDisabled = !enabled
+}
+
+// String returns a string representation of container
+func (tree *Tree[K, V]) String() string {
+	str := "Treap\n"
+	if !tree.Empty() {
+		output(tree.Root, "", true, &str)
+	}
+	return str
+}
+
+func (n *Node[K, V]) String() string {
+	return fmt.Sprintf("%v", n.Key)
+}
+
+// split splits the subtree into nodes with keys smaller than the key and the rest.
+func (tree *Tree[K, V]) split(n *Node[K, V], key K) (left, right *Node[K, V]) {
+	if n == nil {
+		return nil, nil
+	}
+	if tree.Comparator(n.Key, key) < 0 {
+		l, r := tree.split(n.Children[1], key)
+		n.Children[1] = l
+		setParent(l, n)
+		n.update()
+		return n, r
+	}
+	l, r := tree.split(n.Children[0], key)
+	n.Children[0] = r
+	setParent(r, n)
+	n.update()
+	return l, n
+}
+
+// merge joins two subtrees where all keys in the left subtree are smaller than those in the right subtree.
+func merge[K comparable, V any](left, right *Node[K, V]) *Node[K, V] {
+	if left == nil {
+		return right
+	}
+	if right == nil {
+		return left
+	}
+	if left.priority > right.priority {
+		left.Children[1] = merge(left.Children[1], right)
+		setParent(left.Children[1], left)
+		left.update()
+		return left
+	}
+	right.Children[0] = merge(left, right.Children[0])
+	setParent(right.Children[0], right)
+	right.update()
+	return right
+}
+
+func setParent[K comparable, V any](n *Node[K, V], parent *Node[K, V]) {
+	if n != nil {
+		n.Parent = parent
+	}
+}
+
+func (n *Node[K, V]) update() {
+	n.size = 1 + n.Children[0].Size() + n.Children[1].Size()
+}
+
+// rotateUp rotates the node above its parent.
+func (tree *Tree[K, V]) rotateUp(n *Node[K, V]) {
+	p := n.Parent
+	a := 0
+	if p.Children[1] == n {
+		a = 1
+	}
+	p.Children[a] = n.Children[a^1]
+	setParent(p.Children[a], p)
+	tree.replace(p, n)
+	n.Children[a^1] = p
+	p.Parent = n
+	p.update()
+	n.update()
+}
+
+// replace puts the replacement node in place of the node within the node's parent.
+func (tree *Tree[K, V]) replace(n *Node[K, V], replacement *Node[K, V]) {
+	p := n.Parent
+	setParent(replacement, p)
+	switch {
+	case p == nil:
+		tree.Root = replacement
+	case p.Children[0] == n:
+		p.Children[0] = replacement
+	default:
+		p.Children[1] = replacement
+	}
+}
+
+func direction(c int) int {
+	if c < 0 {
+		return 0
+	}
+	return 1
+}
+
+func (tree *Tree[K, V]) bottom(d int) *Node[K, V] {
+	n := tree.Root
+	if n == nil {
+		return nil
+	}
+
+	for c := n.Children[d]; c != nil; c = n.Children[d] {
+		n = c
+	}
+	return n
+}
+
+// Prev returns the previous element in an inorder
+// walk of the treap.
+func (n *Node[K, V]) Prev() *Node[K, V] {
+	return n.walk1(0)
+}
+
+// Next returns the next element in an inorder
+// walk of the treap.
+func (n *Node[K, V]) Next() *Node[K, V] {
+	return n.walk1(1)
+}
+
+func (n *Node[K, V]) walk1(a int) *Node[K, V] {
+	if n == nil {
+		return nil
+	}
+
+	if n.Children[a] != nil {
+		n = n.Children[a]
+		for n.Children[a^1] != nil {
+			n = n.Children[a^1]
+		}
+		return n
+	}
+
+	p := n.Parent
+	for p != nil && p.Children[a] == n {
+		n = p
+		p = p.Parent
+	}
+	return p
+}
+
+func output[K comparable, V any](node *Node[K, V], prefix string, isTail bool, str *string) {
+	if node.Children[1] != nil {
+		newPrefix := prefix
+		if isTail {
+			newPrefix += "│   "
+		} else {
+			newPrefix += "    "
+		}
+		output(node.Children[1], newPrefix, false, str)
+	}
+	*str += prefix
+	if isTail {
+		*str += "└── "
+	} else {
+		*str += "┌── "
+	}
+	*str += node.String() + "\n"
+	if node.Children[0] != nil {
+		newPrefix := prefix
+		if isTail {
+			newPrefix += "    "
+		} else {
+			newPrefix += "│   "
+		}
+		output(node.Children[0], newPrefix, true, str)
+	}
+}
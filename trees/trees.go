@@ -9,7 +9,10 @@
 // Reference: https://en.wikipedia.org/wiki/Tree_%28data_structure%29
 package trees
 
-import "github.com/emirpasic/gods/v2/containers"
+import (
+	"github.com/emirpasic/gods/v2/containers"
+	"github.com/emirpasic/gods/v2/utils"
+)
 
 // Tree interface that all trees implement
 type Tree[V any] interface {
@@ -20,3 +23,66 @@ type Tree[V any] interface {
 	// Values() []interface{}
 	// String() string
 }
+
+// Heap interface that all heaps keeping the least value with respect to their comparator on top implement
+// (extends the Tree interface).
+//
+// Priority queues can be backed by any heap implementing it, see priorityqueue.NewWithHeap.
+type Heap[V any] interface {
+	// Push adds values (one or more) onto the heap.
+	Push(values ...V)
+
+	// Pop removes the top value of the heap and returns it, ok is false if the heap is empty.
+	Pop() (value V, ok bool)
+
+	// Peek returns the top value of the heap without removing it, ok is false if the heap is empty.
+	Peek() (value V, ok bool)
+
+	containers.JSONSerializer
+	containers.JSONDeserializer
+
+	Tree[V]
+}
+
+// OrderedTree interface that all trees keeping their key/value pairs sorted by key implement (extends the Tree interface).
+//
+// Ordered containers, e.g. TreeMap and TreeSet, can be backed by any tree implementing it.
+type OrderedTree[K comparable, V any] interface {
+	Put(key K, value V)
+	Get(key K) (value V, found bool)
+	Remove(key K)
+	Keys() []K
+
+	// LeftEntry returns the minimum key and its value, found is false if the tree is empty.
+	LeftEntry() (key K, value V, found bool)
+
+	// RightEntry returns the maximum key and its value, found is false if the tree is empty.
+	RightEntry() (key K, value V, found bool)
+
+	// FloorEntry returns the largest key smaller than or equal to the given key and its value, found is false if there is none.
+	FloorEntry(key K) (floorKey K, floorValue V, found bool)
+
+	// CeilingEntry returns the smallest key larger than or equal to the given key and its value, found is false if there is none.
+	CeilingEntry(key K) (ceilingKey K, ceilingValue V, found bool)
+
+	// LowerEntry returns the largest key strictly smaller than the given key and its value, found is false if there is none.
+	LowerEntry(key K) (lowerKey K, lowerValue V, found bool)
+
+	// HigherEntry returns the smallest key strictly larger than the given key and its value, found is false if there is none.
+	HigherEntry(key K) (higherKey K, higherValue V, found bool)
+
+	// IteratorWithKey returns a stateful iterator over the key/value pairs in key order.
+	IteratorWithKey() containers.ReverseIteratorWithKey[K, V]
+
+	// KeyComparator returns the comparator that orders the keys.
+	KeyComparator() utils.Comparator[K]
+
+	// NewEmpty returns a new empty tree of the same kind and configuration (comparator, order, etc.).
+	NewEmpty() OrderedTree[K, V]
+
+	containers.JSONSerializer
+	containers.JSONDeserializer
+	containers.FailFast
+
+	Tree[V]
+}
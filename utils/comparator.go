@@ -4,7 +4,13 @@
 
 package utils
 
-import "time"
+import (
+	"cmp"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
 
 type Comparator[T any] func(x, y T) int
 
@@ -19,3 +25,186 @@ func TimeComparator(a, b time.Time) int {
 		return 0
 	}
 }
+
+// Reverse returns a comparator that imposes the reverse order of the given comparator.
+func Reverse[T any](comparator Comparator[T]) Comparator[T] {
+	return func(a, b T) int {
+		return comparator(b, a)
+	}
+}
+
+// ComparingBy returns a comparator that compares values by the keys extracted from them, in the natural order
+// of the keys, e.g. ComparingBy(func(p Person) string { return p.Name }).
+func ComparingBy[T any, K cmp.Ordered](key func(value T) K) Comparator[T] {
+	return func(a, b T) int {
+		return cmp.Compare(key(a), key(b))
+	}
+}
+
+// ComparingByFunc returns a comparator that compares values by the keys extracted from them, using the comparator
+// of the keys.
+func ComparingByFunc[T, K any](key func(value T) K, comparator Comparator[K]) Comparator[T] {
+	return func(a, b T) int {
+		return comparator(key(a), key(b))
+	}
+}
+
+// ThenComparing returns a comparator that compares values by the first comparator and, if they are equal, by the
+// next ones in turn, e.g. ThenComparing(ComparingBy(lastName), ComparingBy(firstName)).
+func ThenComparing[T any](first Comparator[T], others ...Comparator[T]) Comparator[T] {
+	return func(a, b T) int {
+		if result := first(a, b); result != 0 {
+			return result
+		}
+		for _, comparator := range others {
+			if result := comparator(a, b); result != 0 {
+				return result
+			}
+		}
+		return 0
+	}
+}
+
+// NilsFirst returns a comparator of pointers that orders nil before all other pointers, which are compared by the
+// values they point to using the comparator.
+func NilsFirst[T any](comparator Comparator[T]) Comparator[*T] {
+	return func(a, b *T) int {
+		switch {
+		case a == nil && b == nil:
+			return 0
+		case a == nil:
+			return -1
+		case b == nil:
+			return 1
+		default:
+			return comparator(*a, *b)
+		}
+	}
+}
+
+// NilsLast returns a comparator of pointers that orders nil after all other pointers, which are compared by the
+// values they point to using the comparator.
+func NilsLast[T any](comparator Comparator[T]) Comparator[*T] {
+	return func(a, b *T) int {
+		switch {
+		case a == nil && b == nil:
+			return 0
+		case a == nil:
+			return 1
+		case b == nil:
+			return -1
+		default:
+			return comparator(*a, *b)
+		}
+	}
+}
+
+// RuneMappingComparator returns a comparator of strings that compares them rune by rune after mapping each rune,
+// e.g. with unicode.ToLower. Runes are compared by their code points, i.e. the order does not depend on any locale
+// and no collation is applied. Bytes that are not valid UTF-8 are compared by their raw values against the UTF-8
+// encodings of the mapped runes, i.e. strings that differ in invalid bytes are not equal. Strings are not copied.
+func RuneMappingComparator(mapping func(r rune) rune) Comparator[string] {
+	return func(a, b string) int {
+		for a != "" && b != "" {
+			result, na, nb := compareRunes(a, b, mapping)
+			if result != 0 {
+				return result
+			}
+			a, b = a[na:], b[nb:]
+		}
+		return cmp.Compare(len(a), len(b))
+	}
+}
+
+// CaseInsensitiveStringComparator compares strings by their runes ignoring case, i.e. "a" and "A" are equal.
+// Case is folded by the Unicode case mappings, independently of any locale.
+var CaseInsensitiveStringComparator = RuneMappingComparator(foldCase)
+
+// NaturalStringComparator compares strings in natural order, i.e. runs of decimal digits are compared by their
+// numeric value, e.g. "file2" < "file10". Other runes are compared by their code points and bytes that are not valid
+// UTF-8 by their raw values, as by RuneMappingComparator.
+// Numbers of any length are supported. If strings differ only in leading zeros, the one with fewer leading zeros
+// at the first difference comes first, e.g. "a1" < "a01".
+func NaturalStringComparator(a, b string) int {
+	zeros := 0 // result by leading zeros at the first difference, if the strings are equal otherwise
+	for a != "" && b != "" {
+		if isDigit(a[0]) && isDigit(b[0]) {
+			numberA, numberB := digits(a), digits(b)
+			a, b = a[len(numberA):], b[len(numberB):]
+			trimmedA, trimmedB := trimZeros(numberA), trimZeros(numberB)
+			if result := cmp.Compare(len(trimmedA), len(trimmedB)); result != 0 {
+				return result
+			}
+			if result := cmp.Compare(trimmedA, trimmedB); result != 0 {
+				return result
+			}
+			if zeros == 0 {
+				zeros = cmp.Compare(len(numberA), len(numberB))
+			}
+			continue
+		}
+		result, na, nb := compareRunes(a, b, identity)
+		if result != 0 {
+			return result
+		}
+		a, b = a[na:], b[nb:]
+	}
+	if result := cmp.Compare(len(a), len(b)); result != 0 {
+		return result
+	}
+	return zeros
+}
+
+// compareRunes compares the first runes of the strings, which may not be empty, by their mapped code points and
+// returns the result and the lengths of the runes in bytes.
+// A byte that is not valid UTF-8 decodes to utf8.RuneError like any other invalid byte, so it is compared by its raw
+// value against the UTF-8 encoding of the other mapped rune instead. This orders runes the same way, as UTF-8
+// preserves the order of code points.
+func compareRunes(a, b string, mapping func(r rune) rune) (int, int, int) {
+	ra, na := utf8.DecodeRuneInString(a)
+	rb, nb := utf8.DecodeRuneInString(b)
+	invalidA, invalidB := ra == utf8.RuneError && na == 1, rb == utf8.RuneError && nb == 1
+	if !invalidA && !invalidB {
+		return cmp.Compare(mapping(ra), mapping(rb)), na, nb
+	}
+	encodedA, encodedB := a[:na], b[:nb]
+	if !invalidA {
+		encodedA = string(mapping(ra))
+	}
+	if !invalidB {
+		encodedB = string(mapping(rb))
+	}
+	return strings.Compare(encodedA, encodedB), na, nb
+}
+
+func identity(r rune) rune {
+	return r
+}
+
+// foldCase maps the rune to a case-independent representative, so that upper, lower and title case variants of
+// a letter map to the same rune.
+func foldCase(r rune) rune {
+	return unicode.ToLower(unicode.ToUpper(r))
+}
+
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
+
+// digits returns the leading run of decimal digits of s.
+func digits(s string) string {
+	i := 0
+	for i < len(s) && isDigit(s[i]) {
+		i++
+	}
+	return s[:i]
+}
+
+// trimZeros returns the number without its leading zeros.
+func trimZeros(number string) string {
+	i := 0
+	for i < len(number) && number[i] == '0' {
+		i++
+	}
+	return number[i:]
+}
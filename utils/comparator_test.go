@@ -5,8 +5,11 @@
 package utils
 
 import (
+	"cmp"
+	"slices"
 	"testing"
 	"time"
+	"unicode"
 )
 
 func TestTimeComparator(t *testing.T) {
@@ -28,3 +31,130 @@ func TestTimeComparator(t *testing.T) {
 		}
 	}
 }
+
+func TestReverse(t *testing.T) {
+	reversed := Reverse(cmp.Compare[int])
+	tests := [][]int{
+		{1, 1, 0},
+		{1, 2, 1},
+		{2, 1, -1},
+	}
+	for _, test := range tests {
+		if actual, expected := reversed(test[0], test[1]), test[2]; actual != expected {
+			t.Errorf("Got %v expected %v", actual, expected)
+		}
+	}
+}
+
+func TestComparingByAndThenComparing(t *testing.T) {
+	type person struct {
+		first, last string
+		age         int
+	}
+	byLast := ComparingBy(func(p person) string { return p.last })
+	byFirst := ComparingByFunc(func(p person) string { return p.first }, CaseInsensitiveStringComparator)
+	byAge := ComparingBy(func(p person) int { return p.age })
+	comparator := ThenComparing(byLast, byFirst, Reverse(byAge))
+
+	people := []person{
+		{"bob", "smith", 30},
+		{"Alice", "smith", 40},
+		{"alice", "smith", 50},
+		{"carol", "jones", 20},
+	}
+	slices.SortFunc(people, comparator)
+	expected := []person{
+		{"carol", "jones", 20},
+		{"alice", "smith", 50},
+		{"Alice", "smith", 40},
+		{"bob", "smith", 30},
+	}
+	if !slices.Equal(people, expected) {
+		t.Errorf("Got %v expected %v", people, expected)
+	}
+	if actual := ThenComparing(byLast)(people[1], people[2]); actual != 0 {
+		t.Errorf("Got %v expected %v", actual, 0)
+	}
+}
+
+func TestNilsFirstAndNilsLast(t *testing.T) {
+	one, two := 1, 2
+	values := []*int{&two, nil, &one, nil}
+
+	slices.SortFunc(values, NilsFirst(cmp.Compare[int]))
+	if values[0] != nil || values[1] != nil || *values[2] != 1 || *values[3] != 2 {
+		t.Errorf("Got %v expected %v", values, "[nil nil 1 2]")
+	}
+
+	slices.SortFunc(values, NilsLast(cmp.Compare[int]))
+	if *values[0] != 1 || *values[1] != 2 || values[2] != nil || values[3] != nil {
+		t.Errorf("Got %v expected %v", values, "[1 2 nil nil]")
+	}
+}
+
+func TestCaseInsensitiveStringComparator(t *testing.T) {
+	// s1,s2,expected
+	tests := [][]interface{}{
+		{"", "", 0},
+		{"a", "A", 0},
+		{"abc", "ABC", 0},
+		{"Straße", "STRASSE", 1}, // no locale specific expansions, "ß" > "s"
+		{"Ωmega", "ωMEGA", 0},
+		{"a", "B", -1},
+		{"B", "a", 1},
+		{"ab", "A", 1},
+		{"A", "ab", -1},
+		{"ǅ", "ǆ", 0},       // title case
+		{"\xff", "\xfe", 1}, // invalid bytes are compared by their raw values
+		{"a\x80", "A\x81", -1},
+		{"a\x80b", "A\x80B", 0},
+		{"\xc3", "ÿ", -1}, // "ÿ" is encoded as "\xc3\xbf"
+		{"\xff", "ÿ", 1},
+		{"\x80", "Z", 1},
+	}
+	for _, test := range tests {
+		actual := CaseInsensitiveStringComparator(test[0].(string), test[1].(string))
+		if expected := test[2]; actual != expected {
+			t.Errorf("%v vs %v: got %v expected %v", test[0], test[1], actual, expected)
+		}
+	}
+
+	byLower := RuneMappingComparator(unicode.ToLower)
+	if actual := byLower("HeLLo", "hello"); actual != 0 {
+		t.Errorf("Got %v expected %v", actual, 0)
+	}
+}
+
+func TestNaturalStringComparator(t *testing.T) {
+	// s1,s2,expected
+	tests := [][]interface{}{
+		{"", "", 0},
+		{"file2", "file10", -1},
+		{"file10", "file2", 1},
+		{"file10", "file10", 0},
+		{"file", "file1", -1},
+		{"file1a", "file1b", -1},
+		{"a1", "a01", -1},
+		{"a01b", "a1c", -1},
+		{"a01", "a1", 1},
+		{"x99999999999999999999999", "x100000000000000000000000", -1},
+		{"1.10", "1.9", 1},
+		{"é2", "é10", -1},
+		{"a", "1", 1},
+		{"file1\xfe", "file1\xff", -1},
+		{"\xff2", "\xff10", -1},
+	}
+	for _, test := range tests {
+		actual := NaturalStringComparator(test[0].(string), test[1].(string))
+		if expected := test[2]; actual != expected {
+			t.Errorf("%v vs %v: got %v expected %v", test[0], test[1], actual, expected)
+		}
+	}
+
+	files := []string{"file10.txt", "file2.txt", "file1.txt", "file01.txt", "File3.txt"}
+	slices.SortFunc(files, NaturalStringComparator)
+	expected := []string{"File3.txt", "file1.txt", "file01.txt", "file2.txt", "file10.txt"}
+	if !slices.Equal(files, expected) {
+		t.Errorf("Got %v expected %v", files, expected)
+	}
+}